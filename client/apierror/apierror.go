@@ -0,0 +1,67 @@
+// Package apierror reads the structured details of the server's status errors.
+package apierror
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// Reason returns the ErrorInfo reason of a status error, empty if it has none.
+func Reason(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return ""
+	}
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+// Describe formats an error for users, preferring the server's localized message,
+// and lists field violations and when to retry.
+func Describe(err error) string {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err.Error()
+	}
+	msg := st.Message()
+	for _, d := range st.Details() {
+		if localized, ok := d.(*errdetails.LocalizedMessage); ok {
+			msg = localized.GetMessage()
+		}
+	}
+	var b strings.Builder
+	b.WriteString(msg)
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				fmt.Fprintf(&b, "\n  %s: %s", v.GetField(), v.GetDescription())
+			}
+		case *errdetails.RetryInfo:
+			fmt.Fprintf(&b, "\n  retry in %s", d.GetRetryDelay().AsDuration())
+		}
+	}
+	return b.String()
+}
+
+// AcceptLanguage returns the user's language from the locale environment variables,
+// for the accept-language metadata that localizes server errors.
+func AcceptLanguage() string {
+	for _, env := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		locale := os.Getenv(env)
+		if locale == "" || locale == "C" || locale == "POSIX" {
+			continue
+		}
+		locale, _, _ = strings.Cut(locale, ".")
+		return strings.ReplaceAll(locale, "_", "-")
+	}
+	return ""
+}
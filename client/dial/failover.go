@@ -0,0 +1,54 @@
+package dial
+
+import (
+	"net"
+
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/health" // Client side health checks of the round robin policy.
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Policy is how a connection to several servers chooses between them.
+type Policy int
+
+const (
+	// Priority sends every call to the first server in the list that accepts connections.
+	// When its connection breaks, the servers are tried again from the start of the list.
+	Priority Policy = iota
+	// RoundRobin spreads the calls over every server that is up and whose health service reports it serving.
+	RoundRobin
+)
+
+// failoverScheme is the resolver scheme of targets listing several servers.
+const failoverScheme = "gophkeeper-failover"
+
+// withFailover returns the target and dial options spreading the connection over the addresses by the policy.
+func withFailover(addrs []string, policy Policy) (string, []grpc.DialOption) {
+	var state resolver.State
+	for _, addr := range addrs {
+		state.Endpoints = append(state.Endpoints, endpoint(addr))
+	}
+	r := manual.NewBuilderWithScheme(failoverScheme)
+	r.InitialState(state)
+	return failoverScheme + ":///", []grpc.DialOption{grpc.WithResolvers(r), serviceConfig(policy)}
+}
+
+// endpoint returns the endpoint of a server, whose certificate is checked against its own host.
+func endpoint(addr string) resolver.Endpoint {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return resolver.Endpoint{Addresses: []resolver.Address{{Addr: addr, ServerName: host}}}
+}
+
+// serviceConfig returns the dial option balancing the calls by the policy.
+func serviceConfig(policy Policy) grpc.DialOption {
+	if policy == RoundRobin {
+		return grpc.WithDefaultServiceConfig(
+			`{"loadBalancingConfig": [{"round_robin": {}}], "healthCheckConfig": {"serviceName": ""}}`,
+		)
+	}
+	return grpc.WithDefaultServiceConfig(`{"loadBalancingConfig": [{"pick_first": {}}]}`)
+}
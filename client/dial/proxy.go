@@ -0,0 +1,111 @@
+package dial
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+	"golang.org/x/net/proxy"
+)
+
+var ErrProxy = errors.New("proxy failed")
+
+// proxyURL returns the proxy to reach addr through: explicit if set, otherwise HTTPS_PROXY or ALL_PROXY
+// unless NO_PROXY excludes addr. It returns nil for direct connections.
+func proxyURL(explicit, addr string) (*url.URL, error) {
+	if explicit != "" {
+		return parseProxy(explicit)
+	}
+	env := httpproxy.FromEnvironment()
+	if env.HTTPSProxy == "" {
+		env.HTTPSProxy = getenv("ALL_PROXY")
+	}
+	return env.ProxyFunc()(&url.URL{Scheme: "https", Host: addr})
+}
+
+func getenv(name string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return os.Getenv(strings.ToLower(name))
+}
+
+// parseProxy parses a proxy URL, defaulting to an HTTP proxy for a bare host and port.
+func parseProxy(s string) (*url.URL, error) {
+	if !strings.Contains(s, "://") {
+		s = "http://" + s
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProxy, err)
+	}
+	switch u.Scheme {
+	case "http", "socks5", "socks5h":
+		return u, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported proxy scheme %q", ErrProxy, u.Scheme)
+	}
+}
+
+// dialProxy connects to addr through the proxy, with HTTP CONNECT or SOCKS5.
+func dialProxy(ctx context.Context, p *url.URL, addr string) (net.Conn, error) {
+	if p.Scheme != "http" {
+		d, err := proxy.FromURL(p, proxy.Direct)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrProxy, err)
+		}
+		return d.(proxy.ContextDialer).DialContext(ctx, "tcp", addr) //nolint:forcetypeassert // SOCKS5 dialers are.
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", p.Host)
+	if err != nil {
+		return nil, err
+	}
+	err = connect(ctx, conn, p, addr)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// connect asks the HTTP proxy on conn for a tunnel to addr.
+func connect(ctx context.Context, conn net.Conn, p *url.URL, addr string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodConnect, "", nil)
+	if err != nil {
+		return err
+	}
+	req.URL, req.Host = &url.URL{Host: addr}, addr
+	if p.User != nil {
+		password, _ := p.User.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(p.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+	err = req.Write(conn)
+	if err != nil {
+		return err
+	}
+	// The server speaks only after the TLS handshake, so nothing past the response is buffered.
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w: CONNECT %s: %s", ErrProxy, addr, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,250 @@
+package dial
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/dns/dnsmessage"
+	"google.golang.org/grpc/resolver"
+)
+
+var ErrSRV = errors.New("SRV lookup failed")
+
+const (
+	// srvScheme is the scheme of targets discovering the servers with DNS SRV records,
+	// like srv://_gophkeeper._tcp.example.com.
+	srvScheme = "srv"
+	// minSRVTTL bounds how often the records are looked up again.
+	minSRVTTL = 5 * time.Second
+	// defaultSRVTTL is how long the records are used when their TTL is unknown.
+	defaultSRVTTL = 30 * time.Second
+)
+
+// srvBuilder builds resolvers of srv targets.
+type srvBuilder struct{}
+
+func (srvBuilder) Scheme() string {
+	return srvScheme
+}
+
+func (srvBuilder) Build(
+	target resolver.Target,
+	cc resolver.ClientConn,
+	_ resolver.BuildOptions,
+) (resolver.Resolver, error) {
+	name := srvName(target.URL.String())
+	if name == "" {
+		return nil, fmt.Errorf("%w: no name in %q", ErrSRV, target.URL.String())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &srvResolver{name: name, cc: cc, cancel: cancel, now: make(chan struct{}, 1)}
+	go r.watch(ctx)
+	return r, nil
+}
+
+// srvName returns the name looked up for an srv target, with or without an empty authority.
+func srvName(target string) string {
+	name := strings.TrimPrefix(target, srvScheme+"://")
+	return strings.TrimPrefix(name, "/")
+}
+
+// srvResolver looks the records up again when their TTL expires or connections fail.
+type srvResolver struct {
+	name   string
+	cc     resolver.ClientConn
+	cancel context.CancelFunc
+	now    chan struct{}
+}
+
+func (r *srvResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.now <- struct{}{}:
+	default:
+	}
+}
+
+func (r *srvResolver) Close() {
+	r.cancel()
+}
+
+func (r *srvResolver) watch(ctx context.Context) {
+	for {
+		timer := time.NewTimer(r.resolve(ctx))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		case <-r.now:
+			timer.Stop()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(minSRVTTL):
+			}
+		}
+	}
+}
+
+// resolve updates the servers of the connection, returning when to look them up again.
+func (r *srvResolver) resolve(ctx context.Context) time.Duration {
+	records, ttl, err := lookupSRV(ctx, r.name)
+	if err == nil && len(records) == 0 {
+		err = fmt.Errorf("%w: no records for %s", ErrSRV, r.name)
+	}
+	if err != nil {
+		r.cc.ReportError(err)
+		return minSRVTTL
+	}
+	var state resolver.State
+	for _, srv := range records {
+		addr := net.JoinHostPort(strings.TrimSuffix(srv.Target, "."), strconv.Itoa(int(srv.Port)))
+		state.Endpoints = append(state.Endpoints, endpoint(addr))
+	}
+	// An error only asks for a new lookup, which the TTL brings anyway.
+	_ = r.cc.UpdateState(state)
+	return max(ttl, minSRVTTL)
+}
+
+// lookupSRV returns the SRV records of name by priority, and by weight within a priority, with their least TTL.
+// The TTL is known when the name servers of /etc/resolv.conf answer directly,
+// otherwise the system resolver is used and the TTL is defaultSRVTTL.
+func lookupSRV(ctx context.Context, name string) ([]*net.SRV, time.Duration, error) {
+	for _, ns := range nameservers() {
+		records, ttl, err := querySRV(ctx, ns, name)
+		if err == nil || errors.Is(err, ErrSRV) {
+			return records, ttl, err
+		}
+	}
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "", "", name)
+	return records, defaultSRVTTL, err
+}
+
+// nameservers returns the addresses of the name servers of /etc/resolv.conf.
+func nameservers() []string {
+	f, err := os.Open("/etc/resolv.conf")
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	var servers []string
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) >= 2 && fields[0] == "nameserver" {
+			servers = append(servers, net.JoinHostPort(fields[1], "53"))
+		}
+	}
+	return servers
+}
+
+// querySRV asks the name server for the SRV records of name over UDP.
+// Errors answered by the server wrap ErrSRV, others mean it should be asked differently.
+func querySRV(ctx context.Context, ns, name string) ([]*net.SRV, time.Duration, error) {
+	q, err := dnsmessage.NewName(strings.TrimSuffix(name, ".") + ".")
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %w", ErrSRV, err)
+	}
+	id := uint16(rand.N(1 << 16)) //nolint:gosec // Query ids need not be secure.
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: id, RecursionDesired: true})
+	_ = b.StartQuestions()
+	_ = b.Question(dnsmessage.Question{Name: q, Type: dnsmessage.TypeSRV, Class: dnsmessage.ClassINET})
+	query, err := b.Finish()
+	if err != nil {
+		return nil, 0, err
+	}
+	resp, err := exchange(ctx, ns, query, id)
+	if err != nil {
+		return nil, 0, err
+	}
+	return parseSRV(resp, name)
+}
+
+// exchange sends the query to the name server and returns the response with the id.
+func exchange(ctx context.Context, ns string, query []byte, id uint16) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "udp", ns)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	_ = conn.SetDeadline(deadline)
+	_, err = conn.Write(query)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 512)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		if n >= 2 && uint16(buf[0])<<8|uint16(buf[1]) == id {
+			return buf[:n], nil
+		}
+	}
+}
+
+// parseSRV returns the SRV records of the response, sorted, with their least TTL.
+func parseSRV(resp []byte, name string) ([]*net.SRV, time.Duration, error) {
+	var p dnsmessage.Parser
+	h, err := p.Start(resp)
+	if err != nil {
+		return nil, 0, err
+	}
+	if h.Truncated {
+		return nil, 0, errors.New("truncated response")
+	}
+	if h.RCode != dnsmessage.RCodeSuccess {
+		return nil, 0, fmt.Errorf("%w: %s: %s", ErrSRV, name, h.RCode)
+	}
+	err = p.SkipAllQuestions()
+	if err != nil {
+		return nil, 0, err
+	}
+	var records []*net.SRV
+	ttl := time.Duration(-1)
+	for {
+		a, err := p.AnswerHeader()
+		if errors.Is(err, dnsmessage.ErrSectionDone) {
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if a.Type != dnsmessage.TypeSRV {
+			err = p.SkipAnswer()
+			if err != nil {
+				return nil, 0, err
+			}
+			continue
+		}
+		srv, err := p.SRVResource()
+		if err != nil {
+			return nil, 0, err
+		}
+		records = append(records, &net.SRV{
+			Target: srv.Target.String(), Port: srv.Port, Priority: srv.Priority, Weight: srv.Weight,
+		})
+		if d := time.Duration(a.TTL) * time.Second; ttl < 0 || d < ttl {
+			ttl = d
+		}
+	}
+	slices.SortStableFunc(records, func(a, b *net.SRV) int {
+		return cmp.Or(cmp.Compare(a.Priority, b.Priority), cmp.Compare(b.Weight, a.Weight))
+	})
+	return records, ttl, nil
+}
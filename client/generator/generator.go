@@ -0,0 +1,96 @@
+package generator
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/sethvargo/go-diceware/diceware"
+)
+
+const (
+	DefaultLength = 20
+	DefaultWords  = 6
+	minLength     = 8
+	maxLength     = 128
+	minWords      = 3
+	maxWords      = 20
+
+	letters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+	symbols = "!#$%&*+-=?@^_~"
+)
+
+var ErrBadOptions = errors.New("bad generator options")
+
+// WordList selects one of the EFF diceware word lists.
+type WordList string
+
+const (
+	// WordListLarge has 7776 words, about 12.9 bits of entropy per word.
+	WordListLarge WordList = "large"
+	// WordListShort has 1296 short words, about 10.3 bits of entropy per word.
+	WordListShort WordList = "short"
+)
+
+type PasswordOptions struct {
+	Length  int
+	Symbols bool
+}
+
+type PassphraseOptions struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+	List       WordList
+}
+
+// Password returns a random password of letters and digits, and symbols if requested.
+func Password(opts PasswordOptions) (string, error) {
+	if opts.Length < minLength || opts.Length > maxLength {
+		return "", fmt.Errorf("%w: length must be between %d and %d", ErrBadOptions, minLength, maxLength)
+	}
+	alphabet := letters
+	if opts.Symbols {
+		alphabet += symbols
+	}
+	var b strings.Builder
+	for range opts.Length {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(alphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// Passphrase returns random words from an EFF word list joined by the separator.
+func Passphrase(opts PassphraseOptions) (string, error) {
+	if opts.Words < minWords || opts.Words > maxWords {
+		return "", fmt.Errorf("%w: word count must be between %d and %d", ErrBadOptions, minWords, maxWords)
+	}
+	var list diceware.WordList
+	switch opts.List {
+	case WordListLarge, "":
+		list = diceware.WordListEffLarge()
+	case WordListShort:
+		list = diceware.WordListEffSmall()
+	default:
+		return "", fmt.Errorf("%w: unknown word list %q", ErrBadOptions, opts.List)
+	}
+	words, err := diceware.GenerateWithWordList(opts.Words, list)
+	if err != nil {
+		return "", err
+	}
+	if opts.Capitalize {
+		for i, w := range words {
+			r, size := utf8.DecodeRuneInString(w)
+			words[i] = string(unicode.ToUpper(r)) + w[size:]
+		}
+	}
+	return strings.Join(words, opts.Separator), nil
+}
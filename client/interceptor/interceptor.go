@@ -0,0 +1,145 @@
+// Package interceptor provides client interceptors, so behavior every call needs, like sending the access token,
+// is set up once when dialing instead of by each call.
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/client/readonly"
+)
+
+// Set is a pair of interceptors, either may be nil.
+type Set struct {
+	Unary  grpc.UnaryClientInterceptor
+	Stream grpc.StreamClientInterceptor
+}
+
+// DialOptions chains the interceptors of the sets, in order, after those already chained.
+func DialOptions(sets ...Set) []grpc.DialOption {
+	var opts []grpc.DialOption
+	for _, s := range sets {
+		if s.Unary != nil {
+			opts = append(opts, grpc.WithChainUnaryInterceptor(s.Unary))
+		}
+		if s.Stream != nil {
+			opts = append(opts, grpc.WithChainStreamInterceptor(s.Stream))
+		}
+	}
+	return opts
+}
+
+// ReadOnly refuses calls that may change data, see the readonly package.
+func ReadOnly() Set {
+	return Set{Unary: readonly.UnaryInterceptor, Stream: readonly.StreamInterceptor}
+}
+
+// Metadata adds the key and value pairs to the metadata of every call.
+func Metadata(kv ...string) Set {
+	return Set{
+		Unary: func(
+			ctx context.Context,
+			method string,
+			req, reply any,
+			cc *grpc.ClientConn,
+			invoker grpc.UnaryInvoker,
+			opts ...grpc.CallOption,
+		) error {
+			return invoker(metadata.AppendToOutgoingContext(ctx, kv...), method, req, reply, cc, opts...)
+		},
+		Stream: func(
+			ctx context.Context,
+			desc *grpc.StreamDesc,
+			cc *grpc.ClientConn,
+			method string,
+			streamer grpc.Streamer,
+			opts ...grpc.CallOption,
+		) (grpc.ClientStream, error) {
+			return streamer(metadata.AppendToOutgoingContext(ctx, kv...), desc, cc, method, opts...)
+		},
+	}
+}
+
+// Auth sends the access token with every call, nothing if the token is empty.
+func Auth(token string) Set {
+	if token == "" {
+		return Set{}
+	}
+	return Metadata("authorization", "Bearer "+token)
+}
+
+// Language asks for errors in the language, like the one of apierror.AcceptLanguage, nothing if it is empty.
+func Language(lang string) Set {
+	if lang == "" {
+		return Set{}
+	}
+	return Metadata("accept-language", lang)
+}
+
+// Retry retries unary idempotent calls failing with UNAVAILABLE up to attempts times in all,
+// waiting backoff before the first retry and twice as long before each next one.
+// Other calls are never retried, they may have been applied.
+func Retry(attempts int, backoff time.Duration) Set {
+	return Set{Unary: func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if !readonly.IsIdempotent(method) {
+			return err
+		}
+		wait := backoff
+		for i := 1; i < attempts && status.Code(err) == codes.Unavailable; i++ {
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(wait):
+			}
+			wait *= 2
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}}
+}
+
+// Log logs every call at debug level, with its status code and how long it took.
+// Streams are logged when they are opened.
+func Log(log *slog.Logger) Set {
+	return Set{
+		Unary: func(
+			ctx context.Context,
+			method string,
+			req, reply any,
+			cc *grpc.ClientConn,
+			invoker grpc.UnaryInvoker,
+			opts ...grpc.CallOption,
+		) error {
+			start := time.Now()
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			log.DebugContext(ctx, "Call", "method", method, "code", status.Code(err), "duration", time.Since(start))
+			return err
+		},
+		Stream: func(
+			ctx context.Context,
+			desc *grpc.StreamDesc,
+			cc *grpc.ClientConn,
+			method string,
+			streamer grpc.Streamer,
+			opts ...grpc.CallOption,
+		) (grpc.ClientStream, error) {
+			s, err := streamer(ctx, desc, cc, method, opts...)
+			log.DebugContext(ctx, "Stream opened", "method", method, "code", status.Code(err))
+			return s, err
+		},
+	}
+}
@@ -0,0 +1,54 @@
+// Package nativemsg implements the native messaging protocol of Chrome and Firefox:
+// JSON messages prefixed with their length as a 32-bit integer in native byte order.
+package nativemsg
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MaxMessage is the largest message a host may send to the browser.
+const MaxMessage = 1 << 20
+
+// maxRead bounds messages from the browser, which may send up to 4 GiB, so a bad length can't exhaust memory.
+const maxRead = 64 << 20
+
+var ErrTooLarge = errors.New("native message too large")
+
+// Read decodes the next message into v. It returns io.EOF when the browser closes the pipe.
+func Read(r io.Reader, v any) error {
+	var n uint32
+	err := binary.Read(r, binary.NativeEndian, &n)
+	if err != nil {
+		return err
+	}
+	if n > maxRead {
+		return fmt.Errorf("%w: %d bytes", ErrTooLarge, n)
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// Write encodes v as a message.
+func Write(w io.Writer, v any) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if len(b) > MaxMessage {
+		return fmt.Errorf("%w: %d bytes", ErrTooLarge, len(b))
+	}
+	err = binary.Write(w, binary.NativeEndian, uint32(len(b)))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
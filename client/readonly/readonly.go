@@ -0,0 +1,86 @@
+package readonly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/options"
+)
+
+var ErrReadOnly = errors.New("client is read-only")
+
+// UnaryInterceptor refuses calls to methods that may change data without sending them.
+func UnaryInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	if !IsReadOnly(method) {
+		return fmt.Errorf("%w: refusing to call %s", ErrReadOnly, method)
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+// StreamInterceptor refuses streams of methods that may change data without opening them.
+func StreamInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	cc *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	if !IsReadOnly(method) {
+		return nil, fmt.Errorf("%w: refusing to call %s", ErrReadOnly, method)
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// IsReadOnly reports whether the method, given as /package.Service/Method, is marked with
+// (v1.options.read_only) = true in its proto definition. Unknown methods are not read-only.
+func IsReadOnly(method string) bool {
+	opts := methodOptions(method)
+	if opts == nil {
+		return false
+	}
+	readOnly, _ := proto.GetExtension(opts, options.E_ReadOnly).(bool)
+	return readOnly
+}
+
+// IsIdempotent reports whether the method, given as /package.Service/Method, is marked with
+// idempotency_level = NO_SIDE_EFFECTS or IDEMPOTENT, so calling it again has no further effect.
+// Unknown methods are not idempotent.
+func IsIdempotent(method string) bool {
+	switch methodOptions(method).GetIdempotencyLevel() {
+	case descriptorpb.MethodOptions_NO_SIDE_EFFECTS, descriptorpb.MethodOptions_IDEMPOTENT:
+		return true
+	case descriptorpb.MethodOptions_IDEMPOTENCY_UNKNOWN:
+	}
+	return false
+}
+
+// methodOptions returns the options of the method, nil if it is unknown.
+func methodOptions(method string) *descriptorpb.MethodOptions {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil
+	}
+	opts, _ := md.Options().(*descriptorpb.MethodOptions)
+	return opts
+}
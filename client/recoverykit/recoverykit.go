@@ -0,0 +1,156 @@
+// Package recoverykit wraps key material with a recovery code and renders the printable recovery kit.
+package recoverykit
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+)
+
+var ErrBadWrappedKey = errors.New("recovery code does not unwrap the key")
+
+// Kit is what users print or download and keep offline.
+type Kit struct {
+	UserID     string
+	Login      string
+	Code       string
+	WrappedKey []byte
+	CreatedAt  time.Time
+}
+
+// Create makes a new recovery kit for the logged in user, storing key wrapped with its code on the server.
+func Create(ctx context.Context, client user.UserServiceClient, login string, key []byte) (Kit, error) {
+	res, err := client.CreateRecoveryKit(ctx, &user.CreateRecoveryKitRequest{})
+	if err != nil {
+		return Kit{}, err
+	}
+	wrapped, err := Wrap(res.GetRecoveryCode(), key)
+	if err != nil {
+		return Kit{}, err
+	}
+	_, err = client.SetRecoveryKey(ctx, &user.SetRecoveryKeyRequest{
+		RecoveryCode: res.GetRecoveryCode(),
+		WrappedKey:   wrapped,
+	})
+	if err != nil {
+		return Kit{}, err
+	}
+	return Kit{
+		UserID:     res.GetUserId(),
+		Login:      login,
+		Code:       res.GetRecoveryCode(),
+		WrappedKey: wrapped,
+		CreatedAt:  res.GetCreatedAt().AsTime(),
+	}, nil
+}
+
+// Recover logs in with the recovery code, replacing the password, and returns the unwrapped key and an access token.
+// The code is used up, so callers should Create a new kit afterwards.
+func Recover(
+	ctx context.Context,
+	client user.UserServiceClient,
+	login, code, newPassword string,
+) ([]byte, string, error) {
+	res, err := client.RecoverAccount(ctx, &user.RecoverAccountRequest{
+		Login:        login,
+		RecoveryCode: code,
+		NewPassword:  newPassword,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	// No key was stored if the kit was created but SetRecoveryKey failed.
+	if len(res.GetWrappedKey()) == 0 {
+		return nil, res.GetToken(), nil
+	}
+	key, err := Unwrap(code, res.GetWrappedKey())
+	if err != nil {
+		return nil, "", err
+	}
+	return key, res.GetToken(), nil
+}
+
+// Wrap encrypts key with AES-GCM under a key derived from the recovery code.
+// Codes have 160 random bits, so a plain hash is enough to derive the key.
+func Wrap(code string, key []byte) ([]byte, error) {
+	aead, err := newAEAD(code)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, key, nil), nil
+}
+
+// Unwrap decrypts a key wrapped with the recovery code.
+func Unwrap(code string, wrapped []byte) ([]byte, error) {
+	aead, err := newAEAD(code)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrBadWrappedKey
+	}
+	key, err := aead.Open(nil, wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():], nil)
+	if err != nil {
+		return nil, ErrBadWrappedKey
+	}
+	return key, nil
+}
+
+func newAEAD(code string) (cipher.AEAD, error) {
+	// Normalized like the server does, so the code can be typed back in any case and grouping.
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	k := sha256.Sum256([]byte("gophkeeper recovery key\x00" + code))
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Render writes the kit as plain text, ready to print.
+func Render(w io.Writer, kit Kit) error {
+	_, err := fmt.Fprintf(w, `GophKeeper recovery kit
+
+Created:       %s
+Account ID:    %s
+Login:         %s
+Recovery code: %s
+
+Wrapped key:
+%s
+
+Keep this kit offline. Anyone with it can log in to your account and decrypt your vault.
+The code works once: create a new kit after recovering your account.
+`,
+		kit.CreatedAt.Format(time.DateTime),
+		kit.UserID,
+		kit.Login,
+		kit.Code,
+		wrapLines(base64.StdEncoding.EncodeToString(kit.WrappedKey), 64),
+	)
+	return err
+}
+
+func wrapLines(s string, width int) string {
+	var lines []string
+	for len(s) > width {
+		lines = append(lines, s[:width])
+		s = s[width:]
+	}
+	return strings.Join(append(lines, s), "\n")
+}
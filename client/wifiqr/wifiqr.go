@@ -0,0 +1,50 @@
+// Package wifiqr encodes WiFi credentials as the QR codes phones scan to join a network.
+package wifiqr
+
+import (
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+)
+
+// Payload returns the WIFI: URI of the credential, as understood by Android and iOS cameras.
+func Payload(w *vault.WifiCredential) string {
+	var b strings.Builder
+	b.WriteString("WIFI:T:")
+	switch w.GetSecurity() { //nolint:exhaustive // open and unknown networks have no password
+	case vault.WifiSecurity_WIFI_SECURITY_WEP:
+		b.WriteString("WEP")
+	case vault.WifiSecurity_WIFI_SECURITY_WPA:
+		b.WriteString("WPA")
+	case vault.WifiSecurity_WIFI_SECURITY_WPA3:
+		b.WriteString("SAE")
+	default:
+		b.WriteString("nopass")
+	}
+	b.WriteString(";S:" + escape(w.GetSsid()) + ";")
+	if w.GetPassword() != "" {
+		b.WriteString("P:" + escape(w.GetPassword()) + ";")
+	}
+	if w.GetHidden() {
+		b.WriteString("H:true;")
+	}
+	b.WriteString(";")
+	return b.String()
+}
+
+// escape backslash-escapes the characters with a meaning in WIFI: URIs.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`).Replace(s)
+}
+
+// Render draws the QR code of the credential with unicode half blocks, two modules per character,
+// for terminals with dark text on a light background unless inverse is set.
+func Render(w *vault.WifiCredential, inverse bool) (string, error) {
+	q, err := qrcode.New(Payload(w), qrcode.Medium)
+	if err != nil {
+		return "", err
+	}
+	return q.ToSmallString(inverse), nil
+}
@@ -0,0 +1,231 @@
+// Command gophkeeper-admin manages a GophKeeper server through its admin socket or mTLS admin address.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/admin"
+)
+
+const usage = `usage: gophkeeper-admin [-socket path | -addr host:port -cert file -key file -ca file] command [args]
+
+commands:
+  users                 list accounts with their item count and storage
+  disable <login>       disable an account
+  enable <login>        enable an account
+  usage                 show database storage usage
+  backups               list backup archives
+  restore [-name archive] [-user id] [-dry-run]
+                        restore a backup, the newest one by default
+  log-level <level>     set the server log level: DEBUG, INFO, WARN or ERROR
+  maintenance on [message] | off
+                        reject public API calls while in maintenance
+`
+
+type command func(ctx context.Context, client admin.AdminServiceClient, args []string) error
+
+var commands = map[string]command{
+	"users":       listUsers,
+	"disable":     setDisabled(true),
+	"enable":      setDisabled(false),
+	"usage":       storageUsage,
+	"backups":     listBackups,
+	"restore":     restoreBackup,
+	"log-level":   setLogLevel,
+	"maintenance": setMaintenance,
+}
+
+func main() {
+	socket := flag.String("socket", os.Getenv("ADMIN_SOCKET"), "server admin socket, $ADMIN_SOCKET by default")
+	addr := flag.String("addr", "", "server admin address, used instead of the socket")
+	cert := flag.String("cert", "", "client certificate for the admin address")
+	key := flag.String("key", "", "client certificate private key")
+	ca := flag.String("ca", "", "CA of the server certificate, the system roots by default")
+	flag.CommandLine.Usage = func() { fmt.Fprint(flag.CommandLine.Output(), usage) }
+	flag.Parse()
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok || (*socket == "" && *addr == "") {
+		flag.CommandLine.Usage()
+		os.Exit(2)
+	}
+
+	target, creds := "unix://"+*socket, insecure.NewCredentials()
+	if *addr != "" {
+		var err error
+		target = *addr
+		creds, err = clientCredentials(*cert, *key, *ca)
+		if err != nil {
+			log.Fatalf("failed to load certificates: %v", err)
+		}
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		log.Fatalf("fail to dial: %v", err)
+	}
+	defer conn.Close()
+	// Restores can take a while on big databases.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	err = cmd(ctx, admin.NewAdminServiceClient(conn), flag.Args()[1:])
+	if err != nil {
+		log.Fatalf("%s failed: %v", flag.Arg(0), err)
+	}
+}
+
+func listUsers(ctx context.Context, client admin.AdminServiceClient, _ []string) error {
+	res, err := client.ListUsers(ctx, &admin.ListUsersRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tLOGIN\tITEMS\tSTORAGE\tDISABLED")
+	for _, u := range res.GetUsers() {
+		disabled := "-"
+		if u.GetDisabledAt() != nil {
+			disabled = u.GetDisabledAt().AsTime().Format(time.RFC3339)
+		}
+		fmt.Fprintf(
+			w,
+			"%s\t%s\t%d\t%s\t%s\n",
+			u.GetId(),
+			u.GetLogin(),
+			u.GetItems(),
+			bytes(u.GetStorageBytes()),
+			disabled,
+		)
+	}
+	return w.Flush()
+}
+
+func setDisabled(disabled bool) command {
+	return func(ctx context.Context, client admin.AdminServiceClient, args []string) error {
+		if len(args) != 1 {
+			return errors.New("expected a login")
+		}
+		_, err := client.SetUserDisabled(ctx, &admin.SetUserDisabledRequest{Login: args[0], Disabled: disabled})
+		return err
+	}
+}
+
+func storageUsage(ctx context.Context, client admin.AdminServiceClient, _ []string) error {
+	res, err := client.GetStorageUsage(ctx, &admin.GetStorageUsageRequest{})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TABLE\tSIZE")
+	for _, t := range res.GetTables() {
+		fmt.Fprintf(w, "%s\t%s\n", t.GetName(), bytes(t.GetBytes()))
+	}
+	fmt.Fprintf(w, "database total\t%s\n", bytes(res.GetDatabaseBytes()))
+	return w.Flush()
+}
+
+func listBackups(ctx context.Context, client admin.AdminServiceClient, _ []string) error {
+	res, err := client.ListBackups(ctx, &admin.ListBackupsRequest{})
+	if err != nil {
+		return err
+	}
+	for _, name := range res.GetNames() {
+		fmt.Fprintln(os.Stdout, name)
+	}
+	return nil
+}
+
+func restoreBackup(ctx context.Context, client admin.AdminServiceClient, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	name := flags.String("name", "", "backup archive to restore, the newest one by default")
+	user := flags.String("user", "", "restore only the data of the user with this id")
+	dryRun := flags.Bool("dry-run", false, "show what would be restored without changing anything")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := client.RestoreBackup(ctx, &admin.RestoreBackupRequest{Name: *name, UserId: *user, DryRun: *dryRun})
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "backup %s made at %s\n", res.GetName(), res.GetCreatedAt().AsTime().Format(time.RFC3339))
+	if *dryRun {
+		fmt.Fprintln(w, "dry run, nothing was changed")
+	}
+	fmt.Fprintln(w, "TABLE\tROWS")
+	for _, t := range res.GetTables() {
+		fmt.Fprintf(w, "%s\t%d\n", t.GetName(), t.GetRows())
+	}
+	return w.Flush()
+}
+
+func setLogLevel(ctx context.Context, client admin.AdminServiceClient, args []string) error {
+	if len(args) != 1 {
+		return errors.New("expected a level")
+	}
+	res, err := client.SetLogLevel(ctx, &admin.SetLogLevelRequest{Level: args[0]})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stdout, "log level changed from %s\n", res.GetPreviousLevel())
+	return nil
+}
+
+func setMaintenance(ctx context.Context, client admin.AdminServiceClient, args []string) error {
+	if len(args) == 0 || (args[0] != "on" && args[0] != "off") {
+		return errors.New("expected on or off")
+	}
+	_, err := client.SetMaintenance(ctx, &admin.SetMaintenanceRequest{
+		Enabled: args[0] == "on",
+		Message: strings.Join(args[1:], " "),
+	})
+	return err
+}
+
+// clientCredentials authenticates with a client certificate, trusting the CA file if set.
+func clientCredentials(certFile, keyFile, caFile string) (credentials.TransportCredentials, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	cfg := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS13}
+	if caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates in %s", caFile)
+		}
+	}
+	return credentials.NewTLS(cfg), nil
+}
+
+// bytes formats a size in bytes for humans.
+func bytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -1,27 +1,107 @@
 package main
 
 import (
+	"bufio"
 	"context"
+	"flag"
+	"fmt"
+	"io"
 	"log"
+	"os"
 	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
+	"github.com/cmrd-a/GophKeeper/client/apierror"
+	"github.com/cmrd-a/GophKeeper/client/dial"
+	"github.com/cmrd-a/GophKeeper/client/generator"
+	"github.com/cmrd-a/GophKeeper/client/interceptor"
 	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
 	"github.com/cmrd-a/GophKeeper/server/insecure"
+	"github.com/cmrd-a/GophKeeper/server/strength"
 )
 
 func main() {
+	readOnly := flag.Bool("read-only", false, "refuse every call that could change the vault")
+	proxy := flag.String("proxy", "", "HTTP or SOCKS5 proxy URL, defaults to HTTPS_PROXY or ALL_PROXY")
+	flag.Parse()
+	switch flag.Arg(0) {
+	case "generate":
+		err := generate(flag.Args()[1:])
+		if err != nil {
+			log.Fatalf("generate failed: %v", err)
+		}
+		return
+	case "strength":
+		err := meter(flag.Args()[1:], os.Stdin)
+		if err != nil {
+			log.Fatalf("strength failed: %v", err)
+		}
+		return
+	}
 	log.Println("its a client")
-	get()
+	get(*readOnly, *proxy)
 }
 
-func get() {
+// generate prints a random password, or a diceware passphrase with -passphrase.
+func generate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ContinueOnError)
+	length := flags.Int("length", generator.DefaultLength, "password length")
+	symbols := flags.Bool("symbols", false, "use symbols in the password")
+	passphrase := flags.Bool("passphrase", false, "generate a passphrase of words instead of a password")
+	words := flags.Int("words", generator.DefaultWords, "passphrase word count")
+	separator := flags.String("separator", "-", "passphrase word separator")
+	capitalize := flags.Bool("capitalize", false, "capitalize passphrase words")
+	list := flags.String("list", string(generator.WordListLarge), "passphrase word list, large or short")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	var secret string
+	if *passphrase {
+		secret, err = generator.Passphrase(generator.PassphraseOptions{
+			Words:      *words,
+			Separator:  *separator,
+			Capitalize: *capitalize,
+			List:       generator.WordList(*list),
+		})
+	} else {
+		secret, err = generator.Password(generator.PasswordOptions{Length: *length, Symbols: *symbols})
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(os.Stdout, secret)
+	return nil
+}
+
+// meter scores each password line read from in as it is entered, with the estimator the server's
+// health report uses, so the client shows the same score.
+func meter(args []string, in io.Reader) error {
+	flags := flag.NewFlagSet("strength", flag.ContinueOnError)
+	login := flags.String("login", "", "login of the item, penalized when found in the password")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	lines := bufio.NewScanner(in)
+	for lines.Scan() {
+		res := strength.Estimate(lines.Text(), *login)
+		fmt.Fprintf(os.Stdout, "%s, crack time %s\n", res.Score, res.CrackTime)
+	}
+	return lines.Err()
+}
+
+func get(readOnly bool, proxy string) {
 	creds := credentials.NewClientTLSFromCert(insecure.CertPool, "localhost:8082")
-	var opts []grpc.DialOption
-	opts = append(opts, grpc.WithTransportCredentials(creds))
-	conn, err := grpc.NewClient("localhost:8082", opts...)
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if readOnly {
+		opts = append(opts, interceptor.DialOptions(interceptor.ReadOnly())...)
+	}
+	opts = append(opts, interceptor.DialOptions(interceptor.Language(apierror.AcceptLanguage()))...)
+	conn, err := dial.New("localhost:8082", dial.Options{WaitForReady: true, Proxy: proxy}, opts...)
 	if err != nil {
 		log.Fatalf("fail to dial: %v", err)
 	}
@@ -31,7 +111,7 @@ func get() {
 	defer cancel()
 	res, err := client.Register(ctx, &user.RegisterRequest{Login: "user", Password: "password"})
 	if err != nil {
-		log.Fatalf("client failed: %v", err)
+		log.Fatalf("client failed: %s", apierror.Describe(err))
 	}
 	log.Println(res)
 }
@@ -0,0 +1,202 @@
+// Command gophkeeper-host is the native messaging host of the browser extension.
+//
+// The browser starts it with the extension's origin as an argument and exchanges native messages
+// on stdin and stdout, so it is configured with environment variables instead of flags:
+// GOPHKEEPER_ADDR is the gRPC address of the server, the addresses of its replicas separated by commas
+// to fail over between in order, or srv://_gophkeeper._tcp.example.com to discover them with DNS SRV records,
+// and GOPHKEEPER_TOKEN the user's access token.
+// The server is reached through the proxy of HTTPS_PROXY or ALL_PROXY, if set.
+// Register it with a manifest like
+//
+//	{
+//	  "name": "com.gophkeeper.host",
+//	  "description": "GophKeeper",
+//	  "path": "/usr/local/bin/gophkeeper-host",
+//	  "type": "stdio",
+//	  "allowed_origins": ["chrome-extension://<extension id>/"]
+//	}
+//
+// with allowed_extensions instead of allowed_origins for Firefox.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cmrd-a/GophKeeper/client/apierror"
+	"github.com/cmrd-a/GophKeeper/client/dial"
+	"github.com/cmrd-a/GophKeeper/client/interceptor"
+	"github.com/cmrd-a/GophKeeper/client/nativemsg"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/insecure"
+)
+
+// Message types sent by the extension.
+const (
+	// TypeFind lists the logins matching the URL, without their passwords.
+	TypeFind = "find"
+	// TypeFill returns the login and password of the login with the ID, if it matches the URL.
+	TypeFill = "fill"
+	// TypeCopy copies the password of the login with the ID to the clipboard, if it matches the URL.
+	TypeCopy = "copy"
+)
+
+var (
+	errUnknownType = errors.New("unknown message type")
+	errNoMatch     = errors.New("no login with this ID matches the URL")
+	errNoClipboard = errors.New("no clipboard command found")
+)
+
+// The host retries lookups while the server restarts, within the timeout of each message.
+const (
+	retryAttempts = 3
+	retryBackoff  = 500 * time.Millisecond
+)
+
+type request struct {
+	// ID is sent back in the response, so the extension can match them.
+	ID   string `json:"id"`
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	// LoginID is the login to fill or copy.
+	LoginID string `json:"login_id,omitempty"`
+}
+
+type response struct {
+	ID       string  `json:"id"`
+	Logins   []login `json:"logins,omitempty"`
+	Login    string  `json:"login,omitempty"`
+	Password string  `json:"password,omitempty"`
+	Error    string  `json:"error,omitempty"`
+}
+
+type login struct {
+	ID      string `json:"id"`
+	Login   string `json:"login"`
+	VaultID string `json:"vault_id"`
+}
+
+func main() {
+	// Stdout carries the messages, so logs go to stderr, which browsers keep in their own logs.
+	log.SetOutput(os.Stderr)
+	addr := os.Getenv("GOPHKEEPER_ADDR")
+	if addr == "" {
+		addr = "localhost:8082"
+	}
+	// The host only ever reads the vault.
+	opts := append(
+		[]grpc.DialOption{grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(insecure.CertPool, ""))},
+		interceptor.DialOptions(
+			interceptor.ReadOnly(),
+			interceptor.Auth(os.Getenv("GOPHKEEPER_TOKEN")),
+			interceptor.Language(apierror.AcceptLanguage()),
+			interceptor.Retry(retryAttempts, retryBackoff),
+		)...,
+	)
+	// Lookups also wait for the server while it is unreachable.
+	conn, err := dial.New(addr, dial.Options{
+		WaitForReady:  true,
+		OnStateChange: func(s connectivity.State) { log.Printf("connection %s", s) },
+	}, opts...)
+	if err != nil {
+		log.Fatalf("fail to dial: %v", err)
+	}
+	defer conn.Close()
+	h := &host{client: vault.NewVaultServiceClient(conn)}
+	err = h.serve(os.Stdin, os.Stdout)
+	if err != nil {
+		log.Fatalf("host failed: %v", err)
+	}
+}
+
+type host struct {
+	client vault.VaultServiceClient
+}
+
+// serve answers messages until the browser closes stdin.
+func (h *host) serve(r io.Reader, w io.Writer) error {
+	for {
+		var req request
+		err := nativemsg.Read(r, &req)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		res, err := h.handle(req)
+		if err != nil {
+			res = response{Error: apierror.Describe(err)}
+		}
+		res.ID = req.ID
+		err = nativemsg.Write(w, res)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (h *host) handle(req request) (response, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	res, err := h.client.FindLoginsForURL(ctx, &vault.FindLoginsForURLRequest{Url: req.URL})
+	if err != nil {
+		return response{}, err
+	}
+
+	switch req.Type {
+	case TypeFind:
+		var out response
+		for _, lp := range res.GetLoginPasswords() {
+			out.Logins = append(out.Logins, login{ID: lp.GetId(), Login: lp.GetLogin(), VaultID: lp.GetVaultId()})
+		}
+		return out, nil
+	case TypeFill, TypeCopy:
+		// Secrets are only given for a login matching the page, so a page can't get another site's password.
+		for _, lp := range res.GetLoginPasswords() {
+			if lp.GetId() != req.LoginID {
+				continue
+			}
+			if req.Type == TypeCopy {
+				return response{}, copyToClipboard(lp.GetPassword())
+			}
+			return response{Login: lp.GetLogin(), Password: lp.GetPassword()}, nil
+		}
+		return response{}, errNoMatch
+	default:
+		return response{}, fmt.Errorf("%w: %q", errUnknownType, req.Type)
+	}
+}
+
+// copyToClipboard pipes the text to the first clipboard command of the platform that is installed.
+func copyToClipboard(text string) error {
+	commands := map[string][][]string{
+		"darwin":  {{"pbcopy"}},
+		"windows": {{"clip"}},
+	}[runtime.GOOS]
+	if commands == nil {
+		commands = [][]string{{"wl-copy"}, {"xclip", "-selection", "clipboard"}, {"xsel", "--clipboard", "--input"}}
+	}
+	for _, c := range commands {
+		path, err := exec.LookPath(c[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, c[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return errNoClipboard
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/demo"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+// openDemo returns an in-memory repository with the demo user and their sample items.
+// The user's access token is logged, so clients can connect without registering.
+func openDemo(log *slog.Logger, cfg *config.Config) (*repository.Memory, error) {
+	repo := repository.NewMemory()
+	userID, err := demo.Seed(context.Background(), repo)
+	if err != nil {
+		return nil, err
+	}
+	generation, err := repo.GetTokenGeneration(context.Background(), userID)
+	if err != nil {
+		return nil, err
+	}
+	deviceID, err := repo.InsertDevice(context.Background(), userID, "Demo client")
+	if err != nil {
+		return nil, err
+	}
+	token, err := auth.NewToken(cfg.Tokens(), userID, deviceID, generation)
+	if err != nil {
+		return nil, err
+	}
+	log.Warn("Demo mode: data is kept in memory and lost when the server stops")
+	log.Info("Demo user", "login", demo.Login, "access_token", token, "expires_in", cfg.Tokens().TokenTTL())
+	return repo, nil
+}
@@ -1,61 +1,178 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"log/slog"
-	"net"
 	"os"
+	"os/signal"
+	"slices"
+	"syscall"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/reflection"
+	"github.com/google/uuid"
 
-	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
-	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
-	"github.com/cmrd-a/GophKeeper/server/insecure"
-	"github.com/cmrd-a/GophKeeper/server/logger"
-
-	"github.com/cmrd-a/GophKeeper/server/api"
+	"github.com/cmrd-a/GophKeeper/server"
 	"github.com/cmrd-a/GophKeeper/server/config"
-	"github.com/cmrd-a/GophKeeper/server/gateway"
-
-	"google.golang.org/grpc/credentials"
+	"github.com/cmrd-a/GophKeeper/server/logger"
 )
 
 func main() {
+	if len(os.Args) > 1 && slices.Contains([]string{"help", "-h", "-help", "--help"}, os.Args[1]) {
+		help()
+		return
+	}
 	log, lvl := logger.NewLogger()
 	cfg, err := config.NewConfig(log, lvl)
 	if err != nil {
 		log.Error("failed to make config", "error", err)
 		os.Exit(1)
 	}
-	startServers(log, cfg)
+	log, closeLog, err := logger.New(logOptions(cfg, cfg.LogFile), lvl)
+	if err != nil {
+		log.Error("failed to open log", "error", err)
+		os.Exit(1)
+	}
+	defer closeLog.Close()
+	var accessLog *slog.Logger
+	if cfg.AccessLog {
+		accessLog = log
+	}
+	if cfg.AccessLog && cfg.AccessLogFile != "" {
+		var closeAccessLog io.Closer
+		accessLog, closeAccessLog, err = logger.New(logOptions(cfg, cfg.AccessLogFile), lvl)
+		if err != nil {
+			log.Error("failed to open access log", "error", err)
+			os.Exit(1)
+		}
+		defer closeAccessLog.Close()
+	}
+	if len(os.Args) > 1 && os.Args[1] == "restore" {
+		err = restore(context.Background(), log, cfg, os.Args[2:])
+		if err != nil {
+			log.Error("failed to restore backup", "error", err)
+			os.Exit(1)
+		}
+		return
+	}
+	opts := server.Options{AccessLog: accessLog, LogLevel: lvl}
+	if slices.Contains(os.Args[1:], "--demo") {
+		repo, err := openDemo(log, cfg)
+		if err != nil {
+			log.Error("failed to seed demo data", "error", err)
+			os.Exit(1)
+		}
+		opts.Repo = repo
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	reload := make(chan *config.Config)
+	opts.Reload = reload
+	go reloadOnHangup(ctx, log, reload)
+	err = server.Run(ctx, cfg, log, opts)
+	if err != nil {
+		log.Error("server failed", "error", err)
+		os.Exit(1)
+	}
 }
 
-func startServers(log *slog.Logger, cfg *config.Config) {
-	addr := fmt.Sprintf("0.0.0.0:%d", cfg.GRPCPort)
-	lis, err := net.Listen("tcp", addr)
+// reloadOnHangup reads the configuration again on each SIGHUP and sends it to reload,
+// keeping the current one when the new one is invalid.
+func reloadOnHangup(ctx context.Context, log *slog.Logger, reload chan<- *config.Config) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+		log.InfoContext(ctx, "Reloading configuration")
+		cfg, err := config.Reload(log)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to reload config, keeping the current one", "error", err)
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case reload <- cfg:
+		}
+	}
+}
+
+func help() {
+	fmt.Fprintln(os.Stdout, "Run with --demo to try the server with sample data kept in memory, without Postgres.")
+	fmt.Fprintln(os.Stdout, "The server is configured with environment variables or a .env file:")
+	err := config.Help(os.Stdout)
 	if err != nil {
-		log.Error("failed to listen", "error", err)
 		os.Exit(1)
 	}
+}
+
+// logOptions returns the options of a log written to file, stdout when empty.
+func logOptions(cfg *config.Config, file string) logger.Options {
+	return logger.Options{
+		Format:      cfg.LogFormat,
+		File:        file,
+		MaxSize:     cfg.LogMaxSize,
+		RotateEvery: cfg.LogRotateEvery,
+		Keep:        cfg.LogKeep,
+		Async:       cfg.LogAsync,
+	}
+}
 
-	s := grpc.NewServer(grpc.Creds(credentials.NewServerTLSFromCert(&insecure.Cert)))
-	user.RegisterUserServiceServer(s, &api.UserServer{})
-	vault.RegisterVaultServiceServer(s, &api.VaultServer{})
-	reflection.Register(s)
+// restore implements `server restore [-name archive] [-user login-or-id] [-dry-run]`.
+func restore(
+	ctx context.Context,
+	log *slog.Logger,
+	cfg *config.Config,
+	args []string,
+) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	name := flags.String("name", "", "backup archive to restore, the newest one by default")
+	user := flags.String("user", "", "restore only the vaults and items of this user, given by login or id")
+	dryRun := flags.Bool("dry-run", false, "show what would be restored without changing anything")
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+	if cfg.BackupPassphrase == "" {
+		return errors.New("BACKUP_PASSPHRASE is required to restore backups")
+	}
+	repo, err := server.OpenDatabase(log, cfg)
+	if err != nil {
+		return fmt.Errorf("database is not ready: %w", err)
+	}
+	defer repo.Close()
+	backups, err := server.NewBackupService(cfg, repo)
+	if err != nil {
+		return fmt.Errorf("failed to configure backups: %w", err)
+	}
 
-	log.Info("Serving gRPC on ", "addr", addr)
-	go func() {
-		err := s.Serve(lis)
+	var userID *uuid.UUID
+	if *user != "" {
+		id, err := uuid.Parse(*user)
 		if err != nil {
-			log.Error("failed to serve grpc", "error", err)
-			os.Exit(1)
+			id, err = repo.GetUserIDByLogin(ctx, *user)
+		}
+		if err != nil {
+			return fmt.Errorf("unknown user %q: %w", *user, err)
 		}
-	}()
+		userID = &id
+	}
 
-	err = gateway.Run(addr, cfg.HTTPPort)
+	b, restored, err := backups.Restore(ctx, *name, userID, *dryRun)
 	if err != nil {
-		log.Error("failed to serve http", "error", err)
-		os.Exit(1)
+		return err
+	}
+	log.InfoContext(ctx, "Backup restored", "name", b.Name, "created_at", b.CreatedAt, "dry_run", *dryRun)
+	for _, t := range restored {
+		log.InfoContext(ctx, "Restored table", "table", t.Name, "rows", t.Rows)
 	}
+	return nil
 }
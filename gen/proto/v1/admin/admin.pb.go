@@ -0,0 +1,1610 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/v1/admin/admin.proto
+
+package admin
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Lists only the users of this tenant if set.
+	TenantId      string `protobuf:"bytes,1,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListUsersRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Users         []*ListUsersResponse_User `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListUsersResponse) GetUsers() []*ListUsersResponse_User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type SetUserDisabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Disabled      bool                   `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserDisabledRequest) Reset() {
+	*x = SetUserDisabledRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserDisabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserDisabledRequest) ProtoMessage() {}
+
+func (x *SetUserDisabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserDisabledRequest.ProtoReflect.Descriptor instead.
+func (*SetUserDisabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetUserDisabledRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SetUserDisabledRequest) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type SetUserDisabledResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserDisabledResponse) Reset() {
+	*x = SetUserDisabledResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserDisabledResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserDisabledResponse) ProtoMessage() {}
+
+func (x *SetUserDisabledResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserDisabledResponse.ProtoReflect.Descriptor instead.
+func (*SetUserDisabledResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type SetUserAllowedNetworksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Login string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	// networks are in CIDR notation, or single addresses.
+	Networks      []string `protobuf:"bytes,2,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAllowedNetworksRequest) Reset() {
+	*x = SetUserAllowedNetworksRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAllowedNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAllowedNetworksRequest) ProtoMessage() {}
+
+func (x *SetUserAllowedNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAllowedNetworksRequest.ProtoReflect.Descriptor instead.
+func (*SetUserAllowedNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SetUserAllowedNetworksRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SetUserAllowedNetworksRequest) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type SetUserAllowedNetworksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// networks are the stored networks, in CIDR notation.
+	Networks      []string `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserAllowedNetworksResponse) Reset() {
+	*x = SetUserAllowedNetworksResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserAllowedNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserAllowedNetworksResponse) ProtoMessage() {}
+
+func (x *SetUserAllowedNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserAllowedNetworksResponse.ProtoReflect.Descriptor instead.
+func (*SetUserAllowedNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetUserAllowedNetworksResponse) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type GetStorageUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageRequest) Reset() {
+	*x = GetStorageUsageRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageRequest) ProtoMessage() {}
+
+func (x *GetStorageUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{6}
+}
+
+type GetStorageUsageResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	DatabaseBytes int64                            `protobuf:"varint,1,opt,name=database_bytes,json=databaseBytes,proto3" json:"database_bytes,omitempty"`
+	Tables        []*GetStorageUsageResponse_Table `protobuf:"bytes,2,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageResponse) Reset() {
+	*x = GetStorageUsageResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetStorageUsageResponse) GetDatabaseBytes() int64 {
+	if x != nil {
+		return x.DatabaseBytes
+	}
+	return 0
+}
+
+func (x *GetStorageUsageResponse) GetTables() []*GetStorageUsageResponse_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type ListBackupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsRequest) Reset() {
+	*x = ListBackupsRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsRequest) ProtoMessage() {}
+
+func (x *ListBackupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackupsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{8}
+}
+
+type ListBackupsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Oldest first.
+	Names         []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackupsResponse) Reset() {
+	*x = ListBackupsResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackupsResponse) ProtoMessage() {}
+
+func (x *ListBackupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackupsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackupsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListBackupsResponse) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type RestoreBackupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to the newest backup.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Restores only the vaults and items of this user if set, keeping their devices, secret links and grants.
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// Reports what would be restored without changing anything.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBackupRequest) Reset() {
+	*x = RestoreBackupRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBackupRequest) ProtoMessage() {}
+
+func (x *RestoreBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBackupRequest.ProtoReflect.Descriptor instead.
+func (*RestoreBackupRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RestoreBackupRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreBackupRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RestoreBackupRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RestoreBackupResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Name          string                         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SchemaVersion int64                          `protobuf:"varint,2,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	CreatedAt     *timestamppb.Timestamp         `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Tables        []*RestoreBackupResponse_Table `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBackupResponse) Reset() {
+	*x = RestoreBackupResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBackupResponse) ProtoMessage() {}
+
+func (x *RestoreBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBackupResponse.ProtoReflect.Descriptor instead.
+func (*RestoreBackupResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RestoreBackupResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreBackupResponse) GetSchemaVersion() int64 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *RestoreBackupResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RestoreBackupResponse) GetTables() []*RestoreBackupResponse_Table {
+	if x != nil {
+		return x.Tables
+	}
+	return nil
+}
+
+type SetLogLevelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DEBUG, INFO, WARN or ERROR.
+	Level         string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PreviousLevel string                 `protobuf:"bytes,1,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+type SetMaintenanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Rejects public API calls with UNAVAILABLE while enabled.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Shown to clients while in maintenance.
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceRequest) Reset() {
+	*x = SetMaintenanceRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceRequest) ProtoMessage() {}
+
+func (x *SetMaintenanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceRequest.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetMaintenanceRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *SetMaintenanceRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SetMaintenanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaintenanceResponse) Reset() {
+	*x = SetMaintenanceResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaintenanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaintenanceResponse) ProtoMessage() {}
+
+func (x *SetMaintenanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaintenanceResponse.ProtoReflect.Descriptor instead.
+func (*SetMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{15}
+}
+
+type CreateTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Bounds the size of the secrets of the tenant's users, unlimited if 0.
+	StorageQuotaBytes int64 `protobuf:"varint,2,opt,name=storage_quota_bytes,json=storageQuotaBytes,proto3" json:"storage_quota_bytes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateTenantRequest) Reset() {
+	*x = CreateTenantRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantRequest) ProtoMessage() {}
+
+func (x *CreateTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateTenantRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateTenantRequest) GetStorageQuotaBytes() int64 {
+	if x != nil {
+		return x.StorageQuotaBytes
+	}
+	return 0
+}
+
+type CreateTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTenantResponse) Reset() {
+	*x = CreateTenantResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTenantResponse) ProtoMessage() {}
+
+func (x *CreateTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTenantResponse.ProtoReflect.Descriptor instead.
+func (*CreateTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateTenantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListTenantsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsRequest) Reset() {
+	*x = ListTenantsRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsRequest) ProtoMessage() {}
+
+func (x *ListTenantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTenantsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{18}
+}
+
+type ListTenantsResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Tenants       []*ListTenantsResponse_Tenant `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse) Reset() {
+	*x = ListTenantsResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse) ProtoMessage() {}
+
+func (x *ListTenantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTenantsResponse) GetTenants() []*ListTenantsResponse_Tenant {
+	if x != nil {
+		return x.Tenants
+	}
+	return nil
+}
+
+type SetTenantQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Unlimited if 0. Lowering it under the current usage only refuses new items.
+	StorageQuotaBytes int64 `protobuf:"varint,2,opt,name=storage_quota_bytes,json=storageQuotaBytes,proto3" json:"storage_quota_bytes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetTenantQuotaRequest) Reset() {
+	*x = SetTenantQuotaRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTenantQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantQuotaRequest) ProtoMessage() {}
+
+func (x *SetTenantQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetTenantQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetTenantQuotaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetTenantQuotaRequest) GetStorageQuotaBytes() int64 {
+	if x != nil {
+		return x.StorageQuotaBytes
+	}
+	return 0
+}
+
+type SetTenantQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTenantQuotaResponse) Reset() {
+	*x = SetTenantQuotaResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTenantQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTenantQuotaResponse) ProtoMessage() {}
+
+func (x *SetTenantQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTenantQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetTenantQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{21}
+}
+
+type SetUserTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Login string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	// Moves the user out of any tenant if unset.
+	TenantId      string `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTenantRequest) Reset() {
+	*x = SetUserTenantRequest{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTenantRequest) ProtoMessage() {}
+
+func (x *SetUserTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTenantRequest.ProtoReflect.Descriptor instead.
+func (*SetUserTenantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetUserTenantRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SetUserTenantRequest) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type SetUserTenantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserTenantResponse) Reset() {
+	*x = SetUserTenantResponse{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserTenantResponse) ProtoMessage() {}
+
+func (x *SetUserTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserTenantResponse.ProtoReflect.Descriptor instead.
+func (*SetUserTenantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{23}
+}
+
+type ListUsersResponse_User struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	// Unset if the account is enabled.
+	DisabledAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`
+	Items        int64                  `protobuf:"varint,4,opt,name=items,proto3" json:"items,omitempty"`
+	StorageBytes int64                  `protobuf:"varint,5,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// Unset if the user is outside any tenant.
+	TenantId      string `protobuf:"bytes,6,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse_User) Reset() {
+	*x = ListUsersResponse_User{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse_User) ProtoMessage() {}
+
+func (x *ListUsersResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse_User.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse_User) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ListUsersResponse_User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListUsersResponse_User) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *ListUsersResponse_User) GetDisabledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return nil
+}
+
+func (x *ListUsersResponse_User) GetItems() int64 {
+	if x != nil {
+		return x.Items
+	}
+	return 0
+}
+
+func (x *ListUsersResponse_User) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *ListUsersResponse_User) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+type GetStorageUsageResponse_Table struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Bytes         int64                  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorageUsageResponse_Table) Reset() {
+	*x = GetStorageUsageResponse_Table{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorageUsageResponse_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorageUsageResponse_Table) ProtoMessage() {}
+
+func (x *GetStorageUsageResponse_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorageUsageResponse_Table.ProtoReflect.Descriptor instead.
+func (*GetStorageUsageResponse_Table) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *GetStorageUsageResponse_Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetStorageUsageResponse_Table) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+type RestoreBackupResponse_Table struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Rows          int64                  `protobuf:"varint,2,opt,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreBackupResponse_Table) Reset() {
+	*x = RestoreBackupResponse_Table{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBackupResponse_Table) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBackupResponse_Table) ProtoMessage() {}
+
+func (x *RestoreBackupResponse_Table) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreBackupResponse_Table.ProtoReflect.Descriptor instead.
+func (*RestoreBackupResponse_Table) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *RestoreBackupResponse_Table) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RestoreBackupResponse_Table) GetRows() int64 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+type ListTenantsResponse_Tenant struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	StorageQuotaBytes int64                  `protobuf:"varint,3,opt,name=storage_quota_bytes,json=storageQuotaBytes,proto3" json:"storage_quota_bytes,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Users             int64                  `protobuf:"varint,5,opt,name=users,proto3" json:"users,omitempty"`
+	StorageBytes      int64                  `protobuf:"varint,6,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListTenantsResponse_Tenant) Reset() {
+	*x = ListTenantsResponse_Tenant{}
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTenantsResponse_Tenant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTenantsResponse_Tenant) ProtoMessage() {}
+
+func (x *ListTenantsResponse_Tenant) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_admin_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTenantsResponse_Tenant.ProtoReflect.Descriptor instead.
+func (*ListTenantsResponse_Tenant) Descriptor() ([]byte, []int) {
+	return file_proto_v1_admin_admin_proto_rawDescGZIP(), []int{19, 0}
+}
+
+func (x *ListTenantsResponse_Tenant) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListTenantsResponse_Tenant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListTenantsResponse_Tenant) GetStorageQuotaBytes() int64 {
+	if x != nil {
+		return x.StorageQuotaBytes
+	}
+	return 0
+}
+
+func (x *ListTenantsResponse_Tenant) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListTenantsResponse_Tenant) GetUsers() int64 {
+	if x != nil {
+		return x.Users
+	}
+	return 0
+}
+
+func (x *ListTenantsResponse_Tenant) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+var File_proto_v1_admin_admin_proto protoreflect.FileDescriptor
+
+const file_proto_v1_admin_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x1aproto/v1/admin/admin.proto\x12\bv1.admin\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"/\n" +
+	"\x10ListUsersRequest\x12\x1b\n" +
+	"\ttenant_id\x18\x01 \x01(\tR\btenantId\"\x8f\x02\n" +
+	"\x11ListUsersResponse\x126\n" +
+	"\x05users\x18\x01 \x03(\v2 .v1.admin.ListUsersResponse.UserR\x05users\x1a\xc1\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12;\n" +
+	"\vdisabled_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"disabledAt\x12\x14\n" +
+	"\x05items\x18\x04 \x01(\x03R\x05items\x12#\n" +
+	"\rstorage_bytes\x18\x05 \x01(\x03R\fstorageBytes\x12\x1b\n" +
+	"\ttenant_id\x18\x06 \x01(\tR\btenantId\"J\n" +
+	"\x16SetUserDisabledRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bdisabled\x18\x02 \x01(\bR\bdisabled\"\x19\n" +
+	"\x17SetUserDisabledResponse\"Q\n" +
+	"\x1dSetUserAllowedNetworksRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bnetworks\x18\x02 \x03(\tR\bnetworks\"<\n" +
+	"\x1eSetUserAllowedNetworksResponse\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x03(\tR\bnetworks\"\x18\n" +
+	"\x16GetStorageUsageRequest\"\xb4\x01\n" +
+	"\x17GetStorageUsageResponse\x12%\n" +
+	"\x0edatabase_bytes\x18\x01 \x01(\x03R\rdatabaseBytes\x12?\n" +
+	"\x06tables\x18\x02 \x03(\v2'.v1.admin.GetStorageUsageResponse.TableR\x06tables\x1a1\n" +
+	"\x05Table\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05bytes\x18\x02 \x01(\x03R\x05bytes\"\x14\n" +
+	"\x12ListBackupsRequest\"+\n" +
+	"\x13ListBackupsResponse\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"\\\n" +
+	"\x14RestoreBackupRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\"\xfd\x01\n" +
+	"\x15RestoreBackupResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12%\n" +
+	"\x0eschema_version\x18\x02 \x01(\x03R\rschemaVersion\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\x06tables\x18\x04 \x03(\v2%.v1.admin.RestoreBackupResponse.TableR\x06tables\x1a/\n" +
+	"\x05Table\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04rows\x18\x02 \x01(\x03R\x04rows\"*\n" +
+	"\x12SetLogLevelRequest\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\tR\x05level\"<\n" +
+	"\x13SetLogLevelResponse\x12%\n" +
+	"\x0eprevious_level\x18\x01 \x01(\tR\rpreviousLevel\"K\n" +
+	"\x15SetMaintenanceRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x18\n" +
+	"\x16SetMaintenanceResponse\"Y\n" +
+	"\x13CreateTenantRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12.\n" +
+	"\x13storage_quota_bytes\x18\x02 \x01(\x03R\x11storageQuotaBytes\"&\n" +
+	"\x14CreateTenantResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x12ListTenantsRequest\"\xaa\x02\n" +
+	"\x13ListTenantsResponse\x12>\n" +
+	"\atenants\x18\x01 \x03(\v2$.v1.admin.ListTenantsResponse.TenantR\atenants\x1a\xd2\x01\n" +
+	"\x06Tenant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\x13storage_quota_bytes\x18\x03 \x01(\x03R\x11storageQuotaBytes\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x14\n" +
+	"\x05users\x18\x05 \x01(\x03R\x05users\x12#\n" +
+	"\rstorage_bytes\x18\x06 \x01(\x03R\fstorageBytes\"W\n" +
+	"\x15SetTenantQuotaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
+	"\x13storage_quota_bytes\x18\x02 \x01(\x03R\x11storageQuotaBytes\"\x18\n" +
+	"\x16SetTenantQuotaResponse\"I\n" +
+	"\x14SetUserTenantRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\"\x17\n" +
+	"\x15SetUserTenantResponse2\xf8\v\n" +
+	"\fAdminService\x12l\n" +
+	"\tListUsers\x12\x1a.v1.admin.ListUsersRequest\x1a\x1b.v1.admin.ListUsersResponse\"&\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/admin/list-users\x90\x02\x01\x12\x82\x01\n" +
+	"\x0fSetUserDisabled\x12 .v1.admin.SetUserDisabledRequest\x1a!.v1.admin.SetUserDisabledResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/set-user-disabled\x12\x9f\x01\n" +
+	"\x16SetUserAllowedNetworks\x12'.v1.admin.SetUserAllowedNetworksRequest\x1a(.v1.admin.SetUserAllowedNetworksResponse\"2\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/admin/set-user-allowed-networks\x12\x85\x01\n" +
+	"\x0fGetStorageUsage\x12 .v1.admin.GetStorageUsageRequest\x1a!.v1.admin.GetStorageUsageResponse\"-\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/get-storage-usage\x90\x02\x01\x12t\n" +
+	"\vListBackups\x12\x1c.v1.admin.ListBackupsRequest\x1a\x1d.v1.admin.ListBackupsResponse\"(\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/admin/list-backups\x90\x02\x01\x12y\n" +
+	"\rRestoreBackup\x12\x1e.v1.admin.RestoreBackupRequest\x1a\x1f.v1.admin.RestoreBackupResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/admin/restore-backup\x12r\n" +
+	"\vSetLogLevel\x12\x1c.v1.admin.SetLogLevelRequest\x1a\x1d.v1.admin.SetLogLevelResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/set-log-level\x12}\n" +
+	"\x0eSetMaintenance\x12\x1f.v1.admin.SetMaintenanceRequest\x1a .v1.admin.SetMaintenanceResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/admin/set-maintenance\x12u\n" +
+	"\fCreateTenant\x12\x1d.v1.admin.CreateTenantRequest\x1a\x1e.v1.admin.CreateTenantResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/admin/create-tenant\x12t\n" +
+	"\vListTenants\x12\x1c.v1.admin.ListTenantsRequest\x1a\x1d.v1.admin.ListTenantsResponse\"(\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/admin/list-tenants\x90\x02\x01\x12~\n" +
+	"\x0eSetTenantQuota\x12\x1f.v1.admin.SetTenantQuotaRequest\x1a .v1.admin.SetTenantQuotaResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/admin/set-tenant-quota\x12z\n" +
+	"\rSetUserTenant\x12\x1e.v1.admin.SetUserTenantRequest\x1a\x1f.v1.admin.SetUserTenantResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/admin/set-user-tenantB7Z5github.com/cmrd-a/GophKeeper/gen/proto/v1/admin;adminb\x06proto3"
+
+var (
+	file_proto_v1_admin_admin_proto_rawDescOnce sync.Once
+	file_proto_v1_admin_admin_proto_rawDescData []byte
+)
+
+func file_proto_v1_admin_admin_proto_rawDescGZIP() []byte {
+	file_proto_v1_admin_admin_proto_rawDescOnce.Do(func() {
+		file_proto_v1_admin_admin_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_admin_admin_proto_rawDesc), len(file_proto_v1_admin_admin_proto_rawDesc)))
+	})
+	return file_proto_v1_admin_admin_proto_rawDescData
+}
+
+var file_proto_v1_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
+var file_proto_v1_admin_admin_proto_goTypes = []any{
+	(*ListUsersRequest)(nil),               // 0: v1.admin.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 1: v1.admin.ListUsersResponse
+	(*SetUserDisabledRequest)(nil),         // 2: v1.admin.SetUserDisabledRequest
+	(*SetUserDisabledResponse)(nil),        // 3: v1.admin.SetUserDisabledResponse
+	(*SetUserAllowedNetworksRequest)(nil),  // 4: v1.admin.SetUserAllowedNetworksRequest
+	(*SetUserAllowedNetworksResponse)(nil), // 5: v1.admin.SetUserAllowedNetworksResponse
+	(*GetStorageUsageRequest)(nil),         // 6: v1.admin.GetStorageUsageRequest
+	(*GetStorageUsageResponse)(nil),        // 7: v1.admin.GetStorageUsageResponse
+	(*ListBackupsRequest)(nil),             // 8: v1.admin.ListBackupsRequest
+	(*ListBackupsResponse)(nil),            // 9: v1.admin.ListBackupsResponse
+	(*RestoreBackupRequest)(nil),           // 10: v1.admin.RestoreBackupRequest
+	(*RestoreBackupResponse)(nil),          // 11: v1.admin.RestoreBackupResponse
+	(*SetLogLevelRequest)(nil),             // 12: v1.admin.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),            // 13: v1.admin.SetLogLevelResponse
+	(*SetMaintenanceRequest)(nil),          // 14: v1.admin.SetMaintenanceRequest
+	(*SetMaintenanceResponse)(nil),         // 15: v1.admin.SetMaintenanceResponse
+	(*CreateTenantRequest)(nil),            // 16: v1.admin.CreateTenantRequest
+	(*CreateTenantResponse)(nil),           // 17: v1.admin.CreateTenantResponse
+	(*ListTenantsRequest)(nil),             // 18: v1.admin.ListTenantsRequest
+	(*ListTenantsResponse)(nil),            // 19: v1.admin.ListTenantsResponse
+	(*SetTenantQuotaRequest)(nil),          // 20: v1.admin.SetTenantQuotaRequest
+	(*SetTenantQuotaResponse)(nil),         // 21: v1.admin.SetTenantQuotaResponse
+	(*SetUserTenantRequest)(nil),           // 22: v1.admin.SetUserTenantRequest
+	(*SetUserTenantResponse)(nil),          // 23: v1.admin.SetUserTenantResponse
+	(*ListUsersResponse_User)(nil),         // 24: v1.admin.ListUsersResponse.User
+	(*GetStorageUsageResponse_Table)(nil),  // 25: v1.admin.GetStorageUsageResponse.Table
+	(*RestoreBackupResponse_Table)(nil),    // 26: v1.admin.RestoreBackupResponse.Table
+	(*ListTenantsResponse_Tenant)(nil),     // 27: v1.admin.ListTenantsResponse.Tenant
+	(*timestamppb.Timestamp)(nil),          // 28: google.protobuf.Timestamp
+}
+var file_proto_v1_admin_admin_proto_depIdxs = []int32{
+	24, // 0: v1.admin.ListUsersResponse.users:type_name -> v1.admin.ListUsersResponse.User
+	25, // 1: v1.admin.GetStorageUsageResponse.tables:type_name -> v1.admin.GetStorageUsageResponse.Table
+	28, // 2: v1.admin.RestoreBackupResponse.created_at:type_name -> google.protobuf.Timestamp
+	26, // 3: v1.admin.RestoreBackupResponse.tables:type_name -> v1.admin.RestoreBackupResponse.Table
+	27, // 4: v1.admin.ListTenantsResponse.tenants:type_name -> v1.admin.ListTenantsResponse.Tenant
+	28, // 5: v1.admin.ListUsersResponse.User.disabled_at:type_name -> google.protobuf.Timestamp
+	28, // 6: v1.admin.ListTenantsResponse.Tenant.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: v1.admin.AdminService.ListUsers:input_type -> v1.admin.ListUsersRequest
+	2,  // 8: v1.admin.AdminService.SetUserDisabled:input_type -> v1.admin.SetUserDisabledRequest
+	4,  // 9: v1.admin.AdminService.SetUserAllowedNetworks:input_type -> v1.admin.SetUserAllowedNetworksRequest
+	6,  // 10: v1.admin.AdminService.GetStorageUsage:input_type -> v1.admin.GetStorageUsageRequest
+	8,  // 11: v1.admin.AdminService.ListBackups:input_type -> v1.admin.ListBackupsRequest
+	10, // 12: v1.admin.AdminService.RestoreBackup:input_type -> v1.admin.RestoreBackupRequest
+	12, // 13: v1.admin.AdminService.SetLogLevel:input_type -> v1.admin.SetLogLevelRequest
+	14, // 14: v1.admin.AdminService.SetMaintenance:input_type -> v1.admin.SetMaintenanceRequest
+	16, // 15: v1.admin.AdminService.CreateTenant:input_type -> v1.admin.CreateTenantRequest
+	18, // 16: v1.admin.AdminService.ListTenants:input_type -> v1.admin.ListTenantsRequest
+	20, // 17: v1.admin.AdminService.SetTenantQuota:input_type -> v1.admin.SetTenantQuotaRequest
+	22, // 18: v1.admin.AdminService.SetUserTenant:input_type -> v1.admin.SetUserTenantRequest
+	1,  // 19: v1.admin.AdminService.ListUsers:output_type -> v1.admin.ListUsersResponse
+	3,  // 20: v1.admin.AdminService.SetUserDisabled:output_type -> v1.admin.SetUserDisabledResponse
+	5,  // 21: v1.admin.AdminService.SetUserAllowedNetworks:output_type -> v1.admin.SetUserAllowedNetworksResponse
+	7,  // 22: v1.admin.AdminService.GetStorageUsage:output_type -> v1.admin.GetStorageUsageResponse
+	9,  // 23: v1.admin.AdminService.ListBackups:output_type -> v1.admin.ListBackupsResponse
+	11, // 24: v1.admin.AdminService.RestoreBackup:output_type -> v1.admin.RestoreBackupResponse
+	13, // 25: v1.admin.AdminService.SetLogLevel:output_type -> v1.admin.SetLogLevelResponse
+	15, // 26: v1.admin.AdminService.SetMaintenance:output_type -> v1.admin.SetMaintenanceResponse
+	17, // 27: v1.admin.AdminService.CreateTenant:output_type -> v1.admin.CreateTenantResponse
+	19, // 28: v1.admin.AdminService.ListTenants:output_type -> v1.admin.ListTenantsResponse
+	21, // 29: v1.admin.AdminService.SetTenantQuota:output_type -> v1.admin.SetTenantQuotaResponse
+	23, // 30: v1.admin.AdminService.SetUserTenant:output_type -> v1.admin.SetUserTenantResponse
+	19, // [19:31] is the sub-list for method output_type
+	7,  // [7:19] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_admin_admin_proto_init() }
+func file_proto_v1_admin_admin_proto_init() {
+	if File_proto_v1_admin_admin_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_admin_admin_proto_rawDesc), len(file_proto_v1_admin_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   28,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_admin_admin_proto_goTypes,
+		DependencyIndexes: file_proto_v1_admin_admin_proto_depIdxs,
+		MessageInfos:      file_proto_v1_admin_admin_proto_msgTypes,
+	}.Build()
+	File_proto_v1_admin_admin_proto = out.File
+	file_proto_v1_admin_admin_proto_goTypes = nil
+	file_proto_v1_admin_admin_proto_depIdxs = nil
+}
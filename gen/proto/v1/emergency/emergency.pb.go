@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/v1/emergency/emergency.proto
+
+package emergency
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "github.com/cmrd-a/GophKeeper/gen/proto/v1/options"
+	vault "github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_IDLE        Status = 1
+	Status_STATUS_REQUESTED   Status = 2
+	Status_STATUS_GRANTED     Status = 3
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_IDLE",
+		2: "STATUS_REQUESTED",
+		3: "STATUS_GRANTED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_IDLE":        1,
+		"STATUS_REQUESTED":   2,
+		"STATUS_GRANTED":     3,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_emergency_emergency_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_proto_v1_emergency_emergency_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{0}
+}
+
+type TrustedContact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerLogin    string                 `protobuf:"bytes,2,opt,name=owner_login,json=ownerLogin,proto3" json:"owner_login,omitempty"`
+	GranteeLogin  string                 `protobuf:"bytes,3,opt,name=grantee_login,json=granteeLogin,proto3" json:"grantee_login,omitempty"`
+	WaitSeconds   int64                  `protobuf:"varint,4,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	Status        Status                 `protobuf:"varint,5,opt,name=status,proto3,enum=v1.emergency.Status" json:"status,omitempty"`
+	RequestedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_at,json=requestedAt,proto3" json:"requested_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrustedContact) Reset() {
+	*x = TrustedContact{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrustedContact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrustedContact) ProtoMessage() {}
+
+func (x *TrustedContact) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrustedContact.ProtoReflect.Descriptor instead.
+func (*TrustedContact) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrustedContact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TrustedContact) GetOwnerLogin() string {
+	if x != nil {
+		return x.OwnerLogin
+	}
+	return ""
+}
+
+func (x *TrustedContact) GetGranteeLogin() string {
+	if x != nil {
+		return x.GranteeLogin
+	}
+	return ""
+}
+
+func (x *TrustedContact) GetWaitSeconds() int64 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+func (x *TrustedContact) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *TrustedContact) GetRequestedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedAt
+	}
+	return nil
+}
+
+type AddTrustedContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GranteeLogin  string                 `protobuf:"bytes,1,opt,name=grantee_login,json=granteeLogin,proto3" json:"grantee_login,omitempty"`
+	WaitSeconds   int64                  `protobuf:"varint,2,opt,name=wait_seconds,json=waitSeconds,proto3" json:"wait_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTrustedContactRequest) Reset() {
+	*x = AddTrustedContactRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTrustedContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrustedContactRequest) ProtoMessage() {}
+
+func (x *AddTrustedContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrustedContactRequest.ProtoReflect.Descriptor instead.
+func (*AddTrustedContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AddTrustedContactRequest) GetGranteeLogin() string {
+	if x != nil {
+		return x.GranteeLogin
+	}
+	return ""
+}
+
+func (x *AddTrustedContactRequest) GetWaitSeconds() int64 {
+	if x != nil {
+		return x.WaitSeconds
+	}
+	return 0
+}
+
+type AddTrustedContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTrustedContactResponse) Reset() {
+	*x = AddTrustedContactResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTrustedContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTrustedContactResponse) ProtoMessage() {}
+
+func (x *AddTrustedContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTrustedContactResponse.ProtoReflect.Descriptor instead.
+func (*AddTrustedContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AddTrustedContactResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveTrustedContactRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTrustedContactRequest) Reset() {
+	*x = RemoveTrustedContactRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTrustedContactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTrustedContactRequest) ProtoMessage() {}
+
+func (x *RemoveTrustedContactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTrustedContactRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTrustedContactRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveTrustedContactRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RemoveTrustedContactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveTrustedContactResponse) Reset() {
+	*x = RemoveTrustedContactResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveTrustedContactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTrustedContactResponse) ProtoMessage() {}
+
+func (x *RemoveTrustedContactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTrustedContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTrustedContactResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{4}
+}
+
+type ListTrustedContactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrustedContactsRequest) Reset() {
+	*x = ListTrustedContactsRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrustedContactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrustedContactsRequest) ProtoMessage() {}
+
+func (x *ListTrustedContactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrustedContactsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrustedContactsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{5}
+}
+
+type ListTrustedContactsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contacts designated by the caller.
+	Trusted []*TrustedContact `protobuf:"bytes,1,rep,name=trusted,proto3" json:"trusted,omitempty"`
+	// Accounts that designated the caller.
+	TrustedBy     []*TrustedContact `protobuf:"bytes,2,rep,name=trusted_by,json=trustedBy,proto3" json:"trusted_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrustedContactsResponse) Reset() {
+	*x = ListTrustedContactsResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrustedContactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrustedContactsResponse) ProtoMessage() {}
+
+func (x *ListTrustedContactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrustedContactsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrustedContactsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListTrustedContactsResponse) GetTrusted() []*TrustedContact {
+	if x != nil {
+		return x.Trusted
+	}
+	return nil
+}
+
+func (x *ListTrustedContactsResponse) GetTrustedBy() []*TrustedContact {
+	if x != nil {
+		return x.TrustedBy
+	}
+	return nil
+}
+
+type RequestAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccessRequest) Reset() {
+	*x = RequestAccessRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccessRequest) ProtoMessage() {}
+
+func (x *RequestAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccessRequest.ProtoReflect.Descriptor instead.
+func (*RequestAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequestAccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RequestAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GrantedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=granted_at,json=grantedAt,proto3" json:"granted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestAccessResponse) Reset() {
+	*x = RequestAccessResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestAccessResponse) ProtoMessage() {}
+
+func (x *RequestAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestAccessResponse.ProtoReflect.Descriptor instead.
+func (*RequestAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RequestAccessResponse) GetGrantedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GrantedAt
+	}
+	return nil
+}
+
+type ApproveAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessRequest) Reset() {
+	*x = ApproveAccessRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessRequest) ProtoMessage() {}
+
+func (x *ApproveAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessRequest.ProtoReflect.Descriptor instead.
+func (*ApproveAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ApproveAccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ApproveAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveAccessResponse) Reset() {
+	*x = ApproveAccessResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveAccessResponse) ProtoMessage() {}
+
+func (x *ApproveAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveAccessResponse.ProtoReflect.Descriptor instead.
+func (*ApproveAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{10}
+}
+
+type RejectAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectAccessRequest) Reset() {
+	*x = RejectAccessRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAccessRequest) ProtoMessage() {}
+
+func (x *RejectAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAccessRequest.ProtoReflect.Descriptor instead.
+func (*RejectAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RejectAccessRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RejectAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectAccessResponse) Reset() {
+	*x = RejectAccessResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectAccessResponse) ProtoMessage() {}
+
+func (x *RejectAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectAccessResponse.ProtoReflect.Descriptor instead.
+func (*RejectAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{12}
+}
+
+type GetOwnerItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The trusted contact designating the caller.
+	Id            string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnerItemsRequest) Reset() {
+	*x = GetOwnerItemsRequest{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnerItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwnerItemsRequest) ProtoMessage() {}
+
+func (x *GetOwnerItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwnerItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetOwnerItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOwnerItemsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetOwnerItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*vault.VaultItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOwnerItemsResponse) Reset() {
+	*x = GetOwnerItemsResponse{}
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOwnerItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOwnerItemsResponse) ProtoMessage() {}
+
+func (x *GetOwnerItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_emergency_emergency_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOwnerItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetOwnerItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_emergency_emergency_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOwnerItemsResponse) GetItems() []*vault.VaultItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+var File_proto_v1_emergency_emergency_proto protoreflect.FileDescriptor
+
+const file_proto_v1_emergency_emergency_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/v1/emergency/emergency.proto\x12\fv1.emergency\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1eproto/v1/options/options.proto\x1a\x1aproto/v1/vault/vault.proto\"\xf6\x01\n" +
+	"\x0eTrustedContact\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vowner_login\x18\x02 \x01(\tR\n" +
+	"ownerLogin\x12#\n" +
+	"\rgrantee_login\x18\x03 \x01(\tR\fgranteeLogin\x12!\n" +
+	"\fwait_seconds\x18\x04 \x01(\x03R\vwaitSeconds\x12,\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x14.v1.emergency.StatusR\x06status\x12=\n" +
+	"\frequested_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vrequestedAt\"b\n" +
+	"\x18AddTrustedContactRequest\x12#\n" +
+	"\rgrantee_login\x18\x01 \x01(\tR\fgranteeLogin\x12!\n" +
+	"\fwait_seconds\x18\x02 \x01(\x03R\vwaitSeconds\"+\n" +
+	"\x19AddTrustedContactResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"-\n" +
+	"\x1bRemoveTrustedContactRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1e\n" +
+	"\x1cRemoveTrustedContactResponse\"\x1c\n" +
+	"\x1aListTrustedContactsRequest\"\x92\x01\n" +
+	"\x1bListTrustedContactsResponse\x126\n" +
+	"\atrusted\x18\x01 \x03(\v2\x1c.v1.emergency.TrustedContactR\atrusted\x12;\n" +
+	"\n" +
+	"trusted_by\x18\x02 \x03(\v2\x1c.v1.emergency.TrustedContactR\ttrustedBy\"&\n" +
+	"\x14RequestAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x15RequestAccessResponse\x129\n" +
+	"\n" +
+	"granted_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tgrantedAt\"&\n" +
+	"\x14ApproveAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15ApproveAccessResponse\"%\n" +
+	"\x13RejectAccessRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14RejectAccessResponse\"&\n" +
+	"\x14GetOwnerItemsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"B\n" +
+	"\x15GetOwnerItemsResponse\x12)\n" +
+	"\x05items\x18\x01 \x03(\v2\x13.v1.vault.VaultItemR\x05items*[\n" +
+	"\x06Status\x12\x16\n" +
+	"\x12STATUS_UNSPECIFIED\x10\x00\x12\x0f\n" +
+	"\vSTATUS_IDLE\x10\x01\x12\x14\n" +
+	"\x10STATUS_REQUESTED\x10\x02\x12\x12\n" +
+	"\x0eSTATUS_GRANTED\x10\x032\xa2\b\n" +
+	"\x16EmergencyAccessService\x12\x96\x01\n" +
+	"\x11AddTrustedContact\x12&.v1.emergency.AddTrustedContactRequest\x1a'.v1.emergency.AddTrustedContactResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/v1/emergency/add-trusted-contact\x12\xa2\x01\n" +
+	"\x14RemoveTrustedContact\x12).v1.emergency.RemoveTrustedContactRequest\x1a*.v1.emergency.RemoveTrustedContactResponse\"3\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/emergency/remove-trusted-contact\x12\xa5\x01\n" +
+	"\x13ListTrustedContacts\x12(.v1.emergency.ListTrustedContactsRequest\x1a).v1.emergency.ListTrustedContactsResponse\"9\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/emergency/list-trusted-contacts\x90\x02\x01\x12\x85\x01\n" +
+	"\rRequestAccess\x12\".v1.emergency.RequestAccessRequest\x1a#.v1.emergency.RequestAccessResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/emergency/request-access\x12\x85\x01\n" +
+	"\rApproveAccess\x12\".v1.emergency.ApproveAccessRequest\x1a#.v1.emergency.ApproveAccessResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/emergency/approve-access\x12\x81\x01\n" +
+	"\fRejectAccess\x12!.v1.emergency.RejectAccessRequest\x1a\".v1.emergency.RejectAccessResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/emergency/reject-access\x12\x8d\x01\n" +
+	"\rGetOwnerItems\x12\".v1.emergency.GetOwnerItemsRequest\x1a#.v1.emergency.GetOwnerItemsResponse\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/emergency/get-owner-items\x90\x02\x01B?Z=github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency;emergencyb\x06proto3"
+
+var (
+	file_proto_v1_emergency_emergency_proto_rawDescOnce sync.Once
+	file_proto_v1_emergency_emergency_proto_rawDescData []byte
+)
+
+func file_proto_v1_emergency_emergency_proto_rawDescGZIP() []byte {
+	file_proto_v1_emergency_emergency_proto_rawDescOnce.Do(func() {
+		file_proto_v1_emergency_emergency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_emergency_emergency_proto_rawDesc), len(file_proto_v1_emergency_emergency_proto_rawDesc)))
+	})
+	return file_proto_v1_emergency_emergency_proto_rawDescData
+}
+
+var file_proto_v1_emergency_emergency_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_v1_emergency_emergency_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_v1_emergency_emergency_proto_goTypes = []any{
+	(Status)(0),                          // 0: v1.emergency.Status
+	(*TrustedContact)(nil),               // 1: v1.emergency.TrustedContact
+	(*AddTrustedContactRequest)(nil),     // 2: v1.emergency.AddTrustedContactRequest
+	(*AddTrustedContactResponse)(nil),    // 3: v1.emergency.AddTrustedContactResponse
+	(*RemoveTrustedContactRequest)(nil),  // 4: v1.emergency.RemoveTrustedContactRequest
+	(*RemoveTrustedContactResponse)(nil), // 5: v1.emergency.RemoveTrustedContactResponse
+	(*ListTrustedContactsRequest)(nil),   // 6: v1.emergency.ListTrustedContactsRequest
+	(*ListTrustedContactsResponse)(nil),  // 7: v1.emergency.ListTrustedContactsResponse
+	(*RequestAccessRequest)(nil),         // 8: v1.emergency.RequestAccessRequest
+	(*RequestAccessResponse)(nil),        // 9: v1.emergency.RequestAccessResponse
+	(*ApproveAccessRequest)(nil),         // 10: v1.emergency.ApproveAccessRequest
+	(*ApproveAccessResponse)(nil),        // 11: v1.emergency.ApproveAccessResponse
+	(*RejectAccessRequest)(nil),          // 12: v1.emergency.RejectAccessRequest
+	(*RejectAccessResponse)(nil),         // 13: v1.emergency.RejectAccessResponse
+	(*GetOwnerItemsRequest)(nil),         // 14: v1.emergency.GetOwnerItemsRequest
+	(*GetOwnerItemsResponse)(nil),        // 15: v1.emergency.GetOwnerItemsResponse
+	(*timestamppb.Timestamp)(nil),        // 16: google.protobuf.Timestamp
+	(*vault.VaultItem)(nil),              // 17: v1.vault.VaultItem
+}
+var file_proto_v1_emergency_emergency_proto_depIdxs = []int32{
+	0,  // 0: v1.emergency.TrustedContact.status:type_name -> v1.emergency.Status
+	16, // 1: v1.emergency.TrustedContact.requested_at:type_name -> google.protobuf.Timestamp
+	1,  // 2: v1.emergency.ListTrustedContactsResponse.trusted:type_name -> v1.emergency.TrustedContact
+	1,  // 3: v1.emergency.ListTrustedContactsResponse.trusted_by:type_name -> v1.emergency.TrustedContact
+	16, // 4: v1.emergency.RequestAccessResponse.granted_at:type_name -> google.protobuf.Timestamp
+	17, // 5: v1.emergency.GetOwnerItemsResponse.items:type_name -> v1.vault.VaultItem
+	2,  // 6: v1.emergency.EmergencyAccessService.AddTrustedContact:input_type -> v1.emergency.AddTrustedContactRequest
+	4,  // 7: v1.emergency.EmergencyAccessService.RemoveTrustedContact:input_type -> v1.emergency.RemoveTrustedContactRequest
+	6,  // 8: v1.emergency.EmergencyAccessService.ListTrustedContacts:input_type -> v1.emergency.ListTrustedContactsRequest
+	8,  // 9: v1.emergency.EmergencyAccessService.RequestAccess:input_type -> v1.emergency.RequestAccessRequest
+	10, // 10: v1.emergency.EmergencyAccessService.ApproveAccess:input_type -> v1.emergency.ApproveAccessRequest
+	12, // 11: v1.emergency.EmergencyAccessService.RejectAccess:input_type -> v1.emergency.RejectAccessRequest
+	14, // 12: v1.emergency.EmergencyAccessService.GetOwnerItems:input_type -> v1.emergency.GetOwnerItemsRequest
+	3,  // 13: v1.emergency.EmergencyAccessService.AddTrustedContact:output_type -> v1.emergency.AddTrustedContactResponse
+	5,  // 14: v1.emergency.EmergencyAccessService.RemoveTrustedContact:output_type -> v1.emergency.RemoveTrustedContactResponse
+	7,  // 15: v1.emergency.EmergencyAccessService.ListTrustedContacts:output_type -> v1.emergency.ListTrustedContactsResponse
+	9,  // 16: v1.emergency.EmergencyAccessService.RequestAccess:output_type -> v1.emergency.RequestAccessResponse
+	11, // 17: v1.emergency.EmergencyAccessService.ApproveAccess:output_type -> v1.emergency.ApproveAccessResponse
+	13, // 18: v1.emergency.EmergencyAccessService.RejectAccess:output_type -> v1.emergency.RejectAccessResponse
+	15, // 19: v1.emergency.EmergencyAccessService.GetOwnerItems:output_type -> v1.emergency.GetOwnerItemsResponse
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_emergency_emergency_proto_init() }
+func file_proto_v1_emergency_emergency_proto_init() {
+	if File_proto_v1_emergency_emergency_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_emergency_emergency_proto_rawDesc), len(file_proto_v1_emergency_emergency_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_emergency_emergency_proto_goTypes,
+		DependencyIndexes: file_proto_v1_emergency_emergency_proto_depIdxs,
+		EnumInfos:         file_proto_v1_emergency_emergency_proto_enumTypes,
+		MessageInfos:      file_proto_v1_emergency_emergency_proto_msgTypes,
+	}.Build()
+	File_proto_v1_emergency_emergency_proto = out.File
+	file_proto_v1_emergency_emergency_proto_goTypes = nil
+	file_proto_v1_emergency_emergency_proto_depIdxs = nil
+}
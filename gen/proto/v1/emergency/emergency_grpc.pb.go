@@ -0,0 +1,359 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/v1/emergency/emergency.proto
+
+package emergency
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	EmergencyAccessService_AddTrustedContact_FullMethodName    = "/v1.emergency.EmergencyAccessService/AddTrustedContact"
+	EmergencyAccessService_RemoveTrustedContact_FullMethodName = "/v1.emergency.EmergencyAccessService/RemoveTrustedContact"
+	EmergencyAccessService_ListTrustedContacts_FullMethodName  = "/v1.emergency.EmergencyAccessService/ListTrustedContacts"
+	EmergencyAccessService_RequestAccess_FullMethodName        = "/v1.emergency.EmergencyAccessService/RequestAccess"
+	EmergencyAccessService_ApproveAccess_FullMethodName        = "/v1.emergency.EmergencyAccessService/ApproveAccess"
+	EmergencyAccessService_RejectAccess_FullMethodName         = "/v1.emergency.EmergencyAccessService/RejectAccess"
+	EmergencyAccessService_GetOwnerItems_FullMethodName        = "/v1.emergency.EmergencyAccessService/GetOwnerItems"
+)
+
+// EmergencyAccessServiceClient is the client API for EmergencyAccessService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EmergencyAccessService service definition
+type EmergencyAccessServiceClient interface {
+	AddTrustedContact(ctx context.Context, in *AddTrustedContactRequest, opts ...grpc.CallOption) (*AddTrustedContactResponse, error)
+	RemoveTrustedContact(ctx context.Context, in *RemoveTrustedContactRequest, opts ...grpc.CallOption) (*RemoveTrustedContactResponse, error)
+	ListTrustedContacts(ctx context.Context, in *ListTrustedContactsRequest, opts ...grpc.CallOption) (*ListTrustedContactsResponse, error)
+	RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error)
+	ApproveAccess(ctx context.Context, in *ApproveAccessRequest, opts ...grpc.CallOption) (*ApproveAccessResponse, error)
+	RejectAccess(ctx context.Context, in *RejectAccessRequest, opts ...grpc.CallOption) (*RejectAccessResponse, error)
+	// GetOwnerItems returns the items of every type of the owner who designated the caller,
+	// once the caller was granted access. Fails with PERMISSION_DENIED before that.
+	GetOwnerItems(ctx context.Context, in *GetOwnerItemsRequest, opts ...grpc.CallOption) (*GetOwnerItemsResponse, error)
+}
+
+type emergencyAccessServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEmergencyAccessServiceClient(cc grpc.ClientConnInterface) EmergencyAccessServiceClient {
+	return &emergencyAccessServiceClient{cc}
+}
+
+func (c *emergencyAccessServiceClient) AddTrustedContact(ctx context.Context, in *AddTrustedContactRequest, opts ...grpc.CallOption) (*AddTrustedContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTrustedContactResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_AddTrustedContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) RemoveTrustedContact(ctx context.Context, in *RemoveTrustedContactRequest, opts ...grpc.CallOption) (*RemoveTrustedContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveTrustedContactResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_RemoveTrustedContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) ListTrustedContacts(ctx context.Context, in *ListTrustedContactsRequest, opts ...grpc.CallOption) (*ListTrustedContactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrustedContactsResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_ListTrustedContacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) RequestAccess(ctx context.Context, in *RequestAccessRequest, opts ...grpc.CallOption) (*RequestAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestAccessResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_RequestAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) ApproveAccess(ctx context.Context, in *ApproveAccessRequest, opts ...grpc.CallOption) (*ApproveAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveAccessResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_ApproveAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) RejectAccess(ctx context.Context, in *RejectAccessRequest, opts ...grpc.CallOption) (*RejectAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectAccessResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_RejectAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *emergencyAccessServiceClient) GetOwnerItems(ctx context.Context, in *GetOwnerItemsRequest, opts ...grpc.CallOption) (*GetOwnerItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOwnerItemsResponse)
+	err := c.cc.Invoke(ctx, EmergencyAccessService_GetOwnerItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EmergencyAccessServiceServer is the server API for EmergencyAccessService service.
+// All implementations must embed UnimplementedEmergencyAccessServiceServer
+// for forward compatibility.
+//
+// EmergencyAccessService service definition
+type EmergencyAccessServiceServer interface {
+	AddTrustedContact(context.Context, *AddTrustedContactRequest) (*AddTrustedContactResponse, error)
+	RemoveTrustedContact(context.Context, *RemoveTrustedContactRequest) (*RemoveTrustedContactResponse, error)
+	ListTrustedContacts(context.Context, *ListTrustedContactsRequest) (*ListTrustedContactsResponse, error)
+	RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error)
+	ApproveAccess(context.Context, *ApproveAccessRequest) (*ApproveAccessResponse, error)
+	RejectAccess(context.Context, *RejectAccessRequest) (*RejectAccessResponse, error)
+	// GetOwnerItems returns the items of every type of the owner who designated the caller,
+	// once the caller was granted access. Fails with PERMISSION_DENIED before that.
+	GetOwnerItems(context.Context, *GetOwnerItemsRequest) (*GetOwnerItemsResponse, error)
+	mustEmbedUnimplementedEmergencyAccessServiceServer()
+}
+
+// UnimplementedEmergencyAccessServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEmergencyAccessServiceServer struct{}
+
+func (UnimplementedEmergencyAccessServiceServer) AddTrustedContact(context.Context, *AddTrustedContactRequest) (*AddTrustedContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTrustedContact not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) RemoveTrustedContact(context.Context, *RemoveTrustedContactRequest) (*RemoveTrustedContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTrustedContact not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) ListTrustedContacts(context.Context, *ListTrustedContactsRequest) (*ListTrustedContactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrustedContacts not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) RequestAccess(context.Context, *RequestAccessRequest) (*RequestAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestAccess not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) ApproveAccess(context.Context, *ApproveAccessRequest) (*ApproveAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveAccess not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) RejectAccess(context.Context, *RejectAccessRequest) (*RejectAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectAccess not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) GetOwnerItems(context.Context, *GetOwnerItemsRequest) (*GetOwnerItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerItems not implemented")
+}
+func (UnimplementedEmergencyAccessServiceServer) mustEmbedUnimplementedEmergencyAccessServiceServer() {
+}
+func (UnimplementedEmergencyAccessServiceServer) testEmbeddedByValue() {}
+
+// UnsafeEmergencyAccessServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EmergencyAccessServiceServer will
+// result in compilation errors.
+type UnsafeEmergencyAccessServiceServer interface {
+	mustEmbedUnimplementedEmergencyAccessServiceServer()
+}
+
+func RegisterEmergencyAccessServiceServer(s grpc.ServiceRegistrar, srv EmergencyAccessServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEmergencyAccessServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EmergencyAccessService_ServiceDesc, srv)
+}
+
+func _EmergencyAccessService_AddTrustedContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTrustedContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).AddTrustedContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_AddTrustedContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).AddTrustedContact(ctx, req.(*AddTrustedContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_RemoveTrustedContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTrustedContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).RemoveTrustedContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_RemoveTrustedContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).RemoveTrustedContact(ctx, req.(*RemoveTrustedContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_ListTrustedContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrustedContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).ListTrustedContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_ListTrustedContacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).ListTrustedContacts(ctx, req.(*ListTrustedContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_RequestAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).RequestAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_RequestAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).RequestAccess(ctx, req.(*RequestAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_ApproveAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).ApproveAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_ApproveAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).ApproveAccess(ctx, req.(*ApproveAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_RejectAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).RejectAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_RejectAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).RejectAccess(ctx, req.(*RejectAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmergencyAccessService_GetOwnerItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOwnerItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmergencyAccessServiceServer).GetOwnerItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmergencyAccessService_GetOwnerItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmergencyAccessServiceServer).GetOwnerItems(ctx, req.(*GetOwnerItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EmergencyAccessService_ServiceDesc is the grpc.ServiceDesc for EmergencyAccessService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EmergencyAccessService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.emergency.EmergencyAccessService",
+	HandlerType: (*EmergencyAccessServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTrustedContact",
+			Handler:    _EmergencyAccessService_AddTrustedContact_Handler,
+		},
+		{
+			MethodName: "RemoveTrustedContact",
+			Handler:    _EmergencyAccessService_RemoveTrustedContact_Handler,
+		},
+		{
+			MethodName: "ListTrustedContacts",
+			Handler:    _EmergencyAccessService_ListTrustedContacts_Handler,
+		},
+		{
+			MethodName: "RequestAccess",
+			Handler:    _EmergencyAccessService_RequestAccess_Handler,
+		},
+		{
+			MethodName: "ApproveAccess",
+			Handler:    _EmergencyAccessService_ApproveAccess_Handler,
+		},
+		{
+			MethodName: "RejectAccess",
+			Handler:    _EmergencyAccessService_RejectAccess_Handler,
+		},
+		{
+			MethodName: "GetOwnerItems",
+			Handler:    _EmergencyAccessService_GetOwnerItems_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/emergency/emergency.proto",
+}
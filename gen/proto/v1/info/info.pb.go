@@ -0,0 +1,209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/v1/info/info.proto
+
+package info
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	_ "github.com/cmrd-a/GophKeeper/gen/proto/v1/options"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
+	mi := &file_proto_v1_info_info_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_info_info_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_info_info_proto_rawDescGZIP(), []int{0}
+}
+
+type GetServerInfoResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Version    string                 `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Commit     string                 `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	ApiVersion string                 `protobuf:"bytes,3,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// features the server supports: sharing, emergency-access, secret-links, account-recovery, wifi-credentials,
+	// seed-phrases and watch-changes, with grpc-web, breach-check and favicons if enabled.
+	// Vault items are not end-to-end encrypted and there is no second factor, so neither is listed.
+	Features []string `protobuf:"bytes,4,rep,name=features,proto3" json:"features,omitempty"`
+	// telemetry is "off", or where anonymous usage counters are reported and how the last report went.
+	Telemetry     string `protobuf:"bytes,5,opt,name=telemetry,proto3" json:"telemetry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoResponse) Reset() {
+	*x = GetServerInfoResponse{}
+	mi := &file_proto_v1_info_info_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoResponse) ProtoMessage() {}
+
+func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_info_info_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_info_info_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetServerInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetTelemetry() string {
+	if x != nil {
+		return x.Telemetry
+	}
+	return ""
+}
+
+var File_proto_v1_info_info_proto protoreflect.FileDescriptor
+
+const file_proto_v1_info_info_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/v1/info/info.proto\x12\av1.info\x1a\x1cgoogle/api/annotations.proto\x1a\x1eproto/v1/options/options.proto\"\x16\n" +
+	"\x14GetServerInfoRequest\"\xa4\x01\n" +
+	"\x15GetServerInfoResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12\x16\n" +
+	"\x06commit\x18\x02 \x01(\tR\x06commit\x12\x1f\n" +
+	"\vapi_version\x18\x03 \x01(\tR\n" +
+	"apiVersion\x12\x1a\n" +
+	"\bfeatures\x18\x04 \x03(\tR\bfeatures\x12\x1c\n" +
+	"\ttelemetry\x18\x05 \x01(\tR\ttelemetry2\x8d\x01\n" +
+	"\vInfoService\x12~\n" +
+	"\rGetServerInfo\x12\x1d.v1.info.GetServerInfoRequest\x1a\x1e.v1.info.GetServerInfoResponse\".\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/info/get-server-info\x90\x02\x01B5Z3github.com/cmrd-a/GophKeeper/gen/proto/v1/info;infob\x06proto3"
+
+var (
+	file_proto_v1_info_info_proto_rawDescOnce sync.Once
+	file_proto_v1_info_info_proto_rawDescData []byte
+)
+
+func file_proto_v1_info_info_proto_rawDescGZIP() []byte {
+	file_proto_v1_info_info_proto_rawDescOnce.Do(func() {
+		file_proto_v1_info_info_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_info_info_proto_rawDesc), len(file_proto_v1_info_info_proto_rawDesc)))
+	})
+	return file_proto_v1_info_info_proto_rawDescData
+}
+
+var file_proto_v1_info_info_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_v1_info_info_proto_goTypes = []any{
+	(*GetServerInfoRequest)(nil),  // 0: v1.info.GetServerInfoRequest
+	(*GetServerInfoResponse)(nil), // 1: v1.info.GetServerInfoResponse
+}
+var file_proto_v1_info_info_proto_depIdxs = []int32{
+	0, // 0: v1.info.InfoService.GetServerInfo:input_type -> v1.info.GetServerInfoRequest
+	1, // 1: v1.info.InfoService.GetServerInfo:output_type -> v1.info.GetServerInfoResponse
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_info_info_proto_init() }
+func file_proto_v1_info_info_proto_init() {
+	if File_proto_v1_info_info_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_info_info_proto_rawDesc), len(file_proto_v1_info_info_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_info_info_proto_goTypes,
+		DependencyIndexes: file_proto_v1_info_info_proto_depIdxs,
+		MessageInfos:      file_proto_v1_info_info_proto_msgTypes,
+	}.Build()
+	File_proto_v1_info_info_proto = out.File
+	file_proto_v1_info_info_proto_goTypes = nil
+	file_proto_v1_info_info_proto_depIdxs = nil
+}
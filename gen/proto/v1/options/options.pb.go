@@ -0,0 +1,87 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/v1/options/options.proto
+
+package options
+
+import (
+	reflect "reflect"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var file_proto_v1_options_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         50000,
+		Name:          "v1.options.read_only",
+		Tag:           "varint,50000,opt,name=read_only",
+		Filename:      "proto/v1/options/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// read_only marks the methods read-only clients may call. They don't change the vault or the account settings,
+	// though unlike idempotency_level = NO_SIDE_EFFECTS they may have other effects, like issuing a token.
+	//
+	// optional bool read_only = 50000;
+	E_ReadOnly = &file_proto_v1_options_options_proto_extTypes[0]
+)
+
+var File_proto_v1_options_options_proto protoreflect.FileDescriptor
+
+const file_proto_v1_options_options_proto_rawDesc = "" +
+	"\n" +
+	"\x1eproto/v1/options/options.proto\x12\n" +
+	"v1.options\x1a google/protobuf/descriptor.proto:=\n" +
+	"\tread_only\x12\x1e.google.protobuf.MethodOptions\x18І\x03 \x01(\bR\breadOnlyB;Z9github.com/cmrd-a/GophKeeper/gen/proto/v1/options;optionsb\x06proto3"
+
+var file_proto_v1_options_options_proto_goTypes = []any{
+	(*descriptorpb.MethodOptions)(nil), // 0: google.protobuf.MethodOptions
+}
+var file_proto_v1_options_options_proto_depIdxs = []int32{
+	0, // 0: v1.options.read_only:extendee -> google.protobuf.MethodOptions
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	0, // [0:1] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_options_options_proto_init() }
+func file_proto_v1_options_options_proto_init() {
+	if File_proto_v1_options_options_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_options_options_proto_rawDesc), len(file_proto_v1_options_options_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 1,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_v1_options_options_proto_goTypes,
+		DependencyIndexes: file_proto_v1_options_options_proto_depIdxs,
+		ExtensionInfos:    file_proto_v1_options_options_proto_extTypes,
+	}.Build()
+	File_proto_v1_options_options_proto = out.File
+	file_proto_v1_options_options_proto_goTypes = nil
+	file_proto_v1_options_options_proto_depIdxs = nil
+}
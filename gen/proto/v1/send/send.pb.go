@@ -0,0 +1,362 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: proto/v1/send/send.proto
+
+package send
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateSecretLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Secret:
+	//
+	//	*CreateSecretLinkRequest_ItemId
+	//	*CreateSecretLinkRequest_Text
+	Secret     isCreateSecretLinkRequest_Secret `protobuf_oneof:"secret"`
+	TtlSeconds int64                            `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	// Defaults to 1.
+	MaxViews      int32 `protobuf:"varint,4,opt,name=max_views,json=maxViews,proto3" json:"max_views,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretLinkRequest) Reset() {
+	*x = CreateSecretLinkRequest{}
+	mi := &file_proto_v1_send_send_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretLinkRequest) ProtoMessage() {}
+
+func (x *CreateSecretLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_send_send_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateSecretLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_send_send_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateSecretLinkRequest) GetSecret() isCreateSecretLinkRequest_Secret {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *CreateSecretLinkRequest) GetItemId() string {
+	if x != nil {
+		if x, ok := x.Secret.(*CreateSecretLinkRequest_ItemId); ok {
+			return x.ItemId
+		}
+	}
+	return ""
+}
+
+func (x *CreateSecretLinkRequest) GetText() string {
+	if x != nil {
+		if x, ok := x.Secret.(*CreateSecretLinkRequest_Text); ok {
+			return x.Text
+		}
+	}
+	return ""
+}
+
+func (x *CreateSecretLinkRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *CreateSecretLinkRequest) GetMaxViews() int32 {
+	if x != nil {
+		return x.MaxViews
+	}
+	return 0
+}
+
+type isCreateSecretLinkRequest_Secret interface {
+	isCreateSecretLinkRequest_Secret()
+}
+
+type CreateSecretLinkRequest_ItemId struct {
+	// Id of a login password item to share.
+	ItemId string `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3,oneof"`
+}
+
+type CreateSecretLinkRequest_Text struct {
+	// At most 64 KiB. Links count toward the storage quota of the tenant.
+	Text string `protobuf:"bytes,2,opt,name=text,proto3,oneof"`
+}
+
+func (*CreateSecretLinkRequest_ItemId) isCreateSecretLinkRequest_Secret() {}
+
+func (*CreateSecretLinkRequest_Text) isCreateSecretLinkRequest_Secret() {}
+
+type CreateSecretLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Path of the reveal page on the gateway, with the token in the fragment, which browsers don't send.
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSecretLinkResponse) Reset() {
+	*x = CreateSecretLinkResponse{}
+	mi := &file_proto_v1_send_send_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSecretLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretLinkResponse) ProtoMessage() {}
+
+func (x *CreateSecretLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_send_send_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateSecretLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_send_send_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateSecretLinkResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateSecretLinkResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type RevealSecretLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealSecretLinkRequest) Reset() {
+	*x = RevealSecretLinkRequest{}
+	mi := &file_proto_v1_send_send_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealSecretLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealSecretLinkRequest) ProtoMessage() {}
+
+func (x *RevealSecretLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_send_send_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealSecretLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevealSecretLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_send_send_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RevealSecretLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RevealSecretLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	ViewsLeft     int32                  `protobuf:"varint,2,opt,name=views_left,json=viewsLeft,proto3" json:"views_left,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealSecretLinkResponse) Reset() {
+	*x = RevealSecretLinkResponse{}
+	mi := &file_proto_v1_send_send_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealSecretLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealSecretLinkResponse) ProtoMessage() {}
+
+func (x *RevealSecretLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_send_send_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealSecretLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevealSecretLinkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_send_send_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RevealSecretLinkResponse) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *RevealSecretLinkResponse) GetViewsLeft() int32 {
+	if x != nil {
+		return x.ViewsLeft
+	}
+	return 0
+}
+
+var File_proto_v1_send_send_proto protoreflect.FileDescriptor
+
+const file_proto_v1_send_send_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/v1/send/send.proto\x12\av1.send\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x01\n" +
+	"\x17CreateSecretLinkRequest\x12\x19\n" +
+	"\aitem_id\x18\x01 \x01(\tH\x00R\x06itemId\x12\x14\n" +
+	"\x04text\x18\x02 \x01(\tH\x00R\x04text\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x1b\n" +
+	"\tmax_views\x18\x04 \x01(\x05R\bmaxViewsB\b\n" +
+	"\x06secret\"g\n" +
+	"\x18CreateSecretLinkResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"/\n" +
+	"\x17RevealSecretLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"M\n" +
+	"\x18RevealSecretLinkResponse\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x1d\n" +
+	"\n" +
+	"views_left\x18\x02 \x01(\x05R\tviewsLeft2\x99\x02\n" +
+	"\vSendService\x12\x83\x01\n" +
+	"\x10CreateSecretLink\x12 .v1.send.CreateSecretLinkRequest\x1a!.v1.send.CreateSecretLinkResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/send/create-secret-link\x12\x83\x01\n" +
+	"\x10RevealSecretLink\x12 .v1.send.RevealSecretLinkRequest\x1a!.v1.send.RevealSecretLinkResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/send/reveal-secret-linkB5Z3github.com/cmrd-a/GophKeeper/gen/proto/v1/send;sendb\x06proto3"
+
+var (
+	file_proto_v1_send_send_proto_rawDescOnce sync.Once
+	file_proto_v1_send_send_proto_rawDescData []byte
+)
+
+func file_proto_v1_send_send_proto_rawDescGZIP() []byte {
+	file_proto_v1_send_send_proto_rawDescOnce.Do(func() {
+		file_proto_v1_send_send_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_v1_send_send_proto_rawDesc), len(file_proto_v1_send_send_proto_rawDesc)))
+	})
+	return file_proto_v1_send_send_proto_rawDescData
+}
+
+var file_proto_v1_send_send_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_v1_send_send_proto_goTypes = []any{
+	(*CreateSecretLinkRequest)(nil),  // 0: v1.send.CreateSecretLinkRequest
+	(*CreateSecretLinkResponse)(nil), // 1: v1.send.CreateSecretLinkResponse
+	(*RevealSecretLinkRequest)(nil),  // 2: v1.send.RevealSecretLinkRequest
+	(*RevealSecretLinkResponse)(nil), // 3: v1.send.RevealSecretLinkResponse
+	(*timestamppb.Timestamp)(nil),    // 4: google.protobuf.Timestamp
+}
+var file_proto_v1_send_send_proto_depIdxs = []int32{
+	4, // 0: v1.send.CreateSecretLinkResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: v1.send.SendService.CreateSecretLink:input_type -> v1.send.CreateSecretLinkRequest
+	2, // 2: v1.send.SendService.RevealSecretLink:input_type -> v1.send.RevealSecretLinkRequest
+	1, // 3: v1.send.SendService.CreateSecretLink:output_type -> v1.send.CreateSecretLinkResponse
+	3, // 4: v1.send.SendService.RevealSecretLink:output_type -> v1.send.RevealSecretLinkResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_proto_v1_send_send_proto_init() }
+func file_proto_v1_send_send_proto_init() {
+	if File_proto_v1_send_send_proto != nil {
+		return
+	}
+	file_proto_v1_send_send_proto_msgTypes[0].OneofWrappers = []any{
+		(*CreateSecretLinkRequest_ItemId)(nil),
+		(*CreateSecretLinkRequest_Text)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_send_send_proto_rawDesc), len(file_proto_v1_send_send_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v1_send_send_proto_goTypes,
+		DependencyIndexes: file_proto_v1_send_send_proto_depIdxs,
+		MessageInfos:      file_proto_v1_send_send_proto_msgTypes,
+	}.Build()
+	File_proto_v1_send_send_proto = out.File
+	file_proto_v1_send_send_proto_goTypes = nil
+	file_proto_v1_send_send_proto_depIdxs = nil
+}
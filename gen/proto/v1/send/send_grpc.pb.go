@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: proto/v1/send/send.proto
+
+package send
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SendService_CreateSecretLink_FullMethodName = "/v1.send.SendService/CreateSecretLink"
+	SendService_RevealSecretLink_FullMethodName = "/v1.send.SendService/RevealSecretLink"
+)
+
+// SendServiceClient is the client API for SendService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SendService service definition
+type SendServiceClient interface {
+	CreateSecretLink(ctx context.Context, in *CreateSecretLinkRequest, opts ...grpc.CallOption) (*CreateSecretLinkResponse, error)
+	// RevealSecretLink is a POST, so that link previews and prefetching don't use up views
+	// and the token stays out of URLs in access logs. Browsers open the reveal page of the gateway instead.
+	RevealSecretLink(ctx context.Context, in *RevealSecretLinkRequest, opts ...grpc.CallOption) (*RevealSecretLinkResponse, error)
+}
+
+type sendServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSendServiceClient(cc grpc.ClientConnInterface) SendServiceClient {
+	return &sendServiceClient{cc}
+}
+
+func (c *sendServiceClient) CreateSecretLink(ctx context.Context, in *CreateSecretLinkRequest, opts ...grpc.CallOption) (*CreateSecretLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSecretLinkResponse)
+	err := c.cc.Invoke(ctx, SendService_CreateSecretLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sendServiceClient) RevealSecretLink(ctx context.Context, in *RevealSecretLinkRequest, opts ...grpc.CallOption) (*RevealSecretLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevealSecretLinkResponse)
+	err := c.cc.Invoke(ctx, SendService_RevealSecretLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendServiceServer is the server API for SendService service.
+// All implementations must embed UnimplementedSendServiceServer
+// for forward compatibility.
+//
+// SendService service definition
+type SendServiceServer interface {
+	CreateSecretLink(context.Context, *CreateSecretLinkRequest) (*CreateSecretLinkResponse, error)
+	// RevealSecretLink is a POST, so that link previews and prefetching don't use up views
+	// and the token stays out of URLs in access logs. Browsers open the reveal page of the gateway instead.
+	RevealSecretLink(context.Context, *RevealSecretLinkRequest) (*RevealSecretLinkResponse, error)
+	mustEmbedUnimplementedSendServiceServer()
+}
+
+// UnimplementedSendServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSendServiceServer struct{}
+
+func (UnimplementedSendServiceServer) CreateSecretLink(context.Context, *CreateSecretLinkRequest) (*CreateSecretLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecretLink not implemented")
+}
+func (UnimplementedSendServiceServer) RevealSecretLink(context.Context, *RevealSecretLinkRequest) (*RevealSecretLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealSecretLink not implemented")
+}
+func (UnimplementedSendServiceServer) mustEmbedUnimplementedSendServiceServer() {}
+func (UnimplementedSendServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSendServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SendServiceServer will
+// result in compilation errors.
+type UnsafeSendServiceServer interface {
+	mustEmbedUnimplementedSendServiceServer()
+}
+
+func RegisterSendServiceServer(s grpc.ServiceRegistrar, srv SendServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSendServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SendService_ServiceDesc, srv)
+}
+
+func _SendService_CreateSecretLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendServiceServer).CreateSecretLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SendService_CreateSecretLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendServiceServer).CreateSecretLink(ctx, req.(*CreateSecretLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SendService_RevealSecretLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealSecretLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SendServiceServer).RevealSecretLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SendService_RevealSecretLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SendServiceServer).RevealSecretLink(ctx, req.(*RevealSecretLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SendService_ServiceDesc is the grpc.ServiceDesc for SendService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SendService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.send.SendService",
+	HandlerType: (*SendServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateSecretLink",
+			Handler:    _SendService_CreateSecretLink_Handler,
+		},
+		{
+			MethodName: "RevealSecretLink",
+			Handler:    _SendService_RevealSecretLink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/v1/send/send.proto",
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.9
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: proto/v1/user/user.proto
 
@@ -14,6 +14,9 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "github.com/cmrd-a/GophKeeper/gen/proto/v1/options"
 )
 
 const (
@@ -24,9 +27,16 @@ const (
 )
 
 type RegisterRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Login    string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// invite_code is required when open registration is disabled.
+	InviteCode string `protobuf:"bytes,3,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	// challenge and challenge_solution prove the work asked by GetRegistrationChallenge, when enabled.
+	Challenge         string `protobuf:"bytes,4,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	ChallengeSolution string `protobuf:"bytes,5,opt,name=challenge_solution,json=challengeSolution,proto3" json:"challenge_solution,omitempty"`
+	// captcha_token is the response of the CAPTCHA widget, when enabled.
+	CaptchaToken  string `protobuf:"bytes,6,opt,name=captcha_token,json=captchaToken,proto3" json:"captcha_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -75,6 +85,34 @@ func (x *RegisterRequest) GetPassword() string {
 	return ""
 }
 
+func (x *RegisterRequest) GetInviteCode() string {
+	if x != nil {
+		return x.InviteCode
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetChallengeSolution() string {
+	if x != nil {
+		return x.ChallengeSolution
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetCaptchaToken() string {
+	if x != nil {
+		return x.CaptchaToken
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -111,17 +149,138 @@ func (*RegisterResponse) Descriptor() ([]byte, []int) {
 	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{1}
 }
 
-type LoginRequest struct {
+type GetRegistrationChallengeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegistrationChallengeRequest) Reset() {
+	*x = GetRegistrationChallengeRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationChallengeRequest) ProtoMessage() {}
+
+func (x *GetRegistrationChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetRegistrationChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{2}
+}
+
+type GetRegistrationChallengeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// open is false when registering requires an invite code.
+	Open bool `protobuf:"varint,1,opt,name=open,proto3" json:"open,omitempty"`
+	// challenge is empty when no proof of work is required. Otherwise a solution is a string
+	// such that SHA-256 of the challenge followed by the solution starts with difficulty zero bits.
+	Challenge       string                 `protobuf:"bytes,2,opt,name=challenge,proto3" json:"challenge,omitempty"`
+	Difficulty      int32                  `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CaptchaRequired bool                   `protobuf:"varint,5,opt,name=captcha_required,json=captchaRequired,proto3" json:"captcha_required,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRegistrationChallengeResponse) Reset() {
+	*x = GetRegistrationChallengeResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegistrationChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegistrationChallengeResponse) ProtoMessage() {}
+
+func (x *GetRegistrationChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegistrationChallengeResponse.ProtoReflect.Descriptor instead.
+func (*GetRegistrationChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetRegistrationChallengeResponse) GetOpen() bool {
+	if x != nil {
+		return x.Open
+	}
+	return false
+}
+
+func (x *GetRegistrationChallengeResponse) GetChallenge() string {
+	if x != nil {
+		return x.Challenge
+	}
+	return ""
+}
+
+func (x *GetRegistrationChallengeResponse) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *GetRegistrationChallengeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GetRegistrationChallengeResponse) GetCaptchaRequired() bool {
+	if x != nil {
+		return x.CaptchaRequired
+	}
+	return false
+}
+
+type LoginRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Login    string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Name of the device logging in, listed by ListDevices. The token is bound to the device,
+	// so revoking the device ends the session.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Id of the device from an earlier login, to log it in again instead of adding a device.
+	// A revoked or unknown device is replaced by a new one.
+	DeviceId      string `protobuf:"bytes,4,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginRequest) Reset() {
 	*x = LoginRequest{}
-	mi := &file_proto_v1_user_user_proto_msgTypes[2]
+	mi := &file_proto_v1_user_user_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -133,7 +292,7 @@ func (x *LoginRequest) String() string {
 func (*LoginRequest) ProtoMessage() {}
 
 func (x *LoginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_user_proto_msgTypes[2]
+	mi := &file_proto_v1_user_user_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -146,7 +305,7 @@ func (x *LoginRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{2}
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *LoginRequest) GetLogin() string {
@@ -163,16 +322,32 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
 type LoginResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Id of the device the token is bound to, to send with the next login.
+	DeviceId      string `protobuf:"bytes,2,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoginResponse) Reset() {
 	*x = LoginResponse{}
-	mi := &file_proto_v1_user_user_proto_msgTypes[3]
+	mi := &file_proto_v1_user_user_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -184,7 +359,7 @@ func (x *LoginResponse) String() string {
 func (*LoginResponse) ProtoMessage() {}
 
 func (x *LoginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_user_user_proto_msgTypes[3]
+	mi := &file_proto_v1_user_user_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -197,7 +372,7 @@ func (x *LoginResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
 func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{3}
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *LoginResponse) GetToken() string {
@@ -207,23 +382,1234 @@ func (x *LoginResponse) GetToken() string {
 	return ""
 }
 
-var File_proto_v1_user_user_proto protoreflect.FileDescriptor
+func (x *LoginResponse) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
 
-const file_proto_v1_user_user_proto_rawDesc = "" +
-	"\n" +
-	"\x18proto/v1/user/user.proto\x12\av1.user\x1a\x1cgoogle/api/annotations.proto\"C\n" +
-	"\x0fRegisterRequest\x12\x14\n" +
-	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x12\n" +
-	"\x10RegisterResponse\"@\n" +
-	"\fLoginRequest\x12\x14\n" +
-	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"%\n" +
-	"\rLoginResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xc7\x01\n" +
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ChangePasswordResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ListDevicesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesRequest) Reset() {
+	*x = ListDevicesRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesRequest) ProtoMessage() {}
+
+func (x *ListDevicesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListDevicesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{8}
+}
+
+type ListDevicesResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Devices       []*ListDevicesResponse_Device `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse) Reset() {
+	*x = ListDevicesResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse) ProtoMessage() {}
+
+func (x *ListDevicesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListDevicesResponse) GetDevices() []*ListDevicesResponse_Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+type RenameDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameDeviceRequest) Reset() {
+	*x = RenameDeviceRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameDeviceRequest) ProtoMessage() {}
+
+func (x *RenameDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RenameDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RenameDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameDeviceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameDeviceResponse) Reset() {
+	*x = RenameDeviceResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameDeviceResponse) ProtoMessage() {}
+
+func (x *RenameDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RenameDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{11}
+}
+
+type RevokeDeviceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDeviceRequest) Reset() {
+	*x = RevokeDeviceRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDeviceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDeviceRequest) ProtoMessage() {}
+
+func (x *RevokeDeviceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDeviceRequest.ProtoReflect.Descriptor instead.
+func (*RevokeDeviceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeDeviceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeDeviceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeDeviceResponse) Reset() {
+	*x = RevokeDeviceResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeDeviceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeDeviceResponse) ProtoMessage() {}
+
+func (x *RevokeDeviceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeDeviceResponse.ProtoReflect.Descriptor instead.
+func (*RevokeDeviceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{13}
+}
+
+type GetAccountUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageRequest) Reset() {
+	*x = GetAccountUsageRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageRequest) ProtoMessage() {}
+
+func (x *GetAccountUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{14}
+}
+
+type GetAccountUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Item counts by item type, like "login_password".
+	Items  map[string]int64 `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Vaults int64            `protobuf:"varint,2,opt,name=vaults,proto3" json:"vaults,omitempty"`
+	// Devices that are not revoked.
+	Devices      int64 `protobuf:"varint,3,opt,name=devices,proto3" json:"devices,omitempty"`
+	StorageBytes int64 `protobuf:"varint,4,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// Last sync of any device, unset if none has synced.
+	LastSyncAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountUsageResponse) Reset() {
+	*x = GetAccountUsageResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountUsageResponse) ProtoMessage() {}
+
+func (x *GetAccountUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountUsageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetAccountUsageResponse) GetItems() map[string]int64 {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetAccountUsageResponse) GetVaults() int64 {
+	if x != nil {
+		return x.Vaults
+	}
+	return 0
+}
+
+func (x *GetAccountUsageResponse) GetDevices() int64 {
+	if x != nil {
+		return x.Devices
+	}
+	return 0
+}
+
+func (x *GetAccountUsageResponse) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *GetAccountUsageResponse) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+type CreateRecoveryKitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecoveryKitRequest) Reset() {
+	*x = CreateRecoveryKitRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecoveryKitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecoveryKitRequest) ProtoMessage() {}
+
+func (x *CreateRecoveryKitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecoveryKitRequest.ProtoReflect.Descriptor instead.
+func (*CreateRecoveryKitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{16}
+}
+
+type CreateRecoveryKitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecoveryCode  string                 `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRecoveryKitResponse) Reset() {
+	*x = CreateRecoveryKitResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRecoveryKitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRecoveryKitResponse) ProtoMessage() {}
+
+func (x *CreateRecoveryKitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRecoveryKitResponse.ProtoReflect.Descriptor instead.
+func (*CreateRecoveryKitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateRecoveryKitResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRecoveryKitResponse) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+func (x *CreateRecoveryKitResponse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SetRecoveryKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The code of the current kit.
+	RecoveryCode string `protobuf:"bytes,1,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	// Key material wrapped with a key derived from the recovery code, at most 4 KiB.
+	WrappedKey    []byte `protobuf:"bytes,2,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecoveryKeyRequest) Reset() {
+	*x = SetRecoveryKeyRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecoveryKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryKeyRequest) ProtoMessage() {}
+
+func (x *SetRecoveryKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryKeyRequest.ProtoReflect.Descriptor instead.
+func (*SetRecoveryKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetRecoveryKeyRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+func (x *SetRecoveryKeyRequest) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+type SetRecoveryKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRecoveryKeyResponse) Reset() {
+	*x = SetRecoveryKeyResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRecoveryKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRecoveryKeyResponse) ProtoMessage() {}
+
+func (x *SetRecoveryKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRecoveryKeyResponse.ProtoReflect.Descriptor instead.
+func (*SetRecoveryKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{19}
+}
+
+type RecoverAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Login string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	// Case, spaces and dashes are ignored.
+	RecoveryCode string `protobuf:"bytes,2,opt,name=recovery_code,json=recoveryCode,proto3" json:"recovery_code,omitempty"`
+	// Name of the device recovering the account, the token is bound to it as on login.
+	DeviceName string `protobuf:"bytes,3,opt,name=device_name,json=deviceName,proto3" json:"device_name,omitempty"`
+	// Replaces the forgotten password. 8 to 1024 characters, the code is kept if it is invalid.
+	NewPassword   string `protobuf:"bytes,4,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoverAccountRequest) Reset() {
+	*x = RecoverAccountRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverAccountRequest) ProtoMessage() {}
+
+func (x *RecoverAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverAccountRequest.ProtoReflect.Descriptor instead.
+func (*RecoverAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RecoverAccountRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *RecoverAccountRequest) GetRecoveryCode() string {
+	if x != nil {
+		return x.RecoveryCode
+	}
+	return ""
+}
+
+func (x *RecoverAccountRequest) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *RecoverAccountRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type RecoverAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WrappedKey    []byte                 `protobuf:"bytes,1,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoverAccountResponse) Reset() {
+	*x = RecoverAccountResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoverAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverAccountResponse) ProtoMessage() {}
+
+func (x *RecoverAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverAccountResponse.ProtoReflect.Descriptor instead.
+func (*RecoverAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RecoverAccountResponse) GetWrappedKey() []byte {
+	if x != nil {
+		return x.WrappedKey
+	}
+	return nil
+}
+
+func (x *RecoverAccountResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetAllowedNetworksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedNetworksRequest) Reset() {
+	*x = GetAllowedNetworksRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedNetworksRequest) ProtoMessage() {}
+
+func (x *GetAllowedNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedNetworksRequest.ProtoReflect.Descriptor instead.
+func (*GetAllowedNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{22}
+}
+
+type GetAllowedNetworksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// networks are in CIDR notation.
+	Networks      []string `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAllowedNetworksResponse) Reset() {
+	*x = GetAllowedNetworksResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllowedNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllowedNetworksResponse) ProtoMessage() {}
+
+func (x *GetAllowedNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllowedNetworksResponse.ProtoReflect.Descriptor instead.
+func (*GetAllowedNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetAllowedNetworksResponse) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type SetAllowedNetworksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// networks are in CIDR notation, or single addresses.
+	Networks      []string `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAllowedNetworksRequest) Reset() {
+	*x = SetAllowedNetworksRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllowedNetworksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowedNetworksRequest) ProtoMessage() {}
+
+func (x *SetAllowedNetworksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowedNetworksRequest.ProtoReflect.Descriptor instead.
+func (*SetAllowedNetworksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetAllowedNetworksRequest) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type SetAllowedNetworksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// networks are the stored networks, in CIDR notation.
+	Networks      []string `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAllowedNetworksResponse) Reset() {
+	*x = SetAllowedNetworksResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAllowedNetworksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAllowedNetworksResponse) ProtoMessage() {}
+
+func (x *SetAllowedNetworksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAllowedNetworksResponse.ProtoReflect.Descriptor instead.
+func (*SetAllowedNetworksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetAllowedNetworksResponse) GetNetworks() []string {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+type ExportPersonalDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPersonalDataRequest) Reset() {
+	*x = ExportPersonalDataRequest{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPersonalDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPersonalDataRequest) ProtoMessage() {}
+
+func (x *ExportPersonalDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPersonalDataRequest.ProtoReflect.Descriptor instead.
+func (*ExportPersonalDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{26}
+}
+
+type ExportPersonalDataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// archive is a zip of manifest.json and one JSON file for each kind of data:
+	// the account, vaults, items, devices, shares and trusted contacts.
+	Archive []byte `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// filename is a name to save the archive as.
+	Filename      string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPersonalDataResponse) Reset() {
+	*x = ExportPersonalDataResponse{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPersonalDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPersonalDataResponse) ProtoMessage() {}
+
+func (x *ExportPersonalDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPersonalDataResponse.ProtoReflect.Descriptor instead.
+func (*ExportPersonalDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExportPersonalDataResponse) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+func (x *ExportPersonalDataResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type ListDevicesResponse_Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastSyncAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_sync_at,json=lastSyncAt,proto3" json:"last_sync_at,omitempty"`
+	Revoked       bool                   `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDevicesResponse_Device) Reset() {
+	*x = ListDevicesResponse_Device{}
+	mi := &file_proto_v1_user_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDevicesResponse_Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDevicesResponse_Device) ProtoMessage() {}
+
+func (x *ListDevicesResponse_Device) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_user_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDevicesResponse_Device.ProtoReflect.Descriptor instead.
+func (*ListDevicesResponse_Device) Descriptor() ([]byte, []int) {
+	return file_proto_v1_user_user_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *ListDevicesResponse_Device) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListDevicesResponse_Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListDevicesResponse_Device) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse_Device) GetLastSyncAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSyncAt
+	}
+	return nil
+}
+
+func (x *ListDevicesResponse_Device) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+var File_proto_v1_user_user_proto protoreflect.FileDescriptor
+
+const file_proto_v1_user_user_proto_rawDesc = "" +
+	"\n" +
+	"\x18proto/v1/user/user.proto\x12\av1.user\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1eproto/v1/options/options.proto\"\xd6\x01\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vinvite_code\x18\x03 \x01(\tR\n" +
+	"inviteCode\x12\x1c\n" +
+	"\tchallenge\x18\x04 \x01(\tR\tchallenge\x12-\n" +
+	"\x12challenge_solution\x18\x05 \x01(\tR\x11challengeSolution\x12#\n" +
+	"\rcaptcha_token\x18\x06 \x01(\tR\fcaptchaToken\"\x12\n" +
+	"\x10RegisterResponse\"!\n" +
+	"\x1fGetRegistrationChallengeRequest\"\xda\x01\n" +
+	" GetRegistrationChallengeResponse\x12\x12\n" +
+	"\x04open\x18\x01 \x01(\bR\x04open\x12\x1c\n" +
+	"\tchallenge\x18\x02 \x01(\tR\tchallenge\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\x05R\n" +
+	"difficulty\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12)\n" +
+	"\x10captcha_required\x18\x05 \x01(\bR\x0fcaptchaRequired\"~\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12\x1b\n" +
+	"\tdevice_id\x18\x04 \x01(\tR\bdeviceId\"B\n" +
+	"\rLoginResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1b\n" +
+	"\tdevice_id\x18\x02 \x01(\tR\bdeviceId\"o\n" +
+	"\x15ChangePasswordRequest\x12.\n" +
+	"\x10current_password\x18\x01 \x01(\tB\x03\x80\x01\x01R\x0fcurrentPassword\x12&\n" +
+	"\fnew_password\x18\x02 \x01(\tB\x03\x80\x01\x01R\vnewPassword\".\n" +
+	"\x16ChangePasswordResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x14\n" +
+	"\x12ListDevicesRequest\"\x96\x02\n" +
+	"\x13ListDevicesResponse\x12=\n" +
+	"\adevices\x18\x01 \x03(\v2#.v1.user.ListDevicesResponse.DeviceR\adevices\x1a\xbf\x01\n" +
+	"\x06Device\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_sync_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncAt\x12\x18\n" +
+	"\arevoked\x18\x05 \x01(\bR\arevoked\"9\n" +
+	"\x13RenameDeviceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x16\n" +
+	"\x14RenameDeviceResponse\"%\n" +
+	"\x13RevokeDeviceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x16\n" +
+	"\x14RevokeDeviceResponse\"\x18\n" +
+	"\x16GetAccountUsageRequest\"\xab\x02\n" +
+	"\x17GetAccountUsageResponse\x12A\n" +
+	"\x05items\x18\x01 \x03(\v2+.v1.user.GetAccountUsageResponse.ItemsEntryR\x05items\x12\x16\n" +
+	"\x06vaults\x18\x02 \x01(\x03R\x06vaults\x12\x18\n" +
+	"\adevices\x18\x03 \x01(\x03R\adevices\x12#\n" +
+	"\rstorage_bytes\x18\x04 \x01(\x03R\fstorageBytes\x12<\n" +
+	"\flast_sync_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSyncAt\x1a8\n" +
+	"\n" +
+	"ItemsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"\x1a\n" +
+	"\x18CreateRecoveryKitRequest\"\x99\x01\n" +
+	"\x19CreateRecoveryKitResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12(\n" +
+	"\rrecovery_code\x18\x02 \x01(\tB\x03\x80\x01\x01R\frecoveryCode\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"g\n" +
+	"\x15SetRecoveryKeyRequest\x12(\n" +
+	"\rrecovery_code\x18\x01 \x01(\tB\x03\x80\x01\x01R\frecoveryCode\x12$\n" +
+	"\vwrapped_key\x18\x02 \x01(\fB\x03\x80\x01\x01R\n" +
+	"wrappedKey\"\x18\n" +
+	"\x16SetRecoveryKeyResponse\"\xa0\x01\n" +
+	"\x15RecoverAccountRequest\x12\x14\n" +
+	"\x05login\x18\x01 \x01(\tR\x05login\x12(\n" +
+	"\rrecovery_code\x18\x02 \x01(\tB\x03\x80\x01\x01R\frecoveryCode\x12\x1f\n" +
+	"\vdevice_name\x18\x03 \x01(\tR\n" +
+	"deviceName\x12&\n" +
+	"\fnew_password\x18\x04 \x01(\tB\x03\x80\x01\x01R\vnewPassword\"T\n" +
+	"\x16RecoverAccountResponse\x12$\n" +
+	"\vwrapped_key\x18\x01 \x01(\fB\x03\x80\x01\x01R\n" +
+	"wrappedKey\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\"\x1b\n" +
+	"\x19GetAllowedNetworksRequest\"8\n" +
+	"\x1aGetAllowedNetworksResponse\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x03(\tR\bnetworks\"7\n" +
+	"\x19SetAllowedNetworksRequest\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x03(\tR\bnetworks\"8\n" +
+	"\x1aSetAllowedNetworksResponse\x12\x1a\n" +
+	"\bnetworks\x18\x01 \x03(\tR\bnetworks\"\x1b\n" +
+	"\x19ExportPersonalDataRequest\"W\n" +
+	"\x1aExportPersonalDataResponse\x12\x1d\n" +
+	"\aarchive\x18\x01 \x01(\fB\x03\x80\x01\x01R\aarchive\x12\x1a\n" +
+	"\bfilename\x18\x02 \x01(\tR\bfilename2\x94\x0e\n" +
 	"\vUserService\x12a\n" +
-	"\bRegister\x12\x18.v1.user.RegisterRequest\x1a\x19.v1.user.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/user/register\x12U\n" +
-	"\x05Login\x12\x15.v1.user.LoginRequest\x1a\x16.v1.user.LoginResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/user/loginB5Z3github.com/cmrd-a/GophKeeper/gen/proto/v1/user;userb\x06proto3"
+	"\bRegister\x12\x18.v1.user.RegisterRequest\x1a\x19.v1.user.RegisterResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/user/register\x12\xaa\x01\n" +
+	"\x18GetRegistrationChallenge\x12(.v1.user.GetRegistrationChallengeRequest\x1a).v1.user.GetRegistrationChallengeResponse\"9\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/user/get-registration-challenge\x90\x02\x01\x12Y\n" +
+	"\x05Login\x12\x15.v1.user.LoginRequest\x1a\x16.v1.user.LoginResponse\"!\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/user/login\x12z\n" +
+	"\x0eChangePassword\x12\x1e.v1.user.ChangePasswordRequest\x1a\x1f.v1.user.ChangePasswordResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/user/change-password\x12u\n" +
+	"\vListDevices\x12\x1b.v1.user.ListDevicesRequest\x1a\x1c.v1.user.ListDevicesResponse\"+\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/user/list-devices\x90\x02\x01\x12r\n" +
+	"\fRenameDevice\x12\x1c.v1.user.RenameDeviceRequest\x1a\x1d.v1.user.RenameDeviceResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/user/rename-device\x12r\n" +
+	"\fRevokeDevice\x12\x1c.v1.user.RevokeDeviceRequest\x1a\x1d.v1.user.RevokeDeviceResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/user/revoke-device\x12\x86\x01\n" +
+	"\x0fGetAccountUsage\x12\x1f.v1.user.GetAccountUsageRequest\x1a .v1.user.GetAccountUsageResponse\"0\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/user/get-account-usage\x90\x02\x01\x12\x87\x01\n" +
+	"\x11CreateRecoveryKit\x12!.v1.user.CreateRecoveryKitRequest\x1a\".v1.user.CreateRecoveryKitResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/user/create-recovery-kit\x12{\n" +
+	"\x0eSetRecoveryKey\x12\x1e.v1.user.SetRecoveryKeyRequest\x1a\x1f.v1.user.SetRecoveryKeyResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/user/set-recovery-key\x12z\n" +
+	"\x0eRecoverAccount\x12\x1e.v1.user.RecoverAccountRequest\x1a\x1f.v1.user.RecoverAccountResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/user/recover-account\x12\x92\x01\n" +
+	"\x12GetAllowedNetworks\x12\".v1.user.GetAllowedNetworksRequest\x1a#.v1.user.GetAllowedNetworksResponse\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/user/get-allowed-networks\x90\x02\x01\x12\x8b\x01\n" +
+	"\x12SetAllowedNetworks\x12\".v1.user.SetAllowedNetworksRequest\x1a#.v1.user.SetAllowedNetworksResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/user/set-allowed-networks\x12\x8f\x01\n" +
+	"\x12ExportPersonalData\x12\".v1.user.ExportPersonalDataRequest\x1a#.v1.user.ExportPersonalDataResponse\"0\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/user/export-personal-dataB5Z3github.com/cmrd-a/GophKeeper/gen/proto/v1/user;userb\x06proto3"
 
 var (
 	file_proto_v1_user_user_proto_rawDescOnce sync.Once
@@ -237,23 +1623,81 @@ func file_proto_v1_user_user_proto_rawDescGZIP() []byte {
 	return file_proto_v1_user_user_proto_rawDescData
 }
 
-var file_proto_v1_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_v1_user_user_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_proto_v1_user_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),  // 0: v1.user.RegisterRequest
-	(*RegisterResponse)(nil), // 1: v1.user.RegisterResponse
-	(*LoginRequest)(nil),     // 2: v1.user.LoginRequest
-	(*LoginResponse)(nil),    // 3: v1.user.LoginResponse
+	(*RegisterRequest)(nil),                  // 0: v1.user.RegisterRequest
+	(*RegisterResponse)(nil),                 // 1: v1.user.RegisterResponse
+	(*GetRegistrationChallengeRequest)(nil),  // 2: v1.user.GetRegistrationChallengeRequest
+	(*GetRegistrationChallengeResponse)(nil), // 3: v1.user.GetRegistrationChallengeResponse
+	(*LoginRequest)(nil),                     // 4: v1.user.LoginRequest
+	(*LoginResponse)(nil),                    // 5: v1.user.LoginResponse
+	(*ChangePasswordRequest)(nil),            // 6: v1.user.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),           // 7: v1.user.ChangePasswordResponse
+	(*ListDevicesRequest)(nil),               // 8: v1.user.ListDevicesRequest
+	(*ListDevicesResponse)(nil),              // 9: v1.user.ListDevicesResponse
+	(*RenameDeviceRequest)(nil),              // 10: v1.user.RenameDeviceRequest
+	(*RenameDeviceResponse)(nil),             // 11: v1.user.RenameDeviceResponse
+	(*RevokeDeviceRequest)(nil),              // 12: v1.user.RevokeDeviceRequest
+	(*RevokeDeviceResponse)(nil),             // 13: v1.user.RevokeDeviceResponse
+	(*GetAccountUsageRequest)(nil),           // 14: v1.user.GetAccountUsageRequest
+	(*GetAccountUsageResponse)(nil),          // 15: v1.user.GetAccountUsageResponse
+	(*CreateRecoveryKitRequest)(nil),         // 16: v1.user.CreateRecoveryKitRequest
+	(*CreateRecoveryKitResponse)(nil),        // 17: v1.user.CreateRecoveryKitResponse
+	(*SetRecoveryKeyRequest)(nil),            // 18: v1.user.SetRecoveryKeyRequest
+	(*SetRecoveryKeyResponse)(nil),           // 19: v1.user.SetRecoveryKeyResponse
+	(*RecoverAccountRequest)(nil),            // 20: v1.user.RecoverAccountRequest
+	(*RecoverAccountResponse)(nil),           // 21: v1.user.RecoverAccountResponse
+	(*GetAllowedNetworksRequest)(nil),        // 22: v1.user.GetAllowedNetworksRequest
+	(*GetAllowedNetworksResponse)(nil),       // 23: v1.user.GetAllowedNetworksResponse
+	(*SetAllowedNetworksRequest)(nil),        // 24: v1.user.SetAllowedNetworksRequest
+	(*SetAllowedNetworksResponse)(nil),       // 25: v1.user.SetAllowedNetworksResponse
+	(*ExportPersonalDataRequest)(nil),        // 26: v1.user.ExportPersonalDataRequest
+	(*ExportPersonalDataResponse)(nil),       // 27: v1.user.ExportPersonalDataResponse
+	(*ListDevicesResponse_Device)(nil),       // 28: v1.user.ListDevicesResponse.Device
+	nil,                                      // 29: v1.user.GetAccountUsageResponse.ItemsEntry
+	(*timestamppb.Timestamp)(nil),            // 30: google.protobuf.Timestamp
 }
 var file_proto_v1_user_user_proto_depIdxs = []int32{
-	0, // 0: v1.user.UserService.Register:input_type -> v1.user.RegisterRequest
-	2, // 1: v1.user.UserService.Login:input_type -> v1.user.LoginRequest
-	1, // 2: v1.user.UserService.Register:output_type -> v1.user.RegisterResponse
-	3, // 3: v1.user.UserService.Login:output_type -> v1.user.LoginResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	30, // 0: v1.user.GetRegistrationChallengeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	28, // 1: v1.user.ListDevicesResponse.devices:type_name -> v1.user.ListDevicesResponse.Device
+	29, // 2: v1.user.GetAccountUsageResponse.items:type_name -> v1.user.GetAccountUsageResponse.ItemsEntry
+	30, // 3: v1.user.GetAccountUsageResponse.last_sync_at:type_name -> google.protobuf.Timestamp
+	30, // 4: v1.user.CreateRecoveryKitResponse.created_at:type_name -> google.protobuf.Timestamp
+	30, // 5: v1.user.ListDevicesResponse.Device.created_at:type_name -> google.protobuf.Timestamp
+	30, // 6: v1.user.ListDevicesResponse.Device.last_sync_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: v1.user.UserService.Register:input_type -> v1.user.RegisterRequest
+	2,  // 8: v1.user.UserService.GetRegistrationChallenge:input_type -> v1.user.GetRegistrationChallengeRequest
+	4,  // 9: v1.user.UserService.Login:input_type -> v1.user.LoginRequest
+	6,  // 10: v1.user.UserService.ChangePassword:input_type -> v1.user.ChangePasswordRequest
+	8,  // 11: v1.user.UserService.ListDevices:input_type -> v1.user.ListDevicesRequest
+	10, // 12: v1.user.UserService.RenameDevice:input_type -> v1.user.RenameDeviceRequest
+	12, // 13: v1.user.UserService.RevokeDevice:input_type -> v1.user.RevokeDeviceRequest
+	14, // 14: v1.user.UserService.GetAccountUsage:input_type -> v1.user.GetAccountUsageRequest
+	16, // 15: v1.user.UserService.CreateRecoveryKit:input_type -> v1.user.CreateRecoveryKitRequest
+	18, // 16: v1.user.UserService.SetRecoveryKey:input_type -> v1.user.SetRecoveryKeyRequest
+	20, // 17: v1.user.UserService.RecoverAccount:input_type -> v1.user.RecoverAccountRequest
+	22, // 18: v1.user.UserService.GetAllowedNetworks:input_type -> v1.user.GetAllowedNetworksRequest
+	24, // 19: v1.user.UserService.SetAllowedNetworks:input_type -> v1.user.SetAllowedNetworksRequest
+	26, // 20: v1.user.UserService.ExportPersonalData:input_type -> v1.user.ExportPersonalDataRequest
+	1,  // 21: v1.user.UserService.Register:output_type -> v1.user.RegisterResponse
+	3,  // 22: v1.user.UserService.GetRegistrationChallenge:output_type -> v1.user.GetRegistrationChallengeResponse
+	5,  // 23: v1.user.UserService.Login:output_type -> v1.user.LoginResponse
+	7,  // 24: v1.user.UserService.ChangePassword:output_type -> v1.user.ChangePasswordResponse
+	9,  // 25: v1.user.UserService.ListDevices:output_type -> v1.user.ListDevicesResponse
+	11, // 26: v1.user.UserService.RenameDevice:output_type -> v1.user.RenameDeviceResponse
+	13, // 27: v1.user.UserService.RevokeDevice:output_type -> v1.user.RevokeDeviceResponse
+	15, // 28: v1.user.UserService.GetAccountUsage:output_type -> v1.user.GetAccountUsageResponse
+	17, // 29: v1.user.UserService.CreateRecoveryKit:output_type -> v1.user.CreateRecoveryKitResponse
+	19, // 30: v1.user.UserService.SetRecoveryKey:output_type -> v1.user.SetRecoveryKeyResponse
+	21, // 31: v1.user.UserService.RecoverAccount:output_type -> v1.user.RecoverAccountResponse
+	23, // 32: v1.user.UserService.GetAllowedNetworks:output_type -> v1.user.GetAllowedNetworksResponse
+	25, // 33: v1.user.UserService.SetAllowedNetworks:output_type -> v1.user.SetAllowedNetworksResponse
+	27, // 34: v1.user.UserService.ExportPersonalData:output_type -> v1.user.ExportPersonalDataResponse
+	21, // [21:35] is the sub-list for method output_type
+	7,  // [7:21] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_user_user_proto_init() }
@@ -267,7 +1711,7 @@ func file_proto_v1_user_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_user_user_proto_rawDesc), len(file_proto_v1_user_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
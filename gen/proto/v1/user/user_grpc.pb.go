@@ -20,8 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_Register_FullMethodName = "/v1.user.UserService/Register"
-	UserService_Login_FullMethodName    = "/v1.user.UserService/Login"
+	UserService_Register_FullMethodName                 = "/v1.user.UserService/Register"
+	UserService_GetRegistrationChallenge_FullMethodName = "/v1.user.UserService/GetRegistrationChallenge"
+	UserService_Login_FullMethodName                    = "/v1.user.UserService/Login"
+	UserService_ChangePassword_FullMethodName           = "/v1.user.UserService/ChangePassword"
+	UserService_ListDevices_FullMethodName              = "/v1.user.UserService/ListDevices"
+	UserService_RenameDevice_FullMethodName             = "/v1.user.UserService/RenameDevice"
+	UserService_RevokeDevice_FullMethodName             = "/v1.user.UserService/RevokeDevice"
+	UserService_GetAccountUsage_FullMethodName          = "/v1.user.UserService/GetAccountUsage"
+	UserService_CreateRecoveryKit_FullMethodName        = "/v1.user.UserService/CreateRecoveryKit"
+	UserService_SetRecoveryKey_FullMethodName           = "/v1.user.UserService/SetRecoveryKey"
+	UserService_RecoverAccount_FullMethodName           = "/v1.user.UserService/RecoverAccount"
+	UserService_GetAllowedNetworks_FullMethodName       = "/v1.user.UserService/GetAllowedNetworks"
+	UserService_SetAllowedNetworks_FullMethodName       = "/v1.user.UserService/SetAllowedNetworks"
+	UserService_ExportPersonalData_FullMethodName       = "/v1.user.UserService/ExportPersonalData"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -30,8 +42,36 @@ const (
 //
 // UserService service definition
 type UserServiceClient interface {
+	// Register creates an account, if it passes the registration checks the server requires,
+	// see GetRegistrationChallenge.
 	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	// GetRegistrationChallenge returns the checks Register requires, with a proof-of-work challenge if enabled.
+	GetRegistrationChallenge(ctx context.Context, in *GetRegistrationChallengeRequest, opts ...grpc.CallOption) (*GetRegistrationChallengeResponse, error)
+	// Login issues a new access token but does not change vault data, so read-only clients may log in.
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	// ChangePassword replaces the password and revokes every access token of the account,
+	// returning a new token for the caller.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error)
+	RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*RenameDeviceResponse, error)
+	RevokeDevice(ctx context.Context, in *RevokeDeviceRequest, opts ...grpc.CallOption) (*RevokeDeviceResponse, error)
+	GetAccountUsage(ctx context.Context, in *GetAccountUsageRequest, opts ...grpc.CallOption) (*GetAccountUsageResponse, error)
+	// CreateRecoveryKit replaces the user's recovery kit with a new recovery code.
+	// The client then wraps its key material with the code and stores it with SetRecoveryKey.
+	CreateRecoveryKit(ctx context.Context, in *CreateRecoveryKitRequest, opts ...grpc.CallOption) (*CreateRecoveryKitResponse, error)
+	SetRecoveryKey(ctx context.Context, in *SetRecoveryKeyRequest, opts ...grpc.CallOption) (*SetRecoveryKeyResponse, error)
+	// RecoverAccount logs in with a recovery code instead of the password and replaces the password.
+	// Each code works once.
+	RecoverAccount(ctx context.Context, in *RecoverAccountRequest, opts ...grpc.CallOption) (*RecoverAccountResponse, error)
+	// GetAllowedNetworks returns the networks the account can be accessed from, empty if any.
+	GetAllowedNetworks(ctx context.Context, in *GetAllowedNetworksRequest, opts ...grpc.CallOption) (*GetAllowedNetworksResponse, error)
+	// SetAllowedNetworks replaces the networks the account can be accessed from, an empty list allows any.
+	// A list that excludes the caller's own address is refused, so users can't lock themselves out.
+	// Calls through the HTTP gateway come from the address of its client.
+	SetAllowedNetworks(ctx context.Context, in *SetAllowedNetworksRequest, opts ...grpc.CallOption) (*SetAllowedNetworksResponse, error)
+	// ExportPersonalData returns everything stored about the user, for data portability requests.
+	// Each user can export a few times a day, every export is logged.
+	ExportPersonalData(ctx context.Context, in *ExportPersonalDataRequest, opts ...grpc.CallOption) (*ExportPersonalDataResponse, error)
 }
 
 type userServiceClient struct {
@@ -52,6 +92,16 @@ func (c *userServiceClient) Register(ctx context.Context, in *RegisterRequest, o
 	return out, nil
 }
 
+func (c *userServiceClient) GetRegistrationChallenge(ctx context.Context, in *GetRegistrationChallengeRequest, opts ...grpc.CallOption) (*GetRegistrationChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRegistrationChallengeResponse)
+	err := c.cc.Invoke(ctx, UserService_GetRegistrationChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LoginResponse)
@@ -62,14 +112,152 @@ func (c *userServiceClient) Login(ctx context.Context, in *LoginRequest, opts ..
 	return out, nil
 }
 
+func (c *userServiceClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, UserService_ChangePassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListDevices(ctx context.Context, in *ListDevicesRequest, opts ...grpc.CallOption) (*ListDevicesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDevicesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListDevices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RenameDevice(ctx context.Context, in *RenameDeviceRequest, opts ...grpc.CallOption) (*RenameDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameDeviceResponse)
+	err := c.cc.Invoke(ctx, UserService_RenameDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeDevice(ctx context.Context, in *RevokeDeviceRequest, opts ...grpc.CallOption) (*RevokeDeviceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeDeviceResponse)
+	err := c.cc.Invoke(ctx, UserService_RevokeDevice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAccountUsage(ctx context.Context, in *GetAccountUsageRequest, opts ...grpc.CallOption) (*GetAccountUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountUsageResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAccountUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateRecoveryKit(ctx context.Context, in *CreateRecoveryKitRequest, opts ...grpc.CallOption) (*CreateRecoveryKitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRecoveryKitResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateRecoveryKit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetRecoveryKey(ctx context.Context, in *SetRecoveryKeyRequest, opts ...grpc.CallOption) (*SetRecoveryKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRecoveryKeyResponse)
+	err := c.cc.Invoke(ctx, UserService_SetRecoveryKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RecoverAccount(ctx context.Context, in *RecoverAccountRequest, opts ...grpc.CallOption) (*RecoverAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecoverAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_RecoverAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetAllowedNetworks(ctx context.Context, in *GetAllowedNetworksRequest, opts ...grpc.CallOption) (*GetAllowedNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAllowedNetworksResponse)
+	err := c.cc.Invoke(ctx, UserService_GetAllowedNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetAllowedNetworks(ctx context.Context, in *SetAllowedNetworksRequest, opts ...grpc.CallOption) (*SetAllowedNetworksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetAllowedNetworksResponse)
+	err := c.cc.Invoke(ctx, UserService_SetAllowedNetworks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportPersonalData(ctx context.Context, in *ExportPersonalDataRequest, opts ...grpc.CallOption) (*ExportPersonalDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportPersonalDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportPersonalData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
 //
 // UserService service definition
 type UserServiceServer interface {
+	// Register creates an account, if it passes the registration checks the server requires,
+	// see GetRegistrationChallenge.
 	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	// GetRegistrationChallenge returns the checks Register requires, with a proof-of-work challenge if enabled.
+	GetRegistrationChallenge(context.Context, *GetRegistrationChallengeRequest) (*GetRegistrationChallengeResponse, error)
+	// Login issues a new access token but does not change vault data, so read-only clients may log in.
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	// ChangePassword replaces the password and revokes every access token of the account,
+	// returning a new token for the caller.
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error)
+	RenameDevice(context.Context, *RenameDeviceRequest) (*RenameDeviceResponse, error)
+	RevokeDevice(context.Context, *RevokeDeviceRequest) (*RevokeDeviceResponse, error)
+	GetAccountUsage(context.Context, *GetAccountUsageRequest) (*GetAccountUsageResponse, error)
+	// CreateRecoveryKit replaces the user's recovery kit with a new recovery code.
+	// The client then wraps its key material with the code and stores it with SetRecoveryKey.
+	CreateRecoveryKit(context.Context, *CreateRecoveryKitRequest) (*CreateRecoveryKitResponse, error)
+	SetRecoveryKey(context.Context, *SetRecoveryKeyRequest) (*SetRecoveryKeyResponse, error)
+	// RecoverAccount logs in with a recovery code instead of the password and replaces the password.
+	// Each code works once.
+	RecoverAccount(context.Context, *RecoverAccountRequest) (*RecoverAccountResponse, error)
+	// GetAllowedNetworks returns the networks the account can be accessed from, empty if any.
+	GetAllowedNetworks(context.Context, *GetAllowedNetworksRequest) (*GetAllowedNetworksResponse, error)
+	// SetAllowedNetworks replaces the networks the account can be accessed from, an empty list allows any.
+	// A list that excludes the caller's own address is refused, so users can't lock themselves out.
+	// Calls through the HTTP gateway come from the address of its client.
+	SetAllowedNetworks(context.Context, *SetAllowedNetworksRequest) (*SetAllowedNetworksResponse, error)
+	// ExportPersonalData returns everything stored about the user, for data portability requests.
+	// Each user can export a few times a day, every export is logged.
+	ExportPersonalData(context.Context, *ExportPersonalDataRequest) (*ExportPersonalDataResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -83,9 +271,45 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
 }
+func (UnimplementedUserServiceServer) GetRegistrationChallenge(context.Context, *GetRegistrationChallengeRequest) (*GetRegistrationChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistrationChallenge not implemented")
+}
 func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedUserServiceServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangePassword not implemented")
+}
+func (UnimplementedUserServiceServer) ListDevices(context.Context, *ListDevicesRequest) (*ListDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDevices not implemented")
+}
+func (UnimplementedUserServiceServer) RenameDevice(context.Context, *RenameDeviceRequest) (*RenameDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameDevice not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeDevice(context.Context, *RevokeDeviceRequest) (*RevokeDeviceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDevice not implemented")
+}
+func (UnimplementedUserServiceServer) GetAccountUsage(context.Context, *GetAccountUsageRequest) (*GetAccountUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountUsage not implemented")
+}
+func (UnimplementedUserServiceServer) CreateRecoveryKit(context.Context, *CreateRecoveryKitRequest) (*CreateRecoveryKitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecoveryKit not implemented")
+}
+func (UnimplementedUserServiceServer) SetRecoveryKey(context.Context, *SetRecoveryKeyRequest) (*SetRecoveryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRecoveryKey not implemented")
+}
+func (UnimplementedUserServiceServer) RecoverAccount(context.Context, *RecoverAccountRequest) (*RecoverAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverAccount not implemented")
+}
+func (UnimplementedUserServiceServer) GetAllowedNetworks(context.Context, *GetAllowedNetworksRequest) (*GetAllowedNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllowedNetworks not implemented")
+}
+func (UnimplementedUserServiceServer) SetAllowedNetworks(context.Context, *SetAllowedNetworksRequest) (*SetAllowedNetworksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedNetworks not implemented")
+}
+func (UnimplementedUserServiceServer) ExportPersonalData(context.Context, *ExportPersonalDataRequest) (*ExportPersonalDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportPersonalData not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -125,6 +349,24 @@ func _UserService_Register_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetRegistrationChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistrationChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetRegistrationChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetRegistrationChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetRegistrationChallenge(ctx, req.(*GetRegistrationChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LoginRequest)
 	if err := dec(in); err != nil {
@@ -143,6 +385,204 @@ func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListDevices(ctx, req.(*ListDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RenameDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RenameDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RenameDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RenameDevice(ctx, req.(*RenameDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeDevice(ctx, req.(*RevokeDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAccountUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAccountUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAccountUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAccountUsage(ctx, req.(*GetAccountUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateRecoveryKit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecoveryKitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateRecoveryKit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateRecoveryKit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateRecoveryKit(ctx, req.(*CreateRecoveryKitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetRecoveryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRecoveryKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetRecoveryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetRecoveryKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetRecoveryKey(ctx, req.(*SetRecoveryKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RecoverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RecoverAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RecoverAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RecoverAccount(ctx, req.(*RecoverAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAllowedNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAllowedNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAllowedNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAllowedNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAllowedNetworks(ctx, req.(*GetAllowedNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetAllowedNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAllowedNetworksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetAllowedNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetAllowedNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetAllowedNetworks(ctx, req.(*SetAllowedNetworksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportPersonalData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPersonalDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportPersonalData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportPersonalData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportPersonalData(ctx, req.(*ExportPersonalDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -154,10 +594,58 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Register",
 			Handler:    _UserService_Register_Handler,
 		},
+		{
+			MethodName: "GetRegistrationChallenge",
+			Handler:    _UserService_GetRegistrationChallenge_Handler,
+		},
 		{
 			MethodName: "Login",
 			Handler:    _UserService_Login_Handler,
 		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _UserService_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ListDevices",
+			Handler:    _UserService_ListDevices_Handler,
+		},
+		{
+			MethodName: "RenameDevice",
+			Handler:    _UserService_RenameDevice_Handler,
+		},
+		{
+			MethodName: "RevokeDevice",
+			Handler:    _UserService_RevokeDevice_Handler,
+		},
+		{
+			MethodName: "GetAccountUsage",
+			Handler:    _UserService_GetAccountUsage_Handler,
+		},
+		{
+			MethodName: "CreateRecoveryKit",
+			Handler:    _UserService_CreateRecoveryKit_Handler,
+		},
+		{
+			MethodName: "SetRecoveryKey",
+			Handler:    _UserService_SetRecoveryKey_Handler,
+		},
+		{
+			MethodName: "RecoverAccount",
+			Handler:    _UserService_RecoverAccount_Handler,
+		},
+		{
+			MethodName: "GetAllowedNetworks",
+			Handler:    _UserService_GetAllowedNetworks_Handler,
+		},
+		{
+			MethodName: "SetAllowedNetworks",
+			Handler:    _UserService_SetAllowedNetworks_Handler,
+		},
+		{
+			MethodName: "ExportPersonalData",
+			Handler:    _UserService_ExportPersonalData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/user/user.proto",
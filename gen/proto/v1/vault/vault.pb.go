@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.9
+// 	protoc-gen-go v1.36.10
 // 	protoc        (unknown)
 // source: proto/v1/vault/vault.proto
 
@@ -12,38 +12,4195 @@ import (
 	unsafe "unsafe"
 
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+
+	_ "github.com/cmrd-a/GophKeeper/gen/proto/v1/options"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ItemType int32
+
+const (
+	ItemType_ITEM_TYPE_UNSPECIFIED     ItemType = 0
+	ItemType_ITEM_TYPE_LOGIN_PASSWORD  ItemType = 1
+	ItemType_ITEM_TYPE_WIFI_CREDENTIAL ItemType = 2
+	ItemType_ITEM_TYPE_SEED_PHRASE     ItemType = 3
+)
+
+// Enum value maps for ItemType.
+var (
+	ItemType_name = map[int32]string{
+		0: "ITEM_TYPE_UNSPECIFIED",
+		1: "ITEM_TYPE_LOGIN_PASSWORD",
+		2: "ITEM_TYPE_WIFI_CREDENTIAL",
+		3: "ITEM_TYPE_SEED_PHRASE",
+	}
+	ItemType_value = map[string]int32{
+		"ITEM_TYPE_UNSPECIFIED":     0,
+		"ITEM_TYPE_LOGIN_PASSWORD":  1,
+		"ITEM_TYPE_WIFI_CREDENTIAL": 2,
+		"ITEM_TYPE_SEED_PHRASE":     3,
+	}
+)
+
+func (x ItemType) Enum() *ItemType {
+	p := new(ItemType)
+	*p = x
+	return p
+}
+
+func (x ItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vault_vault_proto_enumTypes[0].Descriptor()
+}
+
+func (ItemType) Type() protoreflect.EnumType {
+	return &file_proto_v1_vault_vault_proto_enumTypes[0]
+}
+
+func (x ItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ItemType.Descriptor instead.
+func (ItemType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{0}
+}
+
+type WifiSecurity int32
+
+const (
+	WifiSecurity_WIFI_SECURITY_UNSPECIFIED WifiSecurity = 0
+	WifiSecurity_WIFI_SECURITY_NONE        WifiSecurity = 1
+	WifiSecurity_WIFI_SECURITY_WEP         WifiSecurity = 2
+	// WPA or WPA2 personal.
+	WifiSecurity_WIFI_SECURITY_WPA  WifiSecurity = 3
+	WifiSecurity_WIFI_SECURITY_WPA3 WifiSecurity = 4
+)
+
+// Enum value maps for WifiSecurity.
+var (
+	WifiSecurity_name = map[int32]string{
+		0: "WIFI_SECURITY_UNSPECIFIED",
+		1: "WIFI_SECURITY_NONE",
+		2: "WIFI_SECURITY_WEP",
+		3: "WIFI_SECURITY_WPA",
+		4: "WIFI_SECURITY_WPA3",
+	}
+	WifiSecurity_value = map[string]int32{
+		"WIFI_SECURITY_UNSPECIFIED": 0,
+		"WIFI_SECURITY_NONE":        1,
+		"WIFI_SECURITY_WEP":         2,
+		"WIFI_SECURITY_WPA":         3,
+		"WIFI_SECURITY_WPA3":        4,
+	}
+)
+
+func (x WifiSecurity) Enum() *WifiSecurity {
+	p := new(WifiSecurity)
+	*p = x
+	return p
+}
+
+func (x WifiSecurity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WifiSecurity) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vault_vault_proto_enumTypes[1].Descriptor()
+}
+
+func (WifiSecurity) Type() protoreflect.EnumType {
+	return &file_proto_v1_vault_vault_proto_enumTypes[1]
+}
+
+func (x WifiSecurity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WifiSecurity.Descriptor instead.
+func (WifiSecurity) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{1}
+}
+
+type Operation int32
+
+const (
+	Operation_OPERATION_UNSPECIFIED Operation = 0
+	Operation_OPERATION_CREATED     Operation = 1
+	Operation_OPERATION_UPDATED     Operation = 2
+	Operation_OPERATION_DELETED     Operation = 3
+)
+
+// Enum value maps for Operation.
+var (
+	Operation_name = map[int32]string{
+		0: "OPERATION_UNSPECIFIED",
+		1: "OPERATION_CREATED",
+		2: "OPERATION_UPDATED",
+		3: "OPERATION_DELETED",
+	}
+	Operation_value = map[string]int32{
+		"OPERATION_UNSPECIFIED": 0,
+		"OPERATION_CREATED":     1,
+		"OPERATION_UPDATED":     2,
+		"OPERATION_DELETED":     3,
+	}
+)
+
+func (x Operation) Enum() *Operation {
+	p := new(Operation)
+	*p = x
+	return p
+}
+
+func (x Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vault_vault_proto_enumTypes[2].Descriptor()
+}
+
+func (Operation) Type() protoreflect.EnumType {
+	return &file_proto_v1_vault_vault_proto_enumTypes[2]
+}
+
+func (x Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Operation.Descriptor instead.
+func (Operation) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{2}
+}
+
+type URLMatch int32
+
+const (
+	// Same as URL_MATCH_DOMAIN.
+	URLMatch_URL_MATCH_UNSPECIFIED URLMatch = 0
+	// Pages on the host of the URL and its subdomains.
+	URLMatch_URL_MATCH_DOMAIN URLMatch = 1
+	URLMatch_URL_MATCH_EXACT  URLMatch = 2
+	URLMatch_URL_MATCH_PREFIX URLMatch = 3
+)
+
+// Enum value maps for URLMatch.
+var (
+	URLMatch_name = map[int32]string{
+		0: "URL_MATCH_UNSPECIFIED",
+		1: "URL_MATCH_DOMAIN",
+		2: "URL_MATCH_EXACT",
+		3: "URL_MATCH_PREFIX",
+	}
+	URLMatch_value = map[string]int32{
+		"URL_MATCH_UNSPECIFIED": 0,
+		"URL_MATCH_DOMAIN":      1,
+		"URL_MATCH_EXACT":       2,
+		"URL_MATCH_PREFIX":      3,
+	}
+)
+
+func (x URLMatch) Enum() *URLMatch {
+	p := new(URLMatch)
+	*p = x
+	return p
+}
+
+func (x URLMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (URLMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vault_vault_proto_enumTypes[3].Descriptor()
+}
+
+func (URLMatch) Type() protoreflect.EnumType {
+	return &file_proto_v1_vault_vault_proto_enumTypes[3]
+}
+
+func (x URLMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use URLMatch.Descriptor instead.
+func (URLMatch) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{3}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	// Most recently used first, only items used at least once.
+	SortOrder_SORT_ORDER_RECENTLY_USED SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_RECENTLY_USED",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED":   0,
+		"SORT_ORDER_RECENTLY_USED": 1,
+	}
 )
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_v1_vault_vault_proto_enumTypes[4].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_v1_vault_vault_proto_enumTypes[4]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{4}
+}
+
+type WifiCredential struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VaultId  string                 `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Ssid     string                 `protobuf:"bytes,3,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	Security WifiSecurity           `protobuf:"varint,4,opt,name=security,proto3,enum=v1.vault.WifiSecurity" json:"security,omitempty"`
+	Password string                 `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// Hidden networks don't broadcast their SSID.
+	Hidden        bool                   `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Revision      int64                  `protobuf:"varint,8,opt,name=revision,proto3" json:"revision,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WifiCredential) Reset() {
+	*x = WifiCredential{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WifiCredential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WifiCredential) ProtoMessage() {}
+
+func (x *WifiCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WifiCredential.ProtoReflect.Descriptor instead.
+func (*WifiCredential) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WifiCredential) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WifiCredential) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *WifiCredential) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *WifiCredential) GetSecurity() WifiSecurity {
+	if x != nil {
+		return x.Security
+	}
+	return WifiSecurity_WIFI_SECURITY_UNSPECIFIED
+}
+
+func (x *WifiCredential) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WifiCredential) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *WifiCredential) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *WifiCredential) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *WifiCredential) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type LoginURL struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Match         URLMatch               `protobuf:"varint,2,opt,name=match,proto3,enum=v1.vault.URLMatch" json:"match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginURL) Reset() {
+	*x = LoginURL{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginURL) ProtoMessage() {}
+
+func (x *LoginURL) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginURL.ProtoReflect.Descriptor instead.
+func (*LoginURL) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LoginURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LoginURL) GetMatch() URLMatch {
+	if x != nil {
+		return x.Match
+	}
+	return URLMatch_URL_MATCH_UNSPECIFIED
+}
+
+type GetLoginPasswordsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sort  SortOrder              `protobuf:"varint,1,opt,name=sort,proto3,enum=v1.vault.SortOrder" json:"sort,omitempty"`
+	// With SORT_ORDER_RECENTLY_USED, only items used after this time.
+	UsedSince *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=used_since,json=usedSince,proto3" json:"used_since,omitempty"`
+	// With SORT_ORDER_RECENTLY_USED, at most this many items, up to 100.
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Fields of GetLoginPasswordsResponse.LoginPassword to return, all if unset.
+	// A mask without password lists items without their secrets.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,4,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginPasswordsRequest) Reset() {
+	*x = GetLoginPasswordsRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginPasswordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginPasswordsRequest) ProtoMessage() {}
+
+func (x *GetLoginPasswordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginPasswordsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoginPasswordsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLoginPasswordsRequest) GetSort() SortOrder {
+	if x != nil {
+		return x.Sort
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *GetLoginPasswordsRequest) GetUsedSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UsedSince
+	}
+	return nil
+}
+
+func (x *GetLoginPasswordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLoginPasswordsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type GetLoginPasswordsResponse struct {
+	state          protoimpl.MessageState                     `protogen:"open.v1"`
+	LoginPasswords []*GetLoginPasswordsResponse_LoginPassword `protobuf:"bytes,1,rep,name=login_passwords,json=loginPasswords,proto3" json:"login_passwords,omitempty"`
+	Revision       int64                                      `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLoginPasswordsResponse) Reset() {
+	*x = GetLoginPasswordsResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginPasswordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginPasswordsResponse) ProtoMessage() {}
+
+func (x *GetLoginPasswordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginPasswordsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoginPasswordsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLoginPasswordsResponse) GetLoginPasswords() []*GetLoginPasswordsResponse_LoginPassword {
+	if x != nil {
+		return x.LoginPasswords
+	}
+	return nil
+}
+
+func (x *GetLoginPasswordsResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetVaultItemsStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only items of this vault if set.
+	VaultId *string `protobuf:"bytes,1,opt,name=vault_id,json=vaultId,proto3,oneof" json:"vault_id,omitempty"`
+	// Fields of the VaultItem to return, like "login_password.id", all if unset.
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultItemsStreamRequest) Reset() {
+	*x = GetVaultItemsStreamRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultItemsStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultItemsStreamRequest) ProtoMessage() {}
+
+func (x *GetVaultItemsStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultItemsStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultItemsStreamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVaultItemsStreamRequest) GetVaultId() string {
+	if x != nil && x.VaultId != nil {
+		return *x.VaultId
+	}
+	return ""
+}
+
+func (x *GetVaultItemsStreamRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+type VaultItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Item:
+	//
+	//	*VaultItem_LoginPassword_
+	//	*VaultItem_WifiCredential
+	//	*VaultItem_SeedPhrase
+	Item isVaultItem_Item `protobuf_oneof:"item"`
+	// In streams, the vault revision when the listing started, the same on every item.
+	// From GetVaultItem, the revision of the item, for expected_revision.
+	Revision      int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultItem) Reset() {
+	*x = VaultItem{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultItem) ProtoMessage() {}
+
+func (x *VaultItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultItem.ProtoReflect.Descriptor instead.
+func (*VaultItem) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VaultItem) GetItem() isVaultItem_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *VaultItem) GetLoginPassword() *VaultItem_LoginPassword {
+	if x != nil {
+		if x, ok := x.Item.(*VaultItem_LoginPassword_); ok {
+			return x.LoginPassword
+		}
+	}
+	return nil
+}
+
+func (x *VaultItem) GetWifiCredential() *WifiCredential {
+	if x != nil {
+		if x, ok := x.Item.(*VaultItem_WifiCredential); ok {
+			return x.WifiCredential
+		}
+	}
+	return nil
+}
+
+func (x *VaultItem) GetSeedPhrase() *SeedPhrase {
+	if x != nil {
+		if x, ok := x.Item.(*VaultItem_SeedPhrase); ok {
+			return x.SeedPhrase
+		}
+	}
+	return nil
+}
+
+func (x *VaultItem) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type isVaultItem_Item interface {
+	isVaultItem_Item()
+}
+
+type VaultItem_LoginPassword_ struct {
+	LoginPassword *VaultItem_LoginPassword `protobuf:"bytes,1,opt,name=login_password,json=loginPassword,proto3,oneof"`
+}
+
+type VaultItem_WifiCredential struct {
+	WifiCredential *WifiCredential `protobuf:"bytes,3,opt,name=wifi_credential,json=wifiCredential,proto3,oneof"`
+}
+
+type VaultItem_SeedPhrase struct {
+	SeedPhrase *SeedPhrase `protobuf:"bytes,4,opt,name=seed_phrase,json=seedPhrase,proto3,oneof"`
+}
+
+func (*VaultItem_LoginPassword_) isVaultItem_Item() {}
+
+func (*VaultItem_WifiCredential) isVaultItem_Item() {}
+
+func (*VaultItem_SeedPhrase) isVaultItem_Item() {}
+
+type ListItemSummariesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only items of this vault if set.
+	VaultId         *string `protobuf:"bytes,1,opt,name=vault_id,json=vaultId,proto3,oneof" json:"vault_id,omitempty"`
+	IncludeArchived bool    `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListItemSummariesRequest) Reset() {
+	*x = ListItemSummariesRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemSummariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemSummariesRequest) ProtoMessage() {}
+
+func (x *ListItemSummariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemSummariesRequest.ProtoReflect.Descriptor instead.
+func (*ListItemSummariesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListItemSummariesRequest) GetVaultId() string {
+	if x != nil && x.VaultId != nil {
+		return *x.VaultId
+	}
+	return ""
+}
+
+func (x *ListItemSummariesRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type ListItemSummariesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Pinned first, then in the user's order, then most recently updated first.
+	Items         []*ListItemSummariesResponse_ItemSummary `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemSummariesResponse) Reset() {
+	*x = ListItemSummariesResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemSummariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemSummariesResponse) ProtoMessage() {}
+
+func (x *ListItemSummariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemSummariesResponse.ProtoReflect.Descriptor instead.
+func (*ListItemSummariesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListItemSummariesResponse) GetItems() []*ListItemSummariesResponse_ItemSummary {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetVaultItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          ItemType               `protobuf:"varint,2,opt,name=type,proto3,enum=v1.vault.ItemType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultItemRequest) Reset() {
+	*x = GetVaultItemRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultItemRequest) ProtoMessage() {}
+
+func (x *GetVaultItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultItemRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetVaultItemRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVaultItemRequest) GetType() ItemType {
+	if x != nil {
+		return x.Type
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+type SaveLoginPasswordRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	Login    string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Defaults to the first vault for new items and to the current vault on updates.
+	VaultId *string     `protobuf:"bytes,4,opt,name=vault_id,json=vaultId,proto3,oneof" json:"vault_id,omitempty"`
+	Urls    []*LoginURL `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+	// Updates fail with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,6,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SaveLoginPasswordRequest) Reset() {
+	*x = SaveLoginPasswordRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLoginPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLoginPasswordRequest) ProtoMessage() {}
+
+func (x *SaveLoginPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLoginPasswordRequest.ProtoReflect.Descriptor instead.
+func (*SaveLoginPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SaveLoginPasswordRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SaveLoginPasswordRequest) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *SaveLoginPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SaveLoginPasswordRequest) GetVaultId() string {
+	if x != nil && x.VaultId != nil {
+		return *x.VaultId
+	}
+	return ""
+}
+
+func (x *SaveLoginPasswordRequest) GetUrls() []*LoginURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *SaveLoginPasswordRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type SaveLoginPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveLoginPasswordResponse) Reset() {
+	*x = SaveLoginPasswordResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveLoginPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveLoginPasswordResponse) ProtoMessage() {}
+
+func (x *SaveLoginPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveLoginPasswordResponse.ProtoReflect.Descriptor instead.
+func (*SaveLoginPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SaveLoginPasswordResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type SeedPhrase struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VaultId string                 `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Name    string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// In order. Never logged, even by servers configured to log payloads.
+	Words         []string               `protobuf:"bytes,4,rep,name=words,proto3" json:"words,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Revision      int64                  `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	LastUsedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SeedPhrase) Reset() {
+	*x = SeedPhrase{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SeedPhrase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SeedPhrase) ProtoMessage() {}
+
+func (x *SeedPhrase) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SeedPhrase.ProtoReflect.Descriptor instead.
+func (*SeedPhrase) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SeedPhrase) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SeedPhrase) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *SeedPhrase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SeedPhrase) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *SeedPhrase) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SeedPhrase) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *SeedPhrase) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+type GetSeedPhrasesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeedPhrasesRequest) Reset() {
+	*x = GetSeedPhrasesRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeedPhrasesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeedPhrasesRequest) ProtoMessage() {}
+
+func (x *GetSeedPhrasesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeedPhrasesRequest.ProtoReflect.Descriptor instead.
+func (*GetSeedPhrasesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{12}
+}
+
+type GetSeedPhrasesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SeedPhrases   []*SeedPhrase          `protobuf:"bytes,1,rep,name=seed_phrases,json=seedPhrases,proto3" json:"seed_phrases,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSeedPhrasesResponse) Reset() {
+	*x = GetSeedPhrasesResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSeedPhrasesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSeedPhrasesResponse) ProtoMessage() {}
+
+func (x *GetSeedPhrasesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSeedPhrasesResponse.ProtoReflect.Descriptor instead.
+func (*GetSeedPhrasesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetSeedPhrasesResponse) GetSeedPhrases() []*SeedPhrase {
+	if x != nil {
+		return x.SeedPhrases
+	}
+	return nil
+}
+
+type SaveSeedPhraseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// Defaults to the first vault for new items and to the current vault on updates.
+	VaultId *string `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3,oneof" json:"vault_id,omitempty"`
+	// 1 to 64 characters.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 12 or 24 words, lowercased on save. Never logged, even by servers configured to log payloads.
+	Words []string `protobuf:"bytes,4,rep,name=words,proto3" json:"words,omitempty"`
+	// Updates fail with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,5,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SaveSeedPhraseRequest) Reset() {
+	*x = SaveSeedPhraseRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSeedPhraseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSeedPhraseRequest) ProtoMessage() {}
+
+func (x *SaveSeedPhraseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSeedPhraseRequest.ProtoReflect.Descriptor instead.
+func (*SaveSeedPhraseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SaveSeedPhraseRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SaveSeedPhraseRequest) GetVaultId() string {
+	if x != nil && x.VaultId != nil {
+		return *x.VaultId
+	}
+	return ""
+}
+
+func (x *SaveSeedPhraseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSeedPhraseRequest) GetWords() []string {
+	if x != nil {
+		return x.Words
+	}
+	return nil
+}
+
+func (x *SaveSeedPhraseRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type SaveSeedPhraseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSeedPhraseResponse) Reset() {
+	*x = SaveSeedPhraseResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSeedPhraseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSeedPhraseResponse) ProtoMessage() {}
+
+func (x *SaveSeedPhraseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSeedPhraseResponse.ProtoReflect.Descriptor instead.
+func (*SaveSeedPhraseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SaveSeedPhraseResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DeleteSeedPhraseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fails with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteSeedPhraseRequest) Reset() {
+	*x = DeleteSeedPhraseRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeedPhraseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeedPhraseRequest) ProtoMessage() {}
+
+func (x *DeleteSeedPhraseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeedPhraseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSeedPhraseRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeleteSeedPhraseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSeedPhraseRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type DeleteSeedPhraseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSeedPhraseResponse) Reset() {
+	*x = DeleteSeedPhraseResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSeedPhraseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSeedPhraseResponse) ProtoMessage() {}
+
+func (x *DeleteSeedPhraseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSeedPhraseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSeedPhraseResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeleteSeedPhraseResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetVaultStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultStatsRequest) Reset() {
+	*x = GetVaultStatsRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatsRequest) ProtoMessage() {}
+
+func (x *GetVaultStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{18}
+}
+
+type GetVaultStatsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Months with additions, oldest first.
+	AddedPerMonth []*GetVaultStatsResponse_MonthCount `protobuf:"bytes,1,rep,name=added_per_month,json=addedPerMonth,proto3" json:"added_per_month,omitempty"`
+	// Size of the secrets of each item type, like "login_password", in bytes.
+	StorageBytes map[string]int64 `protobuf:"bytes,2,rep,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// The ten login passwords left unchanged the longest, oldest first.
+	OldestPasswords []*GetVaultStatsResponse_OldPassword `protobuf:"bytes,3,rep,name=oldest_passwords,json=oldestPasswords,proto3" json:"oldest_passwords,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetVaultStatsResponse) Reset() {
+	*x = GetVaultStatsResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatsResponse) ProtoMessage() {}
+
+func (x *GetVaultStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetVaultStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetVaultStatsResponse) GetAddedPerMonth() []*GetVaultStatsResponse_MonthCount {
+	if x != nil {
+		return x.AddedPerMonth
+	}
+	return nil
+}
+
+func (x *GetVaultStatsResponse) GetStorageBytes() map[string]int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return nil
+}
+
+func (x *GetVaultStatsResponse) GetOldestPasswords() []*GetVaultStatsResponse_OldPassword {
+	if x != nil {
+		return x.OldestPasswords
+	}
+	return nil
+}
+
+type GetWifiCredentialsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWifiCredentialsRequest) Reset() {
+	*x = GetWifiCredentialsRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWifiCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWifiCredentialsRequest) ProtoMessage() {}
+
+func (x *GetWifiCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWifiCredentialsRequest.ProtoReflect.Descriptor instead.
+func (*GetWifiCredentialsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{20}
+}
+
+type GetWifiCredentialsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WifiCredentials []*WifiCredential      `protobuf:"bytes,1,rep,name=wifi_credentials,json=wifiCredentials,proto3" json:"wifi_credentials,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetWifiCredentialsResponse) Reset() {
+	*x = GetWifiCredentialsResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWifiCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWifiCredentialsResponse) ProtoMessage() {}
+
+func (x *GetWifiCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWifiCredentialsResponse.ProtoReflect.Descriptor instead.
+func (*GetWifiCredentialsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetWifiCredentialsResponse) GetWifiCredentials() []*WifiCredential {
+	if x != nil {
+		return x.WifiCredentials
+	}
+	return nil
+}
+
+type SaveWifiCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
+	// Defaults to the first vault for new items and to the current vault on updates.
+	VaultId *string `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3,oneof" json:"vault_id,omitempty"`
+	// At most 32 bytes.
+	Ssid     string       `protobuf:"bytes,3,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	Security WifiSecurity `protobuf:"varint,4,opt,name=security,proto3,enum=v1.vault.WifiSecurity" json:"security,omitempty"`
+	// 8 to 63 characters for WPA, empty for open networks.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Hidden   bool   `protobuf:"varint,6,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// Updates fail with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,7,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SaveWifiCredentialRequest) Reset() {
+	*x = SaveWifiCredentialRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveWifiCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveWifiCredentialRequest) ProtoMessage() {}
+
+func (x *SaveWifiCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveWifiCredentialRequest.ProtoReflect.Descriptor instead.
+func (*SaveWifiCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SaveWifiCredentialRequest) GetId() string {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return ""
+}
+
+func (x *SaveWifiCredentialRequest) GetVaultId() string {
+	if x != nil && x.VaultId != nil {
+		return *x.VaultId
+	}
+	return ""
+}
+
+func (x *SaveWifiCredentialRequest) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *SaveWifiCredentialRequest) GetSecurity() WifiSecurity {
+	if x != nil {
+		return x.Security
+	}
+	return WifiSecurity_WIFI_SECURITY_UNSPECIFIED
+}
+
+func (x *SaveWifiCredentialRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *SaveWifiCredentialRequest) GetHidden() bool {
+	if x != nil {
+		return x.Hidden
+	}
+	return false
+}
+
+func (x *SaveWifiCredentialRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type SaveWifiCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveWifiCredentialResponse) Reset() {
+	*x = SaveWifiCredentialResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveWifiCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveWifiCredentialResponse) ProtoMessage() {}
+
+func (x *SaveWifiCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveWifiCredentialResponse.ProtoReflect.Descriptor instead.
+func (*SaveWifiCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SaveWifiCredentialResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DeleteWifiCredentialRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fails with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteWifiCredentialRequest) Reset() {
+	*x = DeleteWifiCredentialRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWifiCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWifiCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteWifiCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWifiCredentialRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWifiCredentialRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteWifiCredentialRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteWifiCredentialRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type DeleteWifiCredentialResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWifiCredentialResponse) Reset() {
+	*x = DeleteWifiCredentialResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWifiCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWifiCredentialResponse) ProtoMessage() {}
+
+func (x *DeleteWifiCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWifiCredentialResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWifiCredentialResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteWifiCredentialResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type TouchItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchItemRequest) Reset() {
+	*x = TouchItemRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchItemRequest) ProtoMessage() {}
+
+func (x *TouchItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchItemRequest.ProtoReflect.Descriptor instead.
+func (*TouchItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TouchItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type TouchItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchItemResponse) Reset() {
+	*x = TouchItemResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchItemResponse) ProtoMessage() {}
+
+func (x *TouchItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchItemResponse.ProtoReflect.Descriptor instead.
+func (*TouchItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TouchItemResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type PinItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Pinned        bool                   `protobuf:"varint,2,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinItemRequest) Reset() {
+	*x = PinItemRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinItemRequest) ProtoMessage() {}
+
+func (x *PinItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinItemRequest.ProtoReflect.Descriptor instead.
+func (*PinItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PinItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PinItemRequest) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+type PinItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PinItemResponse) Reset() {
+	*x = PinItemResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PinItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinItemResponse) ProtoMessage() {}
+
+func (x *PinItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinItemResponse.ProtoReflect.Descriptor instead.
+func (*PinItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PinItemResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ArchiveItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Archived      bool                   `protobuf:"varint,2,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveItemRequest) Reset() {
+	*x = ArchiveItemRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveItemRequest) ProtoMessage() {}
+
+func (x *ArchiveItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveItemRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ArchiveItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ArchiveItemRequest) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type ArchiveItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveItemResponse) Reset() {
+	*x = ArchiveItemResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveItemResponse) ProtoMessage() {}
+
+func (x *ArchiveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveItemResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ArchiveItemResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ReorderItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemIds       []string               `protobuf:"bytes,1,rep,name=item_ids,json=itemIds,proto3" json:"item_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderItemsRequest) Reset() {
+	*x = ReorderItemsRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderItemsRequest) ProtoMessage() {}
+
+func (x *ReorderItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderItemsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderItemsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReorderItemsRequest) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type ReorderItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderItemsResponse) Reset() {
+	*x = ReorderItemsResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderItemsResponse) ProtoMessage() {}
+
+func (x *ReorderItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderItemsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderItemsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReorderItemsResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DeleteLoginPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Fails with FAILED_PRECONDITION unless the item is still at this revision.
+	// REST clients can send it as an If-Match header instead.
+	ExpectedRevision *int64 `protobuf:"varint,2,opt,name=expected_revision,json=expectedRevision,proto3,oneof" json:"expected_revision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteLoginPasswordRequest) Reset() {
+	*x = DeleteLoginPasswordRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLoginPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLoginPasswordRequest) ProtoMessage() {}
+
+func (x *DeleteLoginPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLoginPasswordRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLoginPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteLoginPasswordRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteLoginPasswordRequest) GetExpectedRevision() int64 {
+	if x != nil && x.ExpectedRevision != nil {
+		return *x.ExpectedRevision
+	}
+	return 0
+}
+
+type DeleteLoginPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLoginPasswordResponse) Reset() {
+	*x = DeleteLoginPasswordResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLoginPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLoginPasswordResponse) ProtoMessage() {}
+
+func (x *DeleteLoginPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLoginPasswordResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLoginPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *DeleteLoginPasswordResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type WatchVaultChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchVaultChangesRequest) Reset() {
+	*x = WatchVaultChangesRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVaultChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVaultChangesRequest) ProtoMessage() {}
+
+func (x *WatchVaultChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVaultChangesRequest.ProtoReflect.Descriptor instead.
+func (*WatchVaultChangesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{36}
+}
+
+type VaultChangeEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemType      ItemType               `protobuf:"varint,2,opt,name=item_type,json=itemType,proto3,enum=v1.vault.ItemType" json:"item_type,omitempty"`
+	Operation     Operation              `protobuf:"varint,3,opt,name=operation,proto3,enum=v1.vault.Operation" json:"operation,omitempty"`
+	Revision      int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultChangeEvent) Reset() {
+	*x = VaultChangeEvent{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultChangeEvent) ProtoMessage() {}
+
+func (x *VaultChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultChangeEvent.ProtoReflect.Descriptor instead.
+func (*VaultChangeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *VaultChangeEvent) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *VaultChangeEvent) GetItemType() ItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *VaultChangeEvent) GetOperation() Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return Operation_OPERATION_UNSPECIFIED
+}
+
+func (x *VaultChangeEvent) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetChangesSinceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Zero values request the whole vault.
+	Since *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Takes precedence over since when set, as only the revision tracks pins, archiving and other flag changes.
+	SinceRevision int64 `protobuf:"varint,2,opt,name=since_revision,json=sinceRevision,proto3" json:"since_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChangesSinceRequest) Reset() {
+	*x = GetChangesSinceRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChangesSinceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesSinceRequest) ProtoMessage() {}
+
+func (x *GetChangesSinceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangesSinceRequest.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetChangesSinceRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetChangesSinceRequest) GetSinceRevision() int64 {
+	if x != nil {
+		return x.SinceRevision
+	}
+	return 0
+}
+
+type GetChangesSinceResponse struct {
+	state          protoimpl.MessageState                   `protogen:"open.v1"`
+	LoginPasswords []*GetChangesSinceResponse_LoginPassword `protobuf:"bytes,1,rep,name=login_passwords,json=loginPasswords,proto3" json:"login_passwords,omitempty"`
+	Deleted        []*GetChangesSinceResponse_Tombstone     `protobuf:"bytes,2,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	// Pass as since on the next call.
+	SyncedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=synced_at,json=syncedAt,proto3" json:"synced_at,omitempty"`
+	// Pass as since_revision on the next call.
+	Revision        int64             `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
+	WifiCredentials []*WifiCredential `protobuf:"bytes,5,rep,name=wifi_credentials,json=wifiCredentials,proto3" json:"wifi_credentials,omitempty"`
+	SeedPhrases     []*SeedPhrase     `protobuf:"bytes,6,rep,name=seed_phrases,json=seedPhrases,proto3" json:"seed_phrases,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetChangesSinceResponse) Reset() {
+	*x = GetChangesSinceResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChangesSinceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesSinceResponse) ProtoMessage() {}
+
+func (x *GetChangesSinceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangesSinceResponse.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetChangesSinceResponse) GetLoginPasswords() []*GetChangesSinceResponse_LoginPassword {
+	if x != nil {
+		return x.LoginPasswords
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse) GetDeleted() []*GetChangesSinceResponse_Tombstone {
+	if x != nil {
+		return x.Deleted
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse) GetSyncedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SyncedAt
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetChangesSinceResponse) GetWifiCredentials() []*WifiCredential {
+	if x != nil {
+		return x.WifiCredentials
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse) GetSeedPhrases() []*SeedPhrase {
+	if x != nil {
+		return x.SeedPhrases
+	}
+	return nil
+}
+
+type ShareItemRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ItemId       string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	GranteeLogin string                 `protobuf:"bytes,2,opt,name=grantee_login,json=granteeLogin,proto3" json:"grantee_login,omitempty"`
+	ReadOnly     bool                   `protobuf:"varint,3,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	// Unset means the grant does not expire.
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareItemRequest) Reset() {
+	*x = ShareItemRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemRequest) ProtoMessage() {}
+
+func (x *ShareItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemRequest.ProtoReflect.Descriptor instead.
+func (*ShareItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ShareItemRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ShareItemRequest) GetGranteeLogin() string {
+	if x != nil {
+		return x.GranteeLogin
+	}
+	return ""
+}
+
+func (x *ShareItemRequest) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ShareItemRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ShareItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareItemResponse) Reset() {
+	*x = ShareItemResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareItemResponse) ProtoMessage() {}
+
+func (x *ShareItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareItemResponse.ProtoReflect.Descriptor instead.
+func (*ShareItemResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ShareItemResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListMySharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySharesRequest) Reset() {
+	*x = ListMySharesRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySharesRequest) ProtoMessage() {}
+
+func (x *ListMySharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySharesRequest.ProtoReflect.Descriptor instead.
+func (*ListMySharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{42}
+}
+
+type ListMySharesResponse struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Shares        []*ListMySharesResponse_Share `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySharesResponse) Reset() {
+	*x = ListMySharesResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMySharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMySharesResponse) ProtoMessage() {}
+
+func (x *ListMySharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMySharesResponse.ProtoReflect.Descriptor instead.
+func (*ListMySharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListMySharesResponse) GetShares() []*ListMySharesResponse_Share {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareRequest) Reset() {
+	*x = RevokeShareRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareRequest) ProtoMessage() {}
+
+func (x *RevokeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeShareRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RevokeShareRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeShareResponse) Reset() {
+	*x = RevokeShareResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeShareResponse) ProtoMessage() {}
+
+func (x *RevokeShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeShareResponse.ProtoReflect.Descriptor instead.
+func (*RevokeShareResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{45}
+}
+
+type GetVaultHealthRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultHealthRequest) Reset() {
+	*x = GetVaultHealthRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultHealthRequest) ProtoMessage() {}
+
+func (x *GetVaultHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{46}
+}
+
+type GetVaultHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Passwords scored below "strong".
+	Weak   []*GetVaultHealthResponse_Finding    `protobuf:"bytes,1,rep,name=weak,proto3" json:"weak,omitempty"`
+	Reused []*GetVaultHealthResponse_ReuseGroup `protobuf:"bytes,2,rep,name=reused,proto3" json:"reused,omitempty"`
+	// Passwords found in known breaches, empty if breach checks are disabled.
+	Breached []*GetVaultHealthResponse_Finding `protobuf:"bytes,3,rep,name=breached,proto3" json:"breached,omitempty"`
+	// Passwords not changed for a year.
+	Old []*GetVaultHealthResponse_Finding `protobuf:"bytes,4,rep,name=old,proto3" json:"old,omitempty"`
+	// Passwords due for a change by the rotation policy of the item or its vault, most overdue first.
+	RotationDue   []*GetVaultHealthResponse_Finding `protobuf:"bytes,5,rep,name=rotation_due,json=rotationDue,proto3" json:"rotation_due,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultHealthResponse) Reset() {
+	*x = GetVaultHealthResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultHealthResponse) ProtoMessage() {}
+
+func (x *GetVaultHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultHealthResponse.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetVaultHealthResponse) GetWeak() []*GetVaultHealthResponse_Finding {
+	if x != nil {
+		return x.Weak
+	}
+	return nil
+}
+
+func (x *GetVaultHealthResponse) GetReused() []*GetVaultHealthResponse_ReuseGroup {
+	if x != nil {
+		return x.Reused
+	}
+	return nil
+}
+
+func (x *GetVaultHealthResponse) GetBreached() []*GetVaultHealthResponse_Finding {
+	if x != nil {
+		return x.Breached
+	}
+	return nil
+}
+
+func (x *GetVaultHealthResponse) GetOld() []*GetVaultHealthResponse_Finding {
+	if x != nil {
+		return x.Old
+	}
+	return nil
+}
+
+func (x *GetVaultHealthResponse) GetRotationDue() []*GetVaultHealthResponse_Finding {
+	if x != nil {
+		return x.RotationDue
+	}
+	return nil
+}
+
+type FindLoginsForURLRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Url             string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	IncludeArchived bool                   `protobuf:"varint,2,opt,name=include_archived,json=includeArchived,proto3" json:"include_archived,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FindLoginsForURLRequest) Reset() {
+	*x = FindLoginsForURLRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindLoginsForURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindLoginsForURLRequest) ProtoMessage() {}
+
+func (x *FindLoginsForURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindLoginsForURLRequest.ProtoReflect.Descriptor instead.
+func (*FindLoginsForURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FindLoginsForURLRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *FindLoginsForURLRequest) GetIncludeArchived() bool {
+	if x != nil {
+		return x.IncludeArchived
+	}
+	return false
+}
+
+type FindLoginsForURLResponse struct {
+	state          protoimpl.MessageState                    `protogen:"open.v1"`
+	LoginPasswords []*FindLoginsForURLResponse_LoginPassword `protobuf:"bytes,1,rep,name=login_passwords,json=loginPasswords,proto3" json:"login_passwords,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindLoginsForURLResponse) Reset() {
+	*x = FindLoginsForURLResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindLoginsForURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindLoginsForURLResponse) ProtoMessage() {}
+
+func (x *FindLoginsForURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindLoginsForURLResponse.ProtoReflect.Descriptor instead.
+func (*FindLoginsForURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FindLoginsForURLResponse) GetLoginPasswords() []*FindLoginsForURLResponse_LoginPassword {
+	if x != nil {
+		return x.LoginPasswords
+	}
+	return nil
+}
+
+type GetFaviconRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Host          string                 `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFaviconRequest) Reset() {
+	*x = GetFaviconRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFaviconRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFaviconRequest) ProtoMessage() {}
+
+func (x *GetFaviconRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFaviconRequest.ProtoReflect.Descriptor instead.
+func (*GetFaviconRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetFaviconRequest) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ListVaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultsRequest) Reset() {
+	*x = ListVaultsRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultsRequest) ProtoMessage() {}
+
+func (x *ListVaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultsRequest.ProtoReflect.Descriptor instead.
+func (*ListVaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{51}
+}
+
+type ListVaultsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Vaults        []*ListVaultsResponse_Vault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVaultsResponse) Reset() {
+	*x = ListVaultsResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVaultsResponse) ProtoMessage() {}
+
+func (x *ListVaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVaultsResponse.ProtoReflect.Descriptor instead.
+func (*ListVaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListVaultsResponse) GetVaults() []*ListVaultsResponse_Vault {
+	if x != nil {
+		return x.Vaults
+	}
+	return nil
+}
+
+type SetItemRotationRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	ItemId string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	// Days between password changes, 0 to follow the vault's policy.
+	RotationDays  int32 `protobuf:"varint,2,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetItemRotationRequest) Reset() {
+	*x = SetItemRotationRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetItemRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemRotationRequest) ProtoMessage() {}
+
+func (x *SetItemRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemRotationRequest.ProtoReflect.Descriptor instead.
+func (*SetItemRotationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SetItemRotationRequest) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *SetItemRotationRequest) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
+type SetItemRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetItemRotationResponse) Reset() {
+	*x = SetItemRotationResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetItemRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetItemRotationResponse) ProtoMessage() {}
+
+func (x *SetItemRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetItemRotationResponse.ProtoReflect.Descriptor instead.
+func (*SetItemRotationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SetItemRotationResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type SetVaultRotationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VaultId string                 `protobuf:"bytes,1,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	// Days between password changes, 0 if passwords don't expire.
+	RotationDays  int32 `protobuf:"varint,2,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVaultRotationRequest) Reset() {
+	*x = SetVaultRotationRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVaultRotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVaultRotationRequest) ProtoMessage() {}
+
+func (x *SetVaultRotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVaultRotationRequest.ProtoReflect.Descriptor instead.
+func (*SetVaultRotationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *SetVaultRotationRequest) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *SetVaultRotationRequest) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
+type SetVaultRotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVaultRotationResponse) Reset() {
+	*x = SetVaultRotationResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVaultRotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVaultRotationResponse) ProtoMessage() {}
+
+func (x *SetVaultRotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVaultRotationResponse.ProtoReflect.Descriptor instead.
+func (*SetVaultRotationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetVaultRotationResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type CreateVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVaultRequest) Reset() {
+	*x = CreateVaultRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVaultRequest) ProtoMessage() {}
+
+func (x *CreateVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVaultRequest.ProtoReflect.Descriptor instead.
+func (*CreateVaultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *CreateVaultRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateVaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Revision      int64                  `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVaultResponse) Reset() {
+	*x = CreateVaultResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVaultResponse) ProtoMessage() {}
+
+func (x *CreateVaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVaultResponse.ProtoReflect.Descriptor instead.
+func (*CreateVaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CreateVaultResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateVaultResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type RenameVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameVaultRequest) Reset() {
+	*x = RenameVaultRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameVaultRequest) ProtoMessage() {}
+
+func (x *RenameVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameVaultRequest.ProtoReflect.Descriptor instead.
+func (*RenameVaultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RenameVaultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenameVaultRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameVaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameVaultResponse) Reset() {
+	*x = RenameVaultResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameVaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameVaultResponse) ProtoMessage() {}
+
+func (x *RenameVaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameVaultResponse.ProtoReflect.Descriptor instead.
+func (*RenameVaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *RenameVaultResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type DeleteVaultRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVaultRequest) Reset() {
+	*x = DeleteVaultRequest{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultRequest) ProtoMessage() {}
+
+func (x *DeleteVaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVaultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteVaultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteVaultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revision      int64                  `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVaultResponse) Reset() {
+	*x = DeleteVaultResponse{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVaultResponse) ProtoMessage() {}
+
+func (x *DeleteVaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVaultResponse.ProtoReflect.Descriptor instead.
+func (*DeleteVaultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DeleteVaultResponse) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type GetLoginPasswordsResponse_LoginPassword struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Login      string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	Password   string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	VaultId    string                 `protobuf:"bytes,3,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Urls       []*LoginURL            `protobuf:"bytes,4,rep,name=urls,proto3" json:"urls,omitempty"`
+	Id         string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Revision of the item's last change, for expected_revision.
+	Revision int64 `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	// Days between password changes, 0 to follow the vault's policy.
+	RotationDays  int32 `protobuf:"varint,8,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) Reset() {
+	*x = GetLoginPasswordsResponse_LoginPassword{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoginPasswordsResponse_LoginPassword) ProtoMessage() {}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoginPasswordsResponse_LoginPassword.ProtoReflect.Descriptor instead.
+func (*GetLoginPasswordsResponse_LoginPassword) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetUrls() []*LoginURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetLoginPasswordsResponse_LoginPassword) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
+type VaultItem_LoginPassword struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login      string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password   string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	VaultId    string                 `protobuf:"bytes,4,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Urls       []*LoginURL            `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Revision   int64                  `protobuf:"varint,7,opt,name=revision,proto3" json:"revision,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Days between password changes, 0 to follow the vault's policy.
+	RotationDays  int32 `protobuf:"varint,9,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VaultItem_LoginPassword) Reset() {
+	*x = VaultItem_LoginPassword{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VaultItem_LoginPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultItem_LoginPassword) ProtoMessage() {}
+
+func (x *VaultItem_LoginPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VaultItem_LoginPassword.ProtoReflect.Descriptor instead.
+func (*VaultItem_LoginPassword) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{5, 0}
+}
+
+func (x *VaultItem_LoginPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VaultItem_LoginPassword) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *VaultItem_LoginPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *VaultItem_LoginPassword) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *VaultItem_LoginPassword) GetUrls() []*LoginURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
+
+func (x *VaultItem_LoginPassword) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *VaultItem_LoginPassword) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *VaultItem_LoginPassword) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *VaultItem_LoginPassword) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
+type ListItemSummariesResponse_ItemSummary struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VaultId string                 `protobuf:"bytes,2,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Type    ItemType               `protobuf:"varint,3,opt,name=type,proto3,enum=v1.vault.ItemType" json:"type,omitempty"`
+	// The login, SSID or name of the item.
+	Title     string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Size of the secrets in bytes.
+	Size   int64 `protobuf:"varint,7,opt,name=size,proto3" json:"size,omitempty"`
+	Pinned bool  `protobuf:"varint,8,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	// Place in the user's order from 1, 0 if never ordered.
+	SortIndex     int32 `protobuf:"varint,9,opt,name=sort_index,json=sortIndex,proto3" json:"sort_index,omitempty"`
+	Archived      bool  `protobuf:"varint,10,opt,name=archived,proto3" json:"archived,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) Reset() {
+	*x = ListItemSummariesResponse_ItemSummary{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemSummariesResponse_ItemSummary) ProtoMessage() {}
+
+func (x *ListItemSummariesResponse_ItemSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemSummariesResponse_ItemSummary.ProtoReflect.Descriptor instead.
+func (*ListItemSummariesResponse_ItemSummary) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetType() ItemType {
+	if x != nil {
+		return x.Type
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetSortIndex() int32 {
+	if x != nil {
+		return x.SortIndex
+	}
+	return 0
+}
+
+func (x *ListItemSummariesResponse_ItemSummary) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+type GetVaultStatsResponse_MonthCount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The first instant of the month, in UTC.
+	Month         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=month,proto3" json:"month,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultStatsResponse_MonthCount) Reset() {
+	*x = GetVaultStatsResponse_MonthCount{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatsResponse_MonthCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatsResponse_MonthCount) ProtoMessage() {}
+
+func (x *GetVaultStatsResponse_MonthCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultStatsResponse_MonthCount.ProtoReflect.Descriptor instead.
+func (*GetVaultStatsResponse_MonthCount) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{19, 1}
+}
+
+func (x *GetVaultStatsResponse_MonthCount) GetMonth() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Month
+	}
+	return nil
+}
+
+func (x *GetVaultStatsResponse_MonthCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetVaultStatsResponse_OldPassword struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVaultStatsResponse_OldPassword) Reset() {
+	*x = GetVaultStatsResponse_OldPassword{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVaultStatsResponse_OldPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVaultStatsResponse_OldPassword) ProtoMessage() {}
+
+func (x *GetVaultStatsResponse_OldPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVaultStatsResponse_OldPassword.ProtoReflect.Descriptor instead.
+func (*GetVaultStatsResponse_OldPassword) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{19, 2}
+}
+
+func (x *GetVaultStatsResponse_OldPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetVaultStatsResponse_OldPassword) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GetVaultStatsResponse_OldPassword) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetChangesSinceResponse_LoginPassword struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login      string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password   string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Revision   int64                  `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+	VaultId    string                 `protobuf:"bytes,6,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Urls       []*LoginURL            `protobuf:"bytes,7,rep,name=urls,proto3" json:"urls,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	// Days between password changes, 0 to follow the vault's policy.
+	RotationDays  int32 `protobuf:"varint,9,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) Reset() {
+	*x = GetChangesSinceResponse_LoginPassword{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChangesSinceResponse_LoginPassword) ProtoMessage() {}
+
+func (x *GetChangesSinceResponse_LoginPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChangesSinceResponse_LoginPassword.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceResponse_LoginPassword) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetVaultId() string {
+	if x != nil {
+		return x.VaultId
+	}
+	return ""
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetUrls() []*LoginURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
+}
 
-type GetLoginPasswordsRequest struct {
+func (x *GetChangesSinceResponse_LoginPassword) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse_LoginPassword) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
+type GetChangesSinceResponse_Tombstone struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ItemId        string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemType      ItemType               `protobuf:"varint,2,opt,name=item_type,json=itemType,proto3,enum=v1.vault.ItemType" json:"item_type,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	Revision      int64                  `protobuf:"varint,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoginPasswordsRequest) Reset() {
-	*x = GetLoginPasswordsRequest{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[0]
+func (x *GetChangesSinceResponse_Tombstone) Reset() {
+	*x = GetChangesSinceResponse_Tombstone{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoginPasswordsRequest) String() string {
+func (x *GetChangesSinceResponse_Tombstone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoginPasswordsRequest) ProtoMessage() {}
+func (*GetChangesSinceResponse_Tombstone) ProtoMessage() {}
 
-func (x *GetLoginPasswordsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[0]
+func (x *GetChangesSinceResponse_Tombstone) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -54,33 +4211,67 @@ func (x *GetLoginPasswordsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoginPasswordsRequest.ProtoReflect.Descriptor instead.
-func (*GetLoginPasswordsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetChangesSinceResponse_Tombstone.ProtoReflect.Descriptor instead.
+func (*GetChangesSinceResponse_Tombstone) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{39, 1}
 }
 
-type GetLoginPasswordsResponse struct {
-	state          protoimpl.MessageState                     `protogen:"open.v1"`
-	LoginPasswords []*GetLoginPasswordsResponse_LoginPassword `protobuf:"bytes,1,rep,name=login_passwords,json=loginPasswords,proto3" json:"login_passwords,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetChangesSinceResponse_Tombstone) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
 }
 
-func (x *GetLoginPasswordsResponse) Reset() {
-	*x = GetLoginPasswordsResponse{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[1]
+func (x *GetChangesSinceResponse_Tombstone) GetItemType() ItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *GetChangesSinceResponse_Tombstone) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GetChangesSinceResponse_Tombstone) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+type ListMySharesResponse_Share struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ItemId        string                 `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	ItemType      ItemType               `protobuf:"varint,3,opt,name=item_type,json=itemType,proto3,enum=v1.vault.ItemType" json:"item_type,omitempty"`
+	GranteeLogin  string                 `protobuf:"bytes,4,opt,name=grantee_login,json=granteeLogin,proto3" json:"grantee_login,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,5,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMySharesResponse_Share) Reset() {
+	*x = ListMySharesResponse_Share{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoginPasswordsResponse) String() string {
+func (x *ListMySharesResponse_Share) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoginPasswordsResponse) ProtoMessage() {}
+func (*ListMySharesResponse_Share) ProtoMessage() {}
 
-func (x *GetLoginPasswordsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[1]
+func (x *ListMySharesResponse_Share) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -91,42 +4282,89 @@ func (x *GetLoginPasswordsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoginPasswordsResponse.ProtoReflect.Descriptor instead.
-func (*GetLoginPasswordsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use ListMySharesResponse_Share.ProtoReflect.Descriptor instead.
+func (*ListMySharesResponse_Share) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{43, 0}
 }
 
-func (x *GetLoginPasswordsResponse) GetLoginPasswords() []*GetLoginPasswordsResponse_LoginPassword {
+func (x *ListMySharesResponse_Share) GetId() string {
 	if x != nil {
-		return x.LoginPasswords
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListMySharesResponse_Share) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *ListMySharesResponse_Share) GetItemType() ItemType {
+	if x != nil {
+		return x.ItemType
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ListMySharesResponse_Share) GetGranteeLogin() string {
+	if x != nil {
+		return x.GranteeLogin
+	}
+	return ""
+}
+
+func (x *ListMySharesResponse_Share) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+func (x *ListMySharesResponse_Share) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type SaveLoginPasswordRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            *string                `protobuf:"bytes,1,opt,name=id,proto3,oneof" json:"id,omitempty"`
-	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+func (x *ListMySharesResponse_Share) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetVaultHealthResponse_Finding struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ItemId      string                 `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id,omitempty"`
+	Login       string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	BreachCount int64                  `protobuf:"varint,3,opt,name=breach_count,json=breachCount,proto3" json:"breach_count,omitempty"`
+	// zxcvbn score from 0 to 4.
+	Strength  int32                  `protobuf:"varint,4,opt,name=strength,proto3" json:"strength,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// When the password was due for a change, set on rotation_due findings.
+	RotationDueAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=rotation_due_at,json=rotationDueAt,proto3" json:"rotation_due_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveLoginPasswordRequest) Reset() {
-	*x = SaveLoginPasswordRequest{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[2]
+func (x *GetVaultHealthResponse_Finding) Reset() {
+	*x = GetVaultHealthResponse_Finding{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveLoginPasswordRequest) String() string {
+func (x *GetVaultHealthResponse_Finding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveLoginPasswordRequest) ProtoMessage() {}
+func (*GetVaultHealthResponse_Finding) ProtoMessage() {}
 
-func (x *SaveLoginPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[2]
+func (x *GetVaultHealthResponse_Finding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -137,53 +4375,75 @@ func (x *SaveLoginPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveLoginPasswordRequest.ProtoReflect.Descriptor instead.
-func (*SaveLoginPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use GetVaultHealthResponse_Finding.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthResponse_Finding) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{47, 0}
 }
 
-func (x *SaveLoginPasswordRequest) GetId() string {
-	if x != nil && x.Id != nil {
-		return *x.Id
+func (x *GetVaultHealthResponse_Finding) GetItemId() string {
+	if x != nil {
+		return x.ItemId
 	}
 	return ""
 }
 
-func (x *SaveLoginPasswordRequest) GetLogin() string {
+func (x *GetVaultHealthResponse_Finding) GetLogin() string {
 	if x != nil {
 		return x.Login
 	}
 	return ""
 }
 
-func (x *SaveLoginPasswordRequest) GetPassword() string {
+func (x *GetVaultHealthResponse_Finding) GetBreachCount() int64 {
 	if x != nil {
-		return x.Password
+		return x.BreachCount
 	}
-	return ""
+	return 0
 }
 
-type SaveLoginPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetVaultHealthResponse_Finding) GetStrength() int32 {
+	if x != nil {
+		return x.Strength
+	}
+	return 0
+}
+
+func (x *GetVaultHealthResponse_Finding) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *GetVaultHealthResponse_Finding) GetRotationDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RotationDueAt
+	}
+	return nil
+}
+
+type GetVaultHealthResponse_ReuseGroup struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Items         []*GetVaultHealthResponse_Finding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveLoginPasswordResponse) Reset() {
-	*x = SaveLoginPasswordResponse{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[3]
+func (x *GetVaultHealthResponse_ReuseGroup) Reset() {
+	*x = GetVaultHealthResponse_ReuseGroup{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveLoginPasswordResponse) String() string {
+func (x *GetVaultHealthResponse_ReuseGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveLoginPasswordResponse) ProtoMessage() {}
+func (*GetVaultHealthResponse_ReuseGroup) ProtoMessage() {}
 
-func (x *SaveLoginPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[3]
+func (x *GetVaultHealthResponse_ReuseGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -194,33 +4454,44 @@ func (x *SaveLoginPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SaveLoginPasswordResponse.ProtoReflect.Descriptor instead.
-func (*SaveLoginPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetVaultHealthResponse_ReuseGroup.ProtoReflect.Descriptor instead.
+func (*GetVaultHealthResponse_ReuseGroup) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{47, 1}
 }
 
-type DeleteLoginPasswordRequest struct {
+func (x *GetVaultHealthResponse_ReuseGroup) GetItems() []*GetVaultHealthResponse_Finding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type FindLoginsForURLResponse_LoginPassword struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Login         string                 `protobuf:"bytes,2,opt,name=login,proto3" json:"login,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	VaultId       string                 `protobuf:"bytes,4,opt,name=vault_id,json=vaultId,proto3" json:"vault_id,omitempty"`
+	Urls          []*LoginURL            `protobuf:"bytes,5,rep,name=urls,proto3" json:"urls,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteLoginPasswordRequest) Reset() {
-	*x = DeleteLoginPasswordRequest{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[4]
+func (x *FindLoginsForURLResponse_LoginPassword) Reset() {
+	*x = FindLoginsForURLResponse_LoginPassword{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteLoginPasswordRequest) String() string {
+func (x *FindLoginsForURLResponse_LoginPassword) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteLoginPasswordRequest) ProtoMessage() {}
+func (*FindLoginsForURLResponse_LoginPassword) ProtoMessage() {}
 
-func (x *DeleteLoginPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[4]
+func (x *FindLoginsForURLResponse_LoginPassword) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,77 +4502,72 @@ func (x *DeleteLoginPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteLoginPasswordRequest.ProtoReflect.Descriptor instead.
-func (*DeleteLoginPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FindLoginsForURLResponse_LoginPassword.ProtoReflect.Descriptor instead.
+func (*FindLoginsForURLResponse_LoginPassword) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{49, 0}
 }
 
-func (x *DeleteLoginPasswordRequest) GetId() string {
+func (x *FindLoginsForURLResponse_LoginPassword) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteLoginPasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *DeleteLoginPasswordResponse) Reset() {
-	*x = DeleteLoginPasswordResponse{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *FindLoginsForURLResponse_LoginPassword) GetLogin() string {
+	if x != nil {
+		return x.Login
+	}
+	return ""
 }
 
-func (x *DeleteLoginPasswordResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *FindLoginsForURLResponse_LoginPassword) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
 }
 
-func (*DeleteLoginPasswordResponse) ProtoMessage() {}
-
-func (x *DeleteLoginPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[5]
+func (x *FindLoginsForURLResponse_LoginPassword) GetVaultId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.VaultId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DeleteLoginPasswordResponse.ProtoReflect.Descriptor instead.
-func (*DeleteLoginPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{5}
+func (x *FindLoginsForURLResponse_LoginPassword) GetUrls() []*LoginURL {
+	if x != nil {
+		return x.Urls
+	}
+	return nil
 }
 
-type GetLoginPasswordsResponse_LoginPassword struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Login         string                 `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
-	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+type ListVaultsResponse_Vault struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Days between password changes of the vault's login items, 0 if they don't expire.
+	RotationDays  int32 `protobuf:"varint,4,opt,name=rotation_days,json=rotationDays,proto3" json:"rotation_days,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoginPasswordsResponse_LoginPassword) Reset() {
-	*x = GetLoginPasswordsResponse_LoginPassword{}
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[6]
+func (x *ListVaultsResponse_Vault) Reset() {
+	*x = ListVaultsResponse_Vault{}
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoginPasswordsResponse_LoginPassword) String() string {
+func (x *ListVaultsResponse_Vault) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoginPasswordsResponse_LoginPassword) ProtoMessage() {}
+func (*ListVaultsResponse_Vault) ProtoMessage() {}
 
-func (x *GetLoginPasswordsResponse_LoginPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_v1_vault_vault_proto_msgTypes[6]
+func (x *ListVaultsResponse_Vault) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v1_vault_vault_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,49 +4578,411 @@ func (x *GetLoginPasswordsResponse_LoginPassword) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoginPasswordsResponse_LoginPassword.ProtoReflect.Descriptor instead.
-func (*GetLoginPasswordsResponse_LoginPassword) Descriptor() ([]byte, []int) {
-	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ListVaultsResponse_Vault.ProtoReflect.Descriptor instead.
+func (*ListVaultsResponse_Vault) Descriptor() ([]byte, []int) {
+	return file_proto_v1_vault_vault_proto_rawDescGZIP(), []int{52, 0}
 }
 
-func (x *GetLoginPasswordsResponse_LoginPassword) GetLogin() string {
+func (x *ListVaultsResponse_Vault) GetId() string {
 	if x != nil {
-		return x.Login
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetLoginPasswordsResponse_LoginPassword) GetPassword() string {
+func (x *ListVaultsResponse_Vault) GetName() string {
 	if x != nil {
-		return x.Password
+		return x.Name
 	}
 	return ""
 }
 
+func (x *ListVaultsResponse_Vault) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListVaultsResponse_Vault) GetRotationDays() int32 {
+	if x != nil {
+		return x.RotationDays
+	}
+	return 0
+}
+
 var File_proto_v1_vault_vault_proto protoreflect.FileDescriptor
 
 const file_proto_v1_vault_vault_proto_rawDesc = "" +
 	"\n" +
-	"\x1aproto/v1/vault/vault.proto\x12\bv1.vault\x1a\x1cgoogle/api/annotations.proto\"\x1a\n" +
-	"\x18GetLoginPasswordsRequest\"\xba\x01\n" +
+	"\x1aproto/v1/vault/vault.proto\x12\bv1.vault\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1eproto/v1/options/options.proto\"\xcc\x02\n" +
+	"\x0eWifiCredential\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\tR\avaultId\x12\x12\n" +
+	"\x04ssid\x18\x03 \x01(\tR\x04ssid\x122\n" +
+	"\bsecurity\x18\x04 \x01(\x0e2\x16.v1.vault.WifiSecurityR\bsecurity\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06hidden\x18\x06 \x01(\bR\x06hidden\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\b \x01(\x03R\brevision\x12<\n" +
+	"\flast_used_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"F\n" +
+	"\bLoginURL\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12(\n" +
+	"\x05match\x18\x02 \x01(\x0e2\x12.v1.vault.URLMatchR\x05match\"\xcd\x01\n" +
+	"\x18GetLoginPasswordsRequest\x12'\n" +
+	"\x04sort\x18\x01 \x01(\x0e2\x13.v1.vault.SortOrderR\x04sort\x129\n" +
+	"\n" +
+	"used_since\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tusedSince\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x127\n" +
+	"\tread_mask\x18\x04 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\xa9\x03\n" +
 	"\x19GetLoginPasswordsResponse\x12Z\n" +
-	"\x0flogin_passwords\x18\x01 \x03(\v21.v1.vault.GetLoginPasswordsResponse.LoginPasswordR\x0eloginPasswords\x1aA\n" +
+	"\x0flogin_passwords\x18\x01 \x03(\v21.v1.vault.GetLoginPasswordsResponse.LoginPasswordR\x0eloginPasswords\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x1a\x93\x02\n" +
 	"\rLoginPassword\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"h\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x19\n" +
+	"\bvault_id\x18\x03 \x01(\tR\avaultId\x12&\n" +
+	"\x04urls\x18\x04 \x03(\v2\x12.v1.vault.LoginURLR\x04urls\x12\x0e\n" +
+	"\x02id\x18\x05 \x01(\tR\x02id\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12\x1a\n" +
+	"\brevision\x18\a \x01(\x03R\brevision\x12#\n" +
+	"\rrotation_days\x18\b \x01(\x05R\frotationDays\"\x82\x01\n" +
+	"\x1aGetVaultItemsStreamRequest\x12\x1e\n" +
+	"\bvault_id\x18\x01 \x01(\tH\x00R\avaultId\x88\x01\x01\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMaskB\v\n" +
+	"\t_vault_id\"\xca\x04\n" +
+	"\tVaultItem\x12J\n" +
+	"\x0elogin_password\x18\x01 \x01(\v2!.v1.vault.VaultItem.LoginPasswordH\x00R\rloginPassword\x12C\n" +
+	"\x0fwifi_credential\x18\x03 \x01(\v2\x18.v1.vault.WifiCredentialH\x00R\x0ewifiCredential\x127\n" +
+	"\vseed_phrase\x18\x04 \x01(\v2\x14.v1.vault.SeedPhraseH\x00R\n" +
+	"seedPhrase\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\x1a\xce\x02\n" +
+	"\rLoginPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x19\n" +
+	"\bvault_id\x18\x04 \x01(\tR\avaultId\x12&\n" +
+	"\x04urls\x18\x05 \x03(\v2\x12.v1.vault.LoginURLR\x04urls\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\a \x01(\x03R\brevision\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12#\n" +
+	"\rrotation_days\x18\t \x01(\x05R\frotationDaysB\x06\n" +
+	"\x04item\"r\n" +
+	"\x18ListItemSummariesRequest\x12\x1e\n" +
+	"\bvault_id\x18\x01 \x01(\tH\x00R\avaultId\x88\x01\x01\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchivedB\v\n" +
+	"\t_vault_id\"\xb8\x03\n" +
+	"\x19ListItemSummariesResponse\x12E\n" +
+	"\x05items\x18\x01 \x03(\v2/.v1.vault.ListItemSummariesResponse.ItemSummaryR\x05items\x1a\xd3\x02\n" +
+	"\vItemSummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\tR\avaultId\x12&\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x12.v1.vault.ItemTypeR\x04type\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04size\x18\a \x01(\x03R\x04size\x12\x16\n" +
+	"\x06pinned\x18\b \x01(\bR\x06pinned\x12\x1d\n" +
+	"\n" +
+	"sort_index\x18\t \x01(\x05R\tsortIndex\x12\x1a\n" +
+	"\barchived\x18\n" +
+	" \x01(\bR\barchived\"M\n" +
+	"\x13GetVaultItemRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x12.v1.vault.ItemTypeR\x04type\"\x85\x02\n" +
 	"\x18SaveLoginPasswordRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x14\n" +
 	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpasswordB\x05\n" +
-	"\x03_id\"\x1b\n" +
-	"\x19SaveLoginPasswordResponse\",\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1e\n" +
+	"\bvault_id\x18\x04 \x01(\tH\x01R\avaultId\x88\x01\x01\x12&\n" +
+	"\x04urls\x18\x05 \x03(\v2\x12.v1.vault.LoginURLR\x04urls\x120\n" +
+	"\x11expected_revision\x18\x06 \x01(\x03H\x02R\x10expectedRevision\x88\x01\x01B\x05\n" +
+	"\x03_idB\v\n" +
+	"\t_vault_idB\x14\n" +
+	"\x12_expected_revision\"7\n" +
+	"\x19SaveLoginPasswordResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"\xfb\x01\n" +
+	"\n" +
+	"SeedPhrase\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvault_id\x18\x02 \x01(\tR\avaultId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\x05words\x18\x04 \x03(\tB\x03\x80\x01\x01R\x05words\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\x06 \x01(\x03R\brevision\x12<\n" +
+	"\flast_used_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\"\x17\n" +
+	"\x15GetSeedPhrasesRequest\"Q\n" +
+	"\x16GetSeedPhrasesResponse\x127\n" +
+	"\fseed_phrases\x18\x01 \x03(\v2\x14.v1.vault.SeedPhraseR\vseedPhrases\"\xd7\x01\n" +
+	"\x15SaveSeedPhraseRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1e\n" +
+	"\bvault_id\x18\x02 \x01(\tH\x01R\avaultId\x88\x01\x01\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x19\n" +
+	"\x05words\x18\x04 \x03(\tB\x03\x80\x01\x01R\x05words\x120\n" +
+	"\x11expected_revision\x18\x05 \x01(\x03H\x02R\x10expectedRevision\x88\x01\x01B\x05\n" +
+	"\x03_idB\v\n" +
+	"\t_vault_idB\x14\n" +
+	"\x12_expected_revision\"4\n" +
+	"\x16SaveSeedPhraseResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"q\n" +
+	"\x17DeleteSeedPhraseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x11expected_revision\x18\x02 \x01(\x03H\x00R\x10expectedRevision\x88\x01\x01B\x14\n" +
+	"\x12_expected_revision\"6\n" +
+	"\x18DeleteSeedPhraseResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"\x16\n" +
+	"\x14GetVaultStatsRequest\"\xa2\x04\n" +
+	"\x15GetVaultStatsResponse\x12R\n" +
+	"\x0fadded_per_month\x18\x01 \x03(\v2*.v1.vault.GetVaultStatsResponse.MonthCountR\raddedPerMonth\x12V\n" +
+	"\rstorage_bytes\x18\x02 \x03(\v21.v1.vault.GetVaultStatsResponse.StorageBytesEntryR\fstorageBytes\x12V\n" +
+	"\x10oldest_passwords\x18\x03 \x03(\v2+.v1.vault.GetVaultStatsResponse.OldPasswordR\x0foldestPasswords\x1a?\n" +
+	"\x11StorageBytesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\x1aT\n" +
+	"\n" +
+	"MonthCount\x120\n" +
+	"\x05month\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05month\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x1an\n" +
+	"\vOldPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x1b\n" +
+	"\x19GetWifiCredentialsRequest\"a\n" +
+	"\x1aGetWifiCredentialsResponse\x12C\n" +
+	"\x10wifi_credentials\x18\x01 \x03(\v2\x18.v1.vault.WifiCredentialR\x0fwifiCredentials\"\xa8\x02\n" +
+	"\x19SaveWifiCredentialRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x88\x01\x01\x12\x1e\n" +
+	"\bvault_id\x18\x02 \x01(\tH\x01R\avaultId\x88\x01\x01\x12\x12\n" +
+	"\x04ssid\x18\x03 \x01(\tR\x04ssid\x122\n" +
+	"\bsecurity\x18\x04 \x01(\x0e2\x16.v1.vault.WifiSecurityR\bsecurity\x12\x1a\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x12\x16\n" +
+	"\x06hidden\x18\x06 \x01(\bR\x06hidden\x120\n" +
+	"\x11expected_revision\x18\a \x01(\x03H\x02R\x10expectedRevision\x88\x01\x01B\x05\n" +
+	"\x03_idB\v\n" +
+	"\t_vault_idB\x14\n" +
+	"\x12_expected_revision\"8\n" +
+	"\x1aSaveWifiCredentialResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"u\n" +
+	"\x1bDeleteWifiCredentialRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x11expected_revision\x18\x02 \x01(\x03H\x00R\x10expectedRevision\x88\x01\x01B\x14\n" +
+	"\x12_expected_revision\":\n" +
+	"\x1cDeleteWifiCredentialResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"+\n" +
+	"\x10TouchItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\"/\n" +
+	"\x11TouchItemResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"A\n" +
+	"\x0ePinItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x16\n" +
+	"\x06pinned\x18\x02 \x01(\bR\x06pinned\"-\n" +
+	"\x0fPinItemResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"I\n" +
+	"\x12ArchiveItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x1a\n" +
+	"\barchived\x18\x02 \x01(\bR\barchived\"1\n" +
+	"\x13ArchiveItemResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"0\n" +
+	"\x13ReorderItemsRequest\x12\x19\n" +
+	"\bitem_ids\x18\x01 \x03(\tR\aitemIds\"2\n" +
+	"\x14ReorderItemsResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"t\n" +
 	"\x1aDeleteLoginPasswordRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x1d\n" +
-	"\x1bDeleteLoginPasswordResponse2\xbd\x03\n" +
-	"\fVaultService\x12\x8a\x01\n" +
-	"\x11GetLoginPasswords\x12\".v1.vault.GetLoginPasswordsRequest\x1a#.v1.vault.GetLoginPasswordsResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/get-login-passwords\x12\x8a\x01\n" +
-	"\x11SaveLoginPassword\x12\".v1.vault.SaveLoginPasswordRequest\x1a#.v1.vault.SaveLoginPasswordResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/save-login-password\x12\x92\x01\n" +
-	"\x13DeleteLoginPassword\x12$.v1.vault.DeleteLoginPasswordRequest\x1a%.v1.vault.DeleteLoginPasswordResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/vault/delete-login-passwordB7Z5github.com/cmrd-a/GophKeeper/gen/proto/v1/vault;vaultb\x06proto3"
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x11expected_revision\x18\x02 \x01(\x03H\x00R\x10expectedRevision\x88\x01\x01B\x14\n" +
+	"\x12_expected_revision\"9\n" +
+	"\x1bDeleteLoginPasswordResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"\x1a\n" +
+	"\x18WatchVaultChangesRequest\"\xab\x01\n" +
+	"\x10VaultChangeEvent\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12/\n" +
+	"\titem_type\x18\x02 \x01(\x0e2\x12.v1.vault.ItemTypeR\bitemType\x121\n" +
+	"\toperation\x18\x03 \x01(\x0e2\x13.v1.vault.OperationR\toperation\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\"q\n" +
+	"\x16GetChangesSinceRequest\x120\n" +
+	"\x05since\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05since\x12%\n" +
+	"\x0esince_revision\x18\x02 \x01(\x03R\rsinceRevision\"\x8d\a\n" +
+	"\x17GetChangesSinceResponse\x12X\n" +
+	"\x0flogin_passwords\x18\x01 \x03(\v2/.v1.vault.GetChangesSinceResponse.LoginPasswordR\x0eloginPasswords\x12E\n" +
+	"\adeleted\x18\x02 \x03(\v2+.v1.vault.GetChangesSinceResponse.TombstoneR\adeleted\x127\n" +
+	"\tsynced_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bsyncedAt\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\x12C\n" +
+	"\x10wifi_credentials\x18\x05 \x03(\v2\x18.v1.vault.WifiCredentialR\x0fwifiCredentials\x127\n" +
+	"\fseed_phrases\x18\x06 \x03(\v2\x14.v1.vault.SeedPhraseR\vseedPhrases\x1a\xce\x02\n" +
+	"\rLoginPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\brevision\x18\x05 \x01(\x03R\brevision\x12\x19\n" +
+	"\bvault_id\x18\x06 \x01(\tR\avaultId\x12&\n" +
+	"\x04urls\x18\a \x03(\v2\x12.v1.vault.LoginURLR\x04urls\x12<\n" +
+	"\flast_used_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x12#\n" +
+	"\rrotation_days\x18\t \x01(\x05R\frotationDays\x1a\xac\x01\n" +
+	"\tTombstone\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12/\n" +
+	"\titem_type\x18\x02 \x01(\x0e2\x12.v1.vault.ItemTypeR\bitemType\x129\n" +
+	"\n" +
+	"deleted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1a\n" +
+	"\brevision\x18\x04 \x01(\x03R\brevision\"\xa8\x01\n" +
+	"\x10ShareItemRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12#\n" +
+	"\rgrantee_login\x18\x02 \x01(\tR\fgranteeLogin\x12\x1b\n" +
+	"\tread_only\x18\x03 \x01(\bR\breadOnly\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"#\n" +
+	"\x11ShareItemResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13ListMySharesRequest\"\xf0\x02\n" +
+	"\x14ListMySharesResponse\x12<\n" +
+	"\x06shares\x18\x01 \x03(\v2$.v1.vault.ListMySharesResponse.ShareR\x06shares\x1a\x99\x02\n" +
+	"\x05Share\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\tR\x06itemId\x12/\n" +
+	"\titem_type\x18\x03 \x01(\x0e2\x12.v1.vault.ItemTypeR\bitemType\x12#\n" +
+	"\rgrantee_login\x18\x04 \x01(\tR\fgranteeLogin\x12\x1b\n" +
+	"\tread_only\x18\x05 \x01(\bR\breadOnly\x129\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"$\n" +
+	"\x12RevokeShareRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x15\n" +
+	"\x13RevokeShareResponse\"\x17\n" +
+	"\x15GetVaultHealthRequest\"\xb1\x05\n" +
+	"\x16GetVaultHealthResponse\x12<\n" +
+	"\x04weak\x18\x01 \x03(\v2(.v1.vault.GetVaultHealthResponse.FindingR\x04weak\x12C\n" +
+	"\x06reused\x18\x02 \x03(\v2+.v1.vault.GetVaultHealthResponse.ReuseGroupR\x06reused\x12D\n" +
+	"\bbreached\x18\x03 \x03(\v2(.v1.vault.GetVaultHealthResponse.FindingR\bbreached\x12:\n" +
+	"\x03old\x18\x04 \x03(\v2(.v1.vault.GetVaultHealthResponse.FindingR\x03old\x12K\n" +
+	"\frotation_due\x18\x05 \x03(\v2(.v1.vault.GetVaultHealthResponse.FindingR\vrotationDue\x1a\xf6\x01\n" +
+	"\aFinding\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12!\n" +
+	"\fbreach_count\x18\x03 \x01(\x03R\vbreachCount\x12\x1a\n" +
+	"\bstrength\x18\x04 \x01(\x05R\bstrength\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0frotation_due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\rrotationDueAt\x1aL\n" +
+	"\n" +
+	"ReuseGroup\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.v1.vault.GetVaultHealthResponse.FindingR\x05items\"V\n" +
+	"\x17FindLoginsForURLRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12)\n" +
+	"\x10include_archived\x18\x02 \x01(\bR\x0fincludeArchived\"\x8c\x02\n" +
+	"\x18FindLoginsForURLResponse\x12Y\n" +
+	"\x0flogin_passwords\x18\x01 \x03(\v20.v1.vault.FindLoginsForURLResponse.LoginPasswordR\x0eloginPasswords\x1a\x94\x01\n" +
+	"\rLoginPassword\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05login\x18\x02 \x01(\tR\x05login\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x19\n" +
+	"\bvault_id\x18\x04 \x01(\tR\avaultId\x12&\n" +
+	"\x04urls\x18\x05 \x03(\v2\x12.v1.vault.LoginURLR\x04urls\"'\n" +
+	"\x11GetFaviconRequest\x12\x12\n" +
+	"\x04host\x18\x01 \x01(\tR\x04host\"\x13\n" +
+	"\x11ListVaultsRequest\"\xde\x01\n" +
+	"\x12ListVaultsResponse\x12:\n" +
+	"\x06vaults\x18\x01 \x03(\v2\".v1.vault.ListVaultsResponse.VaultR\x06vaults\x1a\x8b\x01\n" +
+	"\x05Vault\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12#\n" +
+	"\rrotation_days\x18\x04 \x01(\x05R\frotationDays\"V\n" +
+	"\x16SetItemRotationRequest\x12\x17\n" +
+	"\aitem_id\x18\x01 \x01(\tR\x06itemId\x12#\n" +
+	"\rrotation_days\x18\x02 \x01(\x05R\frotationDays\"5\n" +
+	"\x17SetItemRotationResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"Y\n" +
+	"\x17SetVaultRotationRequest\x12\x19\n" +
+	"\bvault_id\x18\x01 \x01(\tR\avaultId\x12#\n" +
+	"\rrotation_days\x18\x02 \x01(\x05R\frotationDays\"6\n" +
+	"\x18SetVaultRotationResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"(\n" +
+	"\x12CreateVaultRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"A\n" +
+	"\x13CreateVaultResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x03R\brevision\"8\n" +
+	"\x12RenameVaultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"1\n" +
+	"\x13RenameVaultResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision\"$\n" +
+	"\x12DeleteVaultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
+	"\x13DeleteVaultResponse\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x03R\brevision*}\n" +
+	"\bItemType\x12\x19\n" +
+	"\x15ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18ITEM_TYPE_LOGIN_PASSWORD\x10\x01\x12\x1d\n" +
+	"\x19ITEM_TYPE_WIFI_CREDENTIAL\x10\x02\x12\x19\n" +
+	"\x15ITEM_TYPE_SEED_PHRASE\x10\x03*\x8b\x01\n" +
+	"\fWifiSecurity\x12\x1d\n" +
+	"\x19WIFI_SECURITY_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12WIFI_SECURITY_NONE\x10\x01\x12\x15\n" +
+	"\x11WIFI_SECURITY_WEP\x10\x02\x12\x15\n" +
+	"\x11WIFI_SECURITY_WPA\x10\x03\x12\x16\n" +
+	"\x12WIFI_SECURITY_WPA3\x10\x04*k\n" +
+	"\tOperation\x12\x19\n" +
+	"\x15OPERATION_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11OPERATION_CREATED\x10\x01\x12\x15\n" +
+	"\x11OPERATION_UPDATED\x10\x02\x12\x15\n" +
+	"\x11OPERATION_DELETED\x10\x03*f\n" +
+	"\bURLMatch\x12\x19\n" +
+	"\x15URL_MATCH_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10URL_MATCH_DOMAIN\x10\x01\x12\x13\n" +
+	"\x0fURL_MATCH_EXACT\x10\x02\x12\x14\n" +
+	"\x10URL_MATCH_PREFIX\x10\x03*E\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SORT_ORDER_RECENTLY_USED\x10\x012\xc2\x1f\n" +
+	"\fVaultService\x12\x91\x01\n" +
+	"\x11GetLoginPasswords\x12\".v1.vault.GetLoginPasswordsRequest\x1a#.v1.vault.GetLoginPasswordsResponse\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/get-login-passwords\x90\x02\x01\x12\x8a\x01\n" +
+	"\x13GetVaultItemsStream\x12$.v1.vault.GetVaultItemsStreamRequest\x1a\x13.v1.vault.VaultItem\"6\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/vault/get-vault-items-stream\x90\x02\x010\x01\x12\x91\x01\n" +
+	"\x11ListItemSummaries\x12\".v1.vault.ListItemSummariesRequest\x1a#.v1.vault.ListItemSummariesResponse\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/list-item-summaries\x90\x02\x01\x12r\n" +
+	"\fGetVaultItem\x12\x1d.v1.vault.GetVaultItemRequest\x1a\x13.v1.vault.VaultItem\".\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/vault/get-vault-item\x90\x02\x01\x12\x8a\x01\n" +
+	"\x11SaveLoginPassword\x12\".v1.vault.SaveLoginPasswordRequest\x1a#.v1.vault.SaveLoginPasswordResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/save-login-password\x12i\n" +
+	"\tTouchItem\x12\x1a.v1.vault.TouchItemRequest\x1a\x1b.v1.vault.TouchItemResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/vault/touch-item\x12a\n" +
+	"\aPinItem\x12\x18.v1.vault.PinItemRequest\x1a\x19.v1.vault.PinItemResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/vault/pin-item\x12q\n" +
+	"\vArchiveItem\x12\x1c.v1.vault.ArchiveItemRequest\x1a\x1d.v1.vault.ArchiveItemResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vault/archive-item\x12u\n" +
+	"\fReorderItems\x12\x1d.v1.vault.ReorderItemsRequest\x1a\x1e.v1.vault.ReorderItemsResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/vault/reorder-items\x12\x92\x01\n" +
+	"\x13DeleteLoginPassword\x12$.v1.vault.DeleteLoginPasswordRequest\x1a%.v1.vault.DeleteLoginPasswordResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/v1/vault/delete-login-password\x12\x95\x01\n" +
+	"\x12GetWifiCredentials\x12#.v1.vault.GetWifiCredentialsRequest\x1a$.v1.vault.GetWifiCredentialsResponse\"4\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/vault/get-wifi-credentials\x90\x02\x01\x12\x8e\x01\n" +
+	"\x12SaveWifiCredential\x12#.v1.vault.SaveWifiCredentialRequest\x1a$.v1.vault.SaveWifiCredentialResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/vault/save-wifi-credential\x12\x96\x01\n" +
+	"\x14DeleteWifiCredential\x12%.v1.vault.DeleteWifiCredentialRequest\x1a&.v1.vault.DeleteWifiCredentialResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/api/v1/vault/delete-wifi-credential\x12\x85\x01\n" +
+	"\x0eGetSeedPhrases\x12\x1f.v1.vault.GetSeedPhrasesRequest\x1a .v1.vault.GetSeedPhrasesResponse\"0\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/vault/get-seed-phrases\x90\x02\x01\x12~\n" +
+	"\x0eSaveSeedPhrase\x12\x1f.v1.vault.SaveSeedPhraseRequest\x1a .v1.vault.SaveSeedPhraseResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/vault/save-seed-phrase\x12\x86\x01\n" +
+	"\x10DeleteSeedPhrase\x12!.v1.vault.DeleteSeedPhraseRequest\x1a\".v1.vault.DeleteSeedPhraseResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/vault/delete-seed-phrase\x12\x81\x01\n" +
+	"\rGetVaultStats\x12\x1e.v1.vault.GetVaultStatsRequest\x1a\x1f.v1.vault.GetVaultStatsResponse\"/\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/vault/get-vault-stats\x90\x02\x01\x12\x89\x01\n" +
+	"\x0fGetChangesSince\x12 .v1.vault.GetChangesSinceRequest\x1a!.v1.vault.GetChangesSinceResponse\"1\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/vault/get-changes-since\x90\x02\x01\x12i\n" +
+	"\tShareItem\x12\x1a.v1.vault.ShareItemRequest\x1a\x1b.v1.vault.ShareItemResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/v1/vault/share-item\x12}\n" +
+	"\fListMyShares\x12\x1d.v1.vault.ListMySharesRequest\x1a\x1e.v1.vault.ListMySharesResponse\".\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/v1/vault/list-my-shares\x90\x02\x01\x12q\n" +
+	"\vRevokeShare\x12\x1c.v1.vault.RevokeShareRequest\x1a\x1d.v1.vault.RevokeShareResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vault/revoke-share\x12\x85\x01\n" +
+	"\x0eGetVaultHealth\x12\x1f.v1.vault.GetVaultHealthRequest\x1a .v1.vault.GetVaultHealthResponse\"0\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/vault/get-vault-health\x90\x02\x01\x12\x8e\x01\n" +
+	"\x10FindLoginsForURL\x12!.v1.vault.FindLoginsForURLRequest\x1a\".v1.vault.FindLoginsForURLResponse\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/find-logins-for-url\x90\x02\x01\x12l\n" +
+	"\n" +
+	"GetFavicon\x12\x1b.v1.vault.GetFaviconRequest\x1a\x14.google.api.HttpBody\"+\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/v1/vault/favicon/{host}\x90\x02\x01\x12\x82\x01\n" +
+	"\x0fSetItemRotation\x12 .v1.vault.SetItemRotationRequest\x1a!.v1.vault.SetItemRotationResponse\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/vault/set-item-rotation\x12\x86\x01\n" +
+	"\x10SetVaultRotation\x12!.v1.vault.SetVaultRotationRequest\x1a\".v1.vault.SetVaultRotationResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/vault/set-vault-rotation\x12t\n" +
+	"\n" +
+	"ListVaults\x12\x1b.v1.vault.ListVaultsRequest\x1a\x1c.v1.vault.ListVaultsResponse\"+\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/api/v1/vault/list-vaults\x90\x02\x01\x12q\n" +
+	"\vCreateVault\x12\x1c.v1.vault.CreateVaultRequest\x1a\x1d.v1.vault.CreateVaultResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vault/create-vault\x12q\n" +
+	"\vRenameVault\x12\x1c.v1.vault.RenameVaultRequest\x1a\x1d.v1.vault.RenameVaultResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vault/rename-vault\x12q\n" +
+	"\vDeleteVault\x12\x1c.v1.vault.DeleteVaultRequest\x1a\x1d.v1.vault.DeleteVaultResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/v1/vault/delete-vault\x12\x8a\x01\n" +
+	"\x11WatchVaultChanges\x12\".v1.vault.WatchVaultChangesRequest\x1a\x1a.v1.vault.VaultChangeEvent\"3\x80\xb5\x18\x01\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/vault/watch-vault-changes\x90\x02\x010\x01B7Z5github.com/cmrd-a/GophKeeper/gen/proto/v1/vault;vaultb\x06proto3"
 
 var (
 	file_proto_v1_vault_vault_proto_rawDescOnce sync.Once
@@ -368,29 +4996,225 @@ func file_proto_v1_vault_vault_proto_rawDescGZIP() []byte {
 	return file_proto_v1_vault_vault_proto_rawDescData
 }
 
-var file_proto_v1_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_v1_vault_vault_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_proto_v1_vault_vault_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
 var file_proto_v1_vault_vault_proto_goTypes = []any{
-	(*GetLoginPasswordsRequest)(nil),                // 0: v1.vault.GetLoginPasswordsRequest
-	(*GetLoginPasswordsResponse)(nil),               // 1: v1.vault.GetLoginPasswordsResponse
-	(*SaveLoginPasswordRequest)(nil),                // 2: v1.vault.SaveLoginPasswordRequest
-	(*SaveLoginPasswordResponse)(nil),               // 3: v1.vault.SaveLoginPasswordResponse
-	(*DeleteLoginPasswordRequest)(nil),              // 4: v1.vault.DeleteLoginPasswordRequest
-	(*DeleteLoginPasswordResponse)(nil),             // 5: v1.vault.DeleteLoginPasswordResponse
-	(*GetLoginPasswordsResponse_LoginPassword)(nil), // 6: v1.vault.GetLoginPasswordsResponse.LoginPassword
+	(ItemType)(0),                                   // 0: v1.vault.ItemType
+	(WifiSecurity)(0),                               // 1: v1.vault.WifiSecurity
+	(Operation)(0),                                  // 2: v1.vault.Operation
+	(URLMatch)(0),                                   // 3: v1.vault.URLMatch
+	(SortOrder)(0),                                  // 4: v1.vault.SortOrder
+	(*WifiCredential)(nil),                          // 5: v1.vault.WifiCredential
+	(*LoginURL)(nil),                                // 6: v1.vault.LoginURL
+	(*GetLoginPasswordsRequest)(nil),                // 7: v1.vault.GetLoginPasswordsRequest
+	(*GetLoginPasswordsResponse)(nil),               // 8: v1.vault.GetLoginPasswordsResponse
+	(*GetVaultItemsStreamRequest)(nil),              // 9: v1.vault.GetVaultItemsStreamRequest
+	(*VaultItem)(nil),                               // 10: v1.vault.VaultItem
+	(*ListItemSummariesRequest)(nil),                // 11: v1.vault.ListItemSummariesRequest
+	(*ListItemSummariesResponse)(nil),               // 12: v1.vault.ListItemSummariesResponse
+	(*GetVaultItemRequest)(nil),                     // 13: v1.vault.GetVaultItemRequest
+	(*SaveLoginPasswordRequest)(nil),                // 14: v1.vault.SaveLoginPasswordRequest
+	(*SaveLoginPasswordResponse)(nil),               // 15: v1.vault.SaveLoginPasswordResponse
+	(*SeedPhrase)(nil),                              // 16: v1.vault.SeedPhrase
+	(*GetSeedPhrasesRequest)(nil),                   // 17: v1.vault.GetSeedPhrasesRequest
+	(*GetSeedPhrasesResponse)(nil),                  // 18: v1.vault.GetSeedPhrasesResponse
+	(*SaveSeedPhraseRequest)(nil),                   // 19: v1.vault.SaveSeedPhraseRequest
+	(*SaveSeedPhraseResponse)(nil),                  // 20: v1.vault.SaveSeedPhraseResponse
+	(*DeleteSeedPhraseRequest)(nil),                 // 21: v1.vault.DeleteSeedPhraseRequest
+	(*DeleteSeedPhraseResponse)(nil),                // 22: v1.vault.DeleteSeedPhraseResponse
+	(*GetVaultStatsRequest)(nil),                    // 23: v1.vault.GetVaultStatsRequest
+	(*GetVaultStatsResponse)(nil),                   // 24: v1.vault.GetVaultStatsResponse
+	(*GetWifiCredentialsRequest)(nil),               // 25: v1.vault.GetWifiCredentialsRequest
+	(*GetWifiCredentialsResponse)(nil),              // 26: v1.vault.GetWifiCredentialsResponse
+	(*SaveWifiCredentialRequest)(nil),               // 27: v1.vault.SaveWifiCredentialRequest
+	(*SaveWifiCredentialResponse)(nil),              // 28: v1.vault.SaveWifiCredentialResponse
+	(*DeleteWifiCredentialRequest)(nil),             // 29: v1.vault.DeleteWifiCredentialRequest
+	(*DeleteWifiCredentialResponse)(nil),            // 30: v1.vault.DeleteWifiCredentialResponse
+	(*TouchItemRequest)(nil),                        // 31: v1.vault.TouchItemRequest
+	(*TouchItemResponse)(nil),                       // 32: v1.vault.TouchItemResponse
+	(*PinItemRequest)(nil),                          // 33: v1.vault.PinItemRequest
+	(*PinItemResponse)(nil),                         // 34: v1.vault.PinItemResponse
+	(*ArchiveItemRequest)(nil),                      // 35: v1.vault.ArchiveItemRequest
+	(*ArchiveItemResponse)(nil),                     // 36: v1.vault.ArchiveItemResponse
+	(*ReorderItemsRequest)(nil),                     // 37: v1.vault.ReorderItemsRequest
+	(*ReorderItemsResponse)(nil),                    // 38: v1.vault.ReorderItemsResponse
+	(*DeleteLoginPasswordRequest)(nil),              // 39: v1.vault.DeleteLoginPasswordRequest
+	(*DeleteLoginPasswordResponse)(nil),             // 40: v1.vault.DeleteLoginPasswordResponse
+	(*WatchVaultChangesRequest)(nil),                // 41: v1.vault.WatchVaultChangesRequest
+	(*VaultChangeEvent)(nil),                        // 42: v1.vault.VaultChangeEvent
+	(*GetChangesSinceRequest)(nil),                  // 43: v1.vault.GetChangesSinceRequest
+	(*GetChangesSinceResponse)(nil),                 // 44: v1.vault.GetChangesSinceResponse
+	(*ShareItemRequest)(nil),                        // 45: v1.vault.ShareItemRequest
+	(*ShareItemResponse)(nil),                       // 46: v1.vault.ShareItemResponse
+	(*ListMySharesRequest)(nil),                     // 47: v1.vault.ListMySharesRequest
+	(*ListMySharesResponse)(nil),                    // 48: v1.vault.ListMySharesResponse
+	(*RevokeShareRequest)(nil),                      // 49: v1.vault.RevokeShareRequest
+	(*RevokeShareResponse)(nil),                     // 50: v1.vault.RevokeShareResponse
+	(*GetVaultHealthRequest)(nil),                   // 51: v1.vault.GetVaultHealthRequest
+	(*GetVaultHealthResponse)(nil),                  // 52: v1.vault.GetVaultHealthResponse
+	(*FindLoginsForURLRequest)(nil),                 // 53: v1.vault.FindLoginsForURLRequest
+	(*FindLoginsForURLResponse)(nil),                // 54: v1.vault.FindLoginsForURLResponse
+	(*GetFaviconRequest)(nil),                       // 55: v1.vault.GetFaviconRequest
+	(*ListVaultsRequest)(nil),                       // 56: v1.vault.ListVaultsRequest
+	(*ListVaultsResponse)(nil),                      // 57: v1.vault.ListVaultsResponse
+	(*SetItemRotationRequest)(nil),                  // 58: v1.vault.SetItemRotationRequest
+	(*SetItemRotationResponse)(nil),                 // 59: v1.vault.SetItemRotationResponse
+	(*SetVaultRotationRequest)(nil),                 // 60: v1.vault.SetVaultRotationRequest
+	(*SetVaultRotationResponse)(nil),                // 61: v1.vault.SetVaultRotationResponse
+	(*CreateVaultRequest)(nil),                      // 62: v1.vault.CreateVaultRequest
+	(*CreateVaultResponse)(nil),                     // 63: v1.vault.CreateVaultResponse
+	(*RenameVaultRequest)(nil),                      // 64: v1.vault.RenameVaultRequest
+	(*RenameVaultResponse)(nil),                     // 65: v1.vault.RenameVaultResponse
+	(*DeleteVaultRequest)(nil),                      // 66: v1.vault.DeleteVaultRequest
+	(*DeleteVaultResponse)(nil),                     // 67: v1.vault.DeleteVaultResponse
+	(*GetLoginPasswordsResponse_LoginPassword)(nil), // 68: v1.vault.GetLoginPasswordsResponse.LoginPassword
+	(*VaultItem_LoginPassword)(nil),                 // 69: v1.vault.VaultItem.LoginPassword
+	(*ListItemSummariesResponse_ItemSummary)(nil),   // 70: v1.vault.ListItemSummariesResponse.ItemSummary
+	nil,                                      // 71: v1.vault.GetVaultStatsResponse.StorageBytesEntry
+	(*GetVaultStatsResponse_MonthCount)(nil), // 72: v1.vault.GetVaultStatsResponse.MonthCount
+	(*GetVaultStatsResponse_OldPassword)(nil),      // 73: v1.vault.GetVaultStatsResponse.OldPassword
+	(*GetChangesSinceResponse_LoginPassword)(nil),  // 74: v1.vault.GetChangesSinceResponse.LoginPassword
+	(*GetChangesSinceResponse_Tombstone)(nil),      // 75: v1.vault.GetChangesSinceResponse.Tombstone
+	(*ListMySharesResponse_Share)(nil),             // 76: v1.vault.ListMySharesResponse.Share
+	(*GetVaultHealthResponse_Finding)(nil),         // 77: v1.vault.GetVaultHealthResponse.Finding
+	(*GetVaultHealthResponse_ReuseGroup)(nil),      // 78: v1.vault.GetVaultHealthResponse.ReuseGroup
+	(*FindLoginsForURLResponse_LoginPassword)(nil), // 79: v1.vault.FindLoginsForURLResponse.LoginPassword
+	(*ListVaultsResponse_Vault)(nil),               // 80: v1.vault.ListVaultsResponse.Vault
+	(*timestamppb.Timestamp)(nil),                  // 81: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),                  // 82: google.protobuf.FieldMask
+	(*httpbody.HttpBody)(nil),                      // 83: google.api.HttpBody
 }
 var file_proto_v1_vault_vault_proto_depIdxs = []int32{
-	6, // 0: v1.vault.GetLoginPasswordsResponse.login_passwords:type_name -> v1.vault.GetLoginPasswordsResponse.LoginPassword
-	0, // 1: v1.vault.VaultService.GetLoginPasswords:input_type -> v1.vault.GetLoginPasswordsRequest
-	2, // 2: v1.vault.VaultService.SaveLoginPassword:input_type -> v1.vault.SaveLoginPasswordRequest
-	4, // 3: v1.vault.VaultService.DeleteLoginPassword:input_type -> v1.vault.DeleteLoginPasswordRequest
-	1, // 4: v1.vault.VaultService.GetLoginPasswords:output_type -> v1.vault.GetLoginPasswordsResponse
-	3, // 5: v1.vault.VaultService.SaveLoginPassword:output_type -> v1.vault.SaveLoginPasswordResponse
-	5, // 6: v1.vault.VaultService.DeleteLoginPassword:output_type -> v1.vault.DeleteLoginPasswordResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1,  // 0: v1.vault.WifiCredential.security:type_name -> v1.vault.WifiSecurity
+	81, // 1: v1.vault.WifiCredential.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 2: v1.vault.WifiCredential.last_used_at:type_name -> google.protobuf.Timestamp
+	3,  // 3: v1.vault.LoginURL.match:type_name -> v1.vault.URLMatch
+	4,  // 4: v1.vault.GetLoginPasswordsRequest.sort:type_name -> v1.vault.SortOrder
+	81, // 5: v1.vault.GetLoginPasswordsRequest.used_since:type_name -> google.protobuf.Timestamp
+	82, // 6: v1.vault.GetLoginPasswordsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	68, // 7: v1.vault.GetLoginPasswordsResponse.login_passwords:type_name -> v1.vault.GetLoginPasswordsResponse.LoginPassword
+	82, // 8: v1.vault.GetVaultItemsStreamRequest.read_mask:type_name -> google.protobuf.FieldMask
+	69, // 9: v1.vault.VaultItem.login_password:type_name -> v1.vault.VaultItem.LoginPassword
+	5,  // 10: v1.vault.VaultItem.wifi_credential:type_name -> v1.vault.WifiCredential
+	16, // 11: v1.vault.VaultItem.seed_phrase:type_name -> v1.vault.SeedPhrase
+	70, // 12: v1.vault.ListItemSummariesResponse.items:type_name -> v1.vault.ListItemSummariesResponse.ItemSummary
+	0,  // 13: v1.vault.GetVaultItemRequest.type:type_name -> v1.vault.ItemType
+	6,  // 14: v1.vault.SaveLoginPasswordRequest.urls:type_name -> v1.vault.LoginURL
+	81, // 15: v1.vault.SeedPhrase.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 16: v1.vault.SeedPhrase.last_used_at:type_name -> google.protobuf.Timestamp
+	16, // 17: v1.vault.GetSeedPhrasesResponse.seed_phrases:type_name -> v1.vault.SeedPhrase
+	72, // 18: v1.vault.GetVaultStatsResponse.added_per_month:type_name -> v1.vault.GetVaultStatsResponse.MonthCount
+	71, // 19: v1.vault.GetVaultStatsResponse.storage_bytes:type_name -> v1.vault.GetVaultStatsResponse.StorageBytesEntry
+	73, // 20: v1.vault.GetVaultStatsResponse.oldest_passwords:type_name -> v1.vault.GetVaultStatsResponse.OldPassword
+	5,  // 21: v1.vault.GetWifiCredentialsResponse.wifi_credentials:type_name -> v1.vault.WifiCredential
+	1,  // 22: v1.vault.SaveWifiCredentialRequest.security:type_name -> v1.vault.WifiSecurity
+	0,  // 23: v1.vault.VaultChangeEvent.item_type:type_name -> v1.vault.ItemType
+	2,  // 24: v1.vault.VaultChangeEvent.operation:type_name -> v1.vault.Operation
+	81, // 25: v1.vault.GetChangesSinceRequest.since:type_name -> google.protobuf.Timestamp
+	74, // 26: v1.vault.GetChangesSinceResponse.login_passwords:type_name -> v1.vault.GetChangesSinceResponse.LoginPassword
+	75, // 27: v1.vault.GetChangesSinceResponse.deleted:type_name -> v1.vault.GetChangesSinceResponse.Tombstone
+	81, // 28: v1.vault.GetChangesSinceResponse.synced_at:type_name -> google.protobuf.Timestamp
+	5,  // 29: v1.vault.GetChangesSinceResponse.wifi_credentials:type_name -> v1.vault.WifiCredential
+	16, // 30: v1.vault.GetChangesSinceResponse.seed_phrases:type_name -> v1.vault.SeedPhrase
+	81, // 31: v1.vault.ShareItemRequest.expires_at:type_name -> google.protobuf.Timestamp
+	76, // 32: v1.vault.ListMySharesResponse.shares:type_name -> v1.vault.ListMySharesResponse.Share
+	77, // 33: v1.vault.GetVaultHealthResponse.weak:type_name -> v1.vault.GetVaultHealthResponse.Finding
+	78, // 34: v1.vault.GetVaultHealthResponse.reused:type_name -> v1.vault.GetVaultHealthResponse.ReuseGroup
+	77, // 35: v1.vault.GetVaultHealthResponse.breached:type_name -> v1.vault.GetVaultHealthResponse.Finding
+	77, // 36: v1.vault.GetVaultHealthResponse.old:type_name -> v1.vault.GetVaultHealthResponse.Finding
+	77, // 37: v1.vault.GetVaultHealthResponse.rotation_due:type_name -> v1.vault.GetVaultHealthResponse.Finding
+	79, // 38: v1.vault.FindLoginsForURLResponse.login_passwords:type_name -> v1.vault.FindLoginsForURLResponse.LoginPassword
+	80, // 39: v1.vault.ListVaultsResponse.vaults:type_name -> v1.vault.ListVaultsResponse.Vault
+	6,  // 40: v1.vault.GetLoginPasswordsResponse.LoginPassword.urls:type_name -> v1.vault.LoginURL
+	81, // 41: v1.vault.GetLoginPasswordsResponse.LoginPassword.last_used_at:type_name -> google.protobuf.Timestamp
+	6,  // 42: v1.vault.VaultItem.LoginPassword.urls:type_name -> v1.vault.LoginURL
+	81, // 43: v1.vault.VaultItem.LoginPassword.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 44: v1.vault.VaultItem.LoginPassword.last_used_at:type_name -> google.protobuf.Timestamp
+	0,  // 45: v1.vault.ListItemSummariesResponse.ItemSummary.type:type_name -> v1.vault.ItemType
+	81, // 46: v1.vault.ListItemSummariesResponse.ItemSummary.created_at:type_name -> google.protobuf.Timestamp
+	81, // 47: v1.vault.ListItemSummariesResponse.ItemSummary.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 48: v1.vault.GetVaultStatsResponse.MonthCount.month:type_name -> google.protobuf.Timestamp
+	81, // 49: v1.vault.GetVaultStatsResponse.OldPassword.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 50: v1.vault.GetChangesSinceResponse.LoginPassword.updated_at:type_name -> google.protobuf.Timestamp
+	6,  // 51: v1.vault.GetChangesSinceResponse.LoginPassword.urls:type_name -> v1.vault.LoginURL
+	81, // 52: v1.vault.GetChangesSinceResponse.LoginPassword.last_used_at:type_name -> google.protobuf.Timestamp
+	0,  // 53: v1.vault.GetChangesSinceResponse.Tombstone.item_type:type_name -> v1.vault.ItemType
+	81, // 54: v1.vault.GetChangesSinceResponse.Tombstone.deleted_at:type_name -> google.protobuf.Timestamp
+	0,  // 55: v1.vault.ListMySharesResponse.Share.item_type:type_name -> v1.vault.ItemType
+	81, // 56: v1.vault.ListMySharesResponse.Share.expires_at:type_name -> google.protobuf.Timestamp
+	81, // 57: v1.vault.ListMySharesResponse.Share.created_at:type_name -> google.protobuf.Timestamp
+	81, // 58: v1.vault.GetVaultHealthResponse.Finding.updated_at:type_name -> google.protobuf.Timestamp
+	81, // 59: v1.vault.GetVaultHealthResponse.Finding.rotation_due_at:type_name -> google.protobuf.Timestamp
+	77, // 60: v1.vault.GetVaultHealthResponse.ReuseGroup.items:type_name -> v1.vault.GetVaultHealthResponse.Finding
+	6,  // 61: v1.vault.FindLoginsForURLResponse.LoginPassword.urls:type_name -> v1.vault.LoginURL
+	81, // 62: v1.vault.ListVaultsResponse.Vault.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 63: v1.vault.VaultService.GetLoginPasswords:input_type -> v1.vault.GetLoginPasswordsRequest
+	9,  // 64: v1.vault.VaultService.GetVaultItemsStream:input_type -> v1.vault.GetVaultItemsStreamRequest
+	11, // 65: v1.vault.VaultService.ListItemSummaries:input_type -> v1.vault.ListItemSummariesRequest
+	13, // 66: v1.vault.VaultService.GetVaultItem:input_type -> v1.vault.GetVaultItemRequest
+	14, // 67: v1.vault.VaultService.SaveLoginPassword:input_type -> v1.vault.SaveLoginPasswordRequest
+	31, // 68: v1.vault.VaultService.TouchItem:input_type -> v1.vault.TouchItemRequest
+	33, // 69: v1.vault.VaultService.PinItem:input_type -> v1.vault.PinItemRequest
+	35, // 70: v1.vault.VaultService.ArchiveItem:input_type -> v1.vault.ArchiveItemRequest
+	37, // 71: v1.vault.VaultService.ReorderItems:input_type -> v1.vault.ReorderItemsRequest
+	39, // 72: v1.vault.VaultService.DeleteLoginPassword:input_type -> v1.vault.DeleteLoginPasswordRequest
+	25, // 73: v1.vault.VaultService.GetWifiCredentials:input_type -> v1.vault.GetWifiCredentialsRequest
+	27, // 74: v1.vault.VaultService.SaveWifiCredential:input_type -> v1.vault.SaveWifiCredentialRequest
+	29, // 75: v1.vault.VaultService.DeleteWifiCredential:input_type -> v1.vault.DeleteWifiCredentialRequest
+	17, // 76: v1.vault.VaultService.GetSeedPhrases:input_type -> v1.vault.GetSeedPhrasesRequest
+	19, // 77: v1.vault.VaultService.SaveSeedPhrase:input_type -> v1.vault.SaveSeedPhraseRequest
+	21, // 78: v1.vault.VaultService.DeleteSeedPhrase:input_type -> v1.vault.DeleteSeedPhraseRequest
+	23, // 79: v1.vault.VaultService.GetVaultStats:input_type -> v1.vault.GetVaultStatsRequest
+	43, // 80: v1.vault.VaultService.GetChangesSince:input_type -> v1.vault.GetChangesSinceRequest
+	45, // 81: v1.vault.VaultService.ShareItem:input_type -> v1.vault.ShareItemRequest
+	47, // 82: v1.vault.VaultService.ListMyShares:input_type -> v1.vault.ListMySharesRequest
+	49, // 83: v1.vault.VaultService.RevokeShare:input_type -> v1.vault.RevokeShareRequest
+	51, // 84: v1.vault.VaultService.GetVaultHealth:input_type -> v1.vault.GetVaultHealthRequest
+	53, // 85: v1.vault.VaultService.FindLoginsForURL:input_type -> v1.vault.FindLoginsForURLRequest
+	55, // 86: v1.vault.VaultService.GetFavicon:input_type -> v1.vault.GetFaviconRequest
+	58, // 87: v1.vault.VaultService.SetItemRotation:input_type -> v1.vault.SetItemRotationRequest
+	60, // 88: v1.vault.VaultService.SetVaultRotation:input_type -> v1.vault.SetVaultRotationRequest
+	56, // 89: v1.vault.VaultService.ListVaults:input_type -> v1.vault.ListVaultsRequest
+	62, // 90: v1.vault.VaultService.CreateVault:input_type -> v1.vault.CreateVaultRequest
+	64, // 91: v1.vault.VaultService.RenameVault:input_type -> v1.vault.RenameVaultRequest
+	66, // 92: v1.vault.VaultService.DeleteVault:input_type -> v1.vault.DeleteVaultRequest
+	41, // 93: v1.vault.VaultService.WatchVaultChanges:input_type -> v1.vault.WatchVaultChangesRequest
+	8,  // 94: v1.vault.VaultService.GetLoginPasswords:output_type -> v1.vault.GetLoginPasswordsResponse
+	10, // 95: v1.vault.VaultService.GetVaultItemsStream:output_type -> v1.vault.VaultItem
+	12, // 96: v1.vault.VaultService.ListItemSummaries:output_type -> v1.vault.ListItemSummariesResponse
+	10, // 97: v1.vault.VaultService.GetVaultItem:output_type -> v1.vault.VaultItem
+	15, // 98: v1.vault.VaultService.SaveLoginPassword:output_type -> v1.vault.SaveLoginPasswordResponse
+	32, // 99: v1.vault.VaultService.TouchItem:output_type -> v1.vault.TouchItemResponse
+	34, // 100: v1.vault.VaultService.PinItem:output_type -> v1.vault.PinItemResponse
+	36, // 101: v1.vault.VaultService.ArchiveItem:output_type -> v1.vault.ArchiveItemResponse
+	38, // 102: v1.vault.VaultService.ReorderItems:output_type -> v1.vault.ReorderItemsResponse
+	40, // 103: v1.vault.VaultService.DeleteLoginPassword:output_type -> v1.vault.DeleteLoginPasswordResponse
+	26, // 104: v1.vault.VaultService.GetWifiCredentials:output_type -> v1.vault.GetWifiCredentialsResponse
+	28, // 105: v1.vault.VaultService.SaveWifiCredential:output_type -> v1.vault.SaveWifiCredentialResponse
+	30, // 106: v1.vault.VaultService.DeleteWifiCredential:output_type -> v1.vault.DeleteWifiCredentialResponse
+	18, // 107: v1.vault.VaultService.GetSeedPhrases:output_type -> v1.vault.GetSeedPhrasesResponse
+	20, // 108: v1.vault.VaultService.SaveSeedPhrase:output_type -> v1.vault.SaveSeedPhraseResponse
+	22, // 109: v1.vault.VaultService.DeleteSeedPhrase:output_type -> v1.vault.DeleteSeedPhraseResponse
+	24, // 110: v1.vault.VaultService.GetVaultStats:output_type -> v1.vault.GetVaultStatsResponse
+	44, // 111: v1.vault.VaultService.GetChangesSince:output_type -> v1.vault.GetChangesSinceResponse
+	46, // 112: v1.vault.VaultService.ShareItem:output_type -> v1.vault.ShareItemResponse
+	48, // 113: v1.vault.VaultService.ListMyShares:output_type -> v1.vault.ListMySharesResponse
+	50, // 114: v1.vault.VaultService.RevokeShare:output_type -> v1.vault.RevokeShareResponse
+	52, // 115: v1.vault.VaultService.GetVaultHealth:output_type -> v1.vault.GetVaultHealthResponse
+	54, // 116: v1.vault.VaultService.FindLoginsForURL:output_type -> v1.vault.FindLoginsForURLResponse
+	83, // 117: v1.vault.VaultService.GetFavicon:output_type -> google.api.HttpBody
+	59, // 118: v1.vault.VaultService.SetItemRotation:output_type -> v1.vault.SetItemRotationResponse
+	61, // 119: v1.vault.VaultService.SetVaultRotation:output_type -> v1.vault.SetVaultRotationResponse
+	57, // 120: v1.vault.VaultService.ListVaults:output_type -> v1.vault.ListVaultsResponse
+	63, // 121: v1.vault.VaultService.CreateVault:output_type -> v1.vault.CreateVaultResponse
+	65, // 122: v1.vault.VaultService.RenameVault:output_type -> v1.vault.RenameVaultResponse
+	67, // 123: v1.vault.VaultService.DeleteVault:output_type -> v1.vault.DeleteVaultResponse
+	42, // 124: v1.vault.VaultService.WatchVaultChanges:output_type -> v1.vault.VaultChangeEvent
+	94, // [94:125] is the sub-list for method output_type
+	63, // [63:94] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
 }
 
 func init() { file_proto_v1_vault_vault_proto_init() }
@@ -398,19 +5222,32 @@ func file_proto_v1_vault_vault_proto_init() {
 	if File_proto_v1_vault_vault_proto != nil {
 		return
 	}
-	file_proto_v1_vault_vault_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[4].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[5].OneofWrappers = []any{
+		(*VaultItem_LoginPassword_)(nil),
+		(*VaultItem_WifiCredential)(nil),
+		(*VaultItem_SeedPhrase)(nil),
+	}
+	file_proto_v1_vault_vault_proto_msgTypes[6].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[9].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[14].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[16].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[22].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[24].OneofWrappers = []any{}
+	file_proto_v1_vault_vault_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_v1_vault_vault_proto_rawDesc), len(file_proto_v1_vault_vault_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      5,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_v1_vault_vault_proto_goTypes,
 		DependencyIndexes: file_proto_v1_vault_vault_proto_depIdxs,
+		EnumInfos:         file_proto_v1_vault_vault_proto_enumTypes,
 		MessageInfos:      file_proto_v1_vault_vault_proto_msgTypes,
 	}.Build()
 	File_proto_v1_vault_vault_proto = out.File
@@ -9,6 +9,7 @@ package vault
 import (
 	context "context"
 
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -20,9 +21,37 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VaultService_GetLoginPasswords_FullMethodName   = "/v1.vault.VaultService/GetLoginPasswords"
-	VaultService_SaveLoginPassword_FullMethodName   = "/v1.vault.VaultService/SaveLoginPassword"
-	VaultService_DeleteLoginPassword_FullMethodName = "/v1.vault.VaultService/DeleteLoginPassword"
+	VaultService_GetLoginPasswords_FullMethodName    = "/v1.vault.VaultService/GetLoginPasswords"
+	VaultService_GetVaultItemsStream_FullMethodName  = "/v1.vault.VaultService/GetVaultItemsStream"
+	VaultService_ListItemSummaries_FullMethodName    = "/v1.vault.VaultService/ListItemSummaries"
+	VaultService_GetVaultItem_FullMethodName         = "/v1.vault.VaultService/GetVaultItem"
+	VaultService_SaveLoginPassword_FullMethodName    = "/v1.vault.VaultService/SaveLoginPassword"
+	VaultService_TouchItem_FullMethodName            = "/v1.vault.VaultService/TouchItem"
+	VaultService_PinItem_FullMethodName              = "/v1.vault.VaultService/PinItem"
+	VaultService_ArchiveItem_FullMethodName          = "/v1.vault.VaultService/ArchiveItem"
+	VaultService_ReorderItems_FullMethodName         = "/v1.vault.VaultService/ReorderItems"
+	VaultService_DeleteLoginPassword_FullMethodName  = "/v1.vault.VaultService/DeleteLoginPassword"
+	VaultService_GetWifiCredentials_FullMethodName   = "/v1.vault.VaultService/GetWifiCredentials"
+	VaultService_SaveWifiCredential_FullMethodName   = "/v1.vault.VaultService/SaveWifiCredential"
+	VaultService_DeleteWifiCredential_FullMethodName = "/v1.vault.VaultService/DeleteWifiCredential"
+	VaultService_GetSeedPhrases_FullMethodName       = "/v1.vault.VaultService/GetSeedPhrases"
+	VaultService_SaveSeedPhrase_FullMethodName       = "/v1.vault.VaultService/SaveSeedPhrase"
+	VaultService_DeleteSeedPhrase_FullMethodName     = "/v1.vault.VaultService/DeleteSeedPhrase"
+	VaultService_GetVaultStats_FullMethodName        = "/v1.vault.VaultService/GetVaultStats"
+	VaultService_GetChangesSince_FullMethodName      = "/v1.vault.VaultService/GetChangesSince"
+	VaultService_ShareItem_FullMethodName            = "/v1.vault.VaultService/ShareItem"
+	VaultService_ListMyShares_FullMethodName         = "/v1.vault.VaultService/ListMyShares"
+	VaultService_RevokeShare_FullMethodName          = "/v1.vault.VaultService/RevokeShare"
+	VaultService_GetVaultHealth_FullMethodName       = "/v1.vault.VaultService/GetVaultHealth"
+	VaultService_FindLoginsForURL_FullMethodName     = "/v1.vault.VaultService/FindLoginsForURL"
+	VaultService_GetFavicon_FullMethodName           = "/v1.vault.VaultService/GetFavicon"
+	VaultService_SetItemRotation_FullMethodName      = "/v1.vault.VaultService/SetItemRotation"
+	VaultService_SetVaultRotation_FullMethodName     = "/v1.vault.VaultService/SetVaultRotation"
+	VaultService_ListVaults_FullMethodName           = "/v1.vault.VaultService/ListVaults"
+	VaultService_CreateVault_FullMethodName          = "/v1.vault.VaultService/CreateVault"
+	VaultService_RenameVault_FullMethodName          = "/v1.vault.VaultService/RenameVault"
+	VaultService_DeleteVault_FullMethodName          = "/v1.vault.VaultService/DeleteVault"
+	VaultService_WatchVaultChanges_FullMethodName    = "/v1.vault.VaultService/WatchVaultChanges"
 )
 
 // VaultServiceClient is the client API for VaultService service.
@@ -32,8 +61,52 @@ const (
 // VaultService service definition
 type VaultServiceClient interface {
 	GetLoginPasswords(ctx context.Context, in *GetLoginPasswordsRequest, opts ...grpc.CallOption) (*GetLoginPasswordsResponse, error)
+	// GetVaultItemsStream sends items of every type one by one, for vaults too big for a single response.
+	GetVaultItemsStream(ctx context.Context, in *GetVaultItemsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VaultItem], error)
+	// ListItemSummaries lists items of every type without their secrets; fetch one with GetVaultItem when it is opened.
+	ListItemSummaries(ctx context.Context, in *ListItemSummariesRequest, opts ...grpc.CallOption) (*ListItemSummariesResponse, error)
+	GetVaultItem(ctx context.Context, in *GetVaultItemRequest, opts ...grpc.CallOption) (*VaultItem, error)
+	// Retries with the same idempotency-key metadata within the server's window return the original response.
 	SaveLoginPassword(ctx context.Context, in *SaveLoginPasswordRequest, opts ...grpc.CallOption) (*SaveLoginPasswordResponse, error)
+	// TouchItem records that the item was viewed or its secret copied. Items shared read-only can't be touched.
+	TouchItem(ctx context.Context, in *TouchItemRequest, opts ...grpc.CallOption) (*TouchItemResponse, error)
+	// PinItem pins or unpins an item, pinned items are listed first.
+	PinItem(ctx context.Context, in *PinItemRequest, opts ...grpc.CallOption) (*PinItemResponse, error)
+	// ArchiveItem archives or restores an item. Archived items are hidden from ListItemSummaries
+	// and FindLoginsForURL unless include_archived is set, but are still synced, unlike deleted ones.
+	ArchiveItem(ctx context.Context, in *ArchiveItemRequest, opts ...grpc.CallOption) (*ArchiveItemResponse, error)
+	// ReorderItems places the listed items in this order, ahead of items never ordered.
+	// Items not listed, like those of other vaults, keep their place.
+	ReorderItems(ctx context.Context, in *ReorderItemsRequest, opts ...grpc.CallOption) (*ReorderItemsResponse, error)
 	DeleteLoginPassword(ctx context.Context, in *DeleteLoginPasswordRequest, opts ...grpc.CallOption) (*DeleteLoginPasswordResponse, error)
+	GetWifiCredentials(ctx context.Context, in *GetWifiCredentialsRequest, opts ...grpc.CallOption) (*GetWifiCredentialsResponse, error)
+	SaveWifiCredential(ctx context.Context, in *SaveWifiCredentialRequest, opts ...grpc.CallOption) (*SaveWifiCredentialResponse, error)
+	DeleteWifiCredential(ctx context.Context, in *DeleteWifiCredentialRequest, opts ...grpc.CallOption) (*DeleteWifiCredentialResponse, error)
+	GetSeedPhrases(ctx context.Context, in *GetSeedPhrasesRequest, opts ...grpc.CallOption) (*GetSeedPhrasesResponse, error)
+	SaveSeedPhrase(ctx context.Context, in *SaveSeedPhraseRequest, opts ...grpc.CallOption) (*SaveSeedPhraseResponse, error)
+	DeleteSeedPhrase(ctx context.Context, in *DeleteSeedPhraseRequest, opts ...grpc.CallOption) (*DeleteSeedPhraseResponse, error)
+	// GetVaultStats reports how the vault grew and what it stores.
+	GetVaultStats(ctx context.Context, in *GetVaultStatsRequest, opts ...grpc.CallOption) (*GetVaultStatsResponse, error)
+	GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error)
+	ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*ShareItemResponse, error)
+	ListMyShares(ctx context.Context, in *ListMySharesRequest, opts ...grpc.CallOption) (*ListMySharesResponse, error)
+	RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*RevokeShareResponse, error)
+	GetVaultHealth(ctx context.Context, in *GetVaultHealthRequest, opts ...grpc.CallOption) (*GetVaultHealthResponse, error)
+	// FindLoginsForURL returns login passwords with a URL matching the page URL, for autofill.
+	FindLoginsForURL(ctx context.Context, in *FindLoginsForURLRequest, opts ...grpc.CallOption) (*FindLoginsForURLResponse, error)
+	// GetFavicon returns the icon of a login URL host as an image, so web clients can use it directly.
+	GetFavicon(ctx context.Context, in *GetFaviconRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
+	// SetItemRotation sets how often the password of a login item should be changed, overriding its vault's policy.
+	SetItemRotation(ctx context.Context, in *SetItemRotationRequest, opts ...grpc.CallOption) (*SetItemRotationResponse, error)
+	// SetVaultRotation sets how often the passwords of the login items in a vault should be changed.
+	SetVaultRotation(ctx context.Context, in *SetVaultRotationRequest, opts ...grpc.CallOption) (*SetVaultRotationResponse, error)
+	// ListVaults lists the caller's vaults, oldest first. Users get the default vault on registration.
+	ListVaults(ctx context.Context, in *ListVaultsRequest, opts ...grpc.CallOption) (*ListVaultsResponse, error)
+	CreateVault(ctx context.Context, in *CreateVaultRequest, opts ...grpc.CallOption) (*CreateVaultResponse, error)
+	RenameVault(ctx context.Context, in *RenameVaultRequest, opts ...grpc.CallOption) (*RenameVaultResponse, error)
+	// DeleteVault deletes an empty vault.
+	DeleteVault(ctx context.Context, in *DeleteVaultRequest, opts ...grpc.CallOption) (*DeleteVaultResponse, error)
+	WatchVaultChanges(ctx context.Context, in *WatchVaultChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VaultChangeEvent], error)
 }
 
 type vaultServiceClient struct {
@@ -54,6 +127,45 @@ func (c *vaultServiceClient) GetLoginPasswords(ctx context.Context, in *GetLogin
 	return out, nil
 }
 
+func (c *vaultServiceClient) GetVaultItemsStream(ctx context.Context, in *GetVaultItemsStreamRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VaultItem], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[0], VaultService_GetVaultItemsStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetVaultItemsStreamRequest, VaultItem]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_GetVaultItemsStreamClient = grpc.ServerStreamingClient[VaultItem]
+
+func (c *vaultServiceClient) ListItemSummaries(ctx context.Context, in *ListItemSummariesRequest, opts ...grpc.CallOption) (*ListItemSummariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListItemSummariesResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListItemSummaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultItem(ctx context.Context, in *GetVaultItemRequest, opts ...grpc.CallOption) (*VaultItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VaultItem)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vaultServiceClient) SaveLoginPassword(ctx context.Context, in *SaveLoginPasswordRequest, opts ...grpc.CallOption) (*SaveLoginPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SaveLoginPasswordResponse)
@@ -64,6 +176,46 @@ func (c *vaultServiceClient) SaveLoginPassword(ctx context.Context, in *SaveLogi
 	return out, nil
 }
 
+func (c *vaultServiceClient) TouchItem(ctx context.Context, in *TouchItemRequest, opts ...grpc.CallOption) (*TouchItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TouchItemResponse)
+	err := c.cc.Invoke(ctx, VaultService_TouchItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) PinItem(ctx context.Context, in *PinItemRequest, opts ...grpc.CallOption) (*PinItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PinItemResponse)
+	err := c.cc.Invoke(ctx, VaultService_PinItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ArchiveItem(ctx context.Context, in *ArchiveItemRequest, opts ...grpc.CallOption) (*ArchiveItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveItemResponse)
+	err := c.cc.Invoke(ctx, VaultService_ArchiveItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ReorderItems(ctx context.Context, in *ReorderItemsRequest, opts ...grpc.CallOption) (*ReorderItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderItemsResponse)
+	err := c.cc.Invoke(ctx, VaultService_ReorderItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vaultServiceClient) DeleteLoginPassword(ctx context.Context, in *DeleteLoginPasswordRequest, opts ...grpc.CallOption) (*DeleteLoginPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteLoginPasswordResponse)
@@ -74,6 +226,225 @@ func (c *vaultServiceClient) DeleteLoginPassword(ctx context.Context, in *Delete
 	return out, nil
 }
 
+func (c *vaultServiceClient) GetWifiCredentials(ctx context.Context, in *GetWifiCredentialsRequest, opts ...grpc.CallOption) (*GetWifiCredentialsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWifiCredentialsResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetWifiCredentials_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SaveWifiCredential(ctx context.Context, in *SaveWifiCredentialRequest, opts ...grpc.CallOption) (*SaveWifiCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveWifiCredentialResponse)
+	err := c.cc.Invoke(ctx, VaultService_SaveWifiCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteWifiCredential(ctx context.Context, in *DeleteWifiCredentialRequest, opts ...grpc.CallOption) (*DeleteWifiCredentialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWifiCredentialResponse)
+	err := c.cc.Invoke(ctx, VaultService_DeleteWifiCredential_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetSeedPhrases(ctx context.Context, in *GetSeedPhrasesRequest, opts ...grpc.CallOption) (*GetSeedPhrasesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSeedPhrasesResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetSeedPhrases_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SaveSeedPhrase(ctx context.Context, in *SaveSeedPhraseRequest, opts ...grpc.CallOption) (*SaveSeedPhraseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SaveSeedPhraseResponse)
+	err := c.cc.Invoke(ctx, VaultService_SaveSeedPhrase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteSeedPhrase(ctx context.Context, in *DeleteSeedPhraseRequest, opts ...grpc.CallOption) (*DeleteSeedPhraseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSeedPhraseResponse)
+	err := c.cc.Invoke(ctx, VaultService_DeleteSeedPhrase_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultStats(ctx context.Context, in *GetVaultStatsRequest, opts ...grpc.CallOption) (*GetVaultStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVaultStatsResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetChangesSince(ctx context.Context, in *GetChangesSinceRequest, opts ...grpc.CallOption) (*GetChangesSinceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChangesSinceResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetChangesSince_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ShareItem(ctx context.Context, in *ShareItemRequest, opts ...grpc.CallOption) (*ShareItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareItemResponse)
+	err := c.cc.Invoke(ctx, VaultService_ShareItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListMyShares(ctx context.Context, in *ListMySharesRequest, opts ...grpc.CallOption) (*ListMySharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMySharesResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListMyShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RevokeShare(ctx context.Context, in *RevokeShareRequest, opts ...grpc.CallOption) (*RevokeShareResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareResponse)
+	err := c.cc.Invoke(ctx, VaultService_RevokeShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetVaultHealth(ctx context.Context, in *GetVaultHealthRequest, opts ...grpc.CallOption) (*GetVaultHealthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVaultHealthResponse)
+	err := c.cc.Invoke(ctx, VaultService_GetVaultHealth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) FindLoginsForURL(ctx context.Context, in *FindLoginsForURLRequest, opts ...grpc.CallOption) (*FindLoginsForURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindLoginsForURLResponse)
+	err := c.cc.Invoke(ctx, VaultService_FindLoginsForURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) GetFavicon(ctx context.Context, in *GetFaviconRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, VaultService_GetFavicon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetItemRotation(ctx context.Context, in *SetItemRotationRequest, opts ...grpc.CallOption) (*SetItemRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetItemRotationResponse)
+	err := c.cc.Invoke(ctx, VaultService_SetItemRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) SetVaultRotation(ctx context.Context, in *SetVaultRotationRequest, opts ...grpc.CallOption) (*SetVaultRotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVaultRotationResponse)
+	err := c.cc.Invoke(ctx, VaultService_SetVaultRotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) ListVaults(ctx context.Context, in *ListVaultsRequest, opts ...grpc.CallOption) (*ListVaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVaultsResponse)
+	err := c.cc.Invoke(ctx, VaultService_ListVaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) CreateVault(ctx context.Context, in *CreateVaultRequest, opts ...grpc.CallOption) (*CreateVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVaultResponse)
+	err := c.cc.Invoke(ctx, VaultService_CreateVault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) RenameVault(ctx context.Context, in *RenameVaultRequest, opts ...grpc.CallOption) (*RenameVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameVaultResponse)
+	err := c.cc.Invoke(ctx, VaultService_RenameVault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) DeleteVault(ctx context.Context, in *DeleteVaultRequest, opts ...grpc.CallOption) (*DeleteVaultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVaultResponse)
+	err := c.cc.Invoke(ctx, VaultService_DeleteVault_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vaultServiceClient) WatchVaultChanges(ctx context.Context, in *WatchVaultChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[VaultChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &VaultService_ServiceDesc.Streams[1], VaultService_WatchVaultChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchVaultChangesRequest, VaultChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_WatchVaultChangesClient = grpc.ServerStreamingClient[VaultChangeEvent]
+
 // VaultServiceServer is the server API for VaultService service.
 // All implementations must embed UnimplementedVaultServiceServer
 // for forward compatibility.
@@ -81,8 +452,52 @@ func (c *vaultServiceClient) DeleteLoginPassword(ctx context.Context, in *Delete
 // VaultService service definition
 type VaultServiceServer interface {
 	GetLoginPasswords(context.Context, *GetLoginPasswordsRequest) (*GetLoginPasswordsResponse, error)
+	// GetVaultItemsStream sends items of every type one by one, for vaults too big for a single response.
+	GetVaultItemsStream(*GetVaultItemsStreamRequest, grpc.ServerStreamingServer[VaultItem]) error
+	// ListItemSummaries lists items of every type without their secrets; fetch one with GetVaultItem when it is opened.
+	ListItemSummaries(context.Context, *ListItemSummariesRequest) (*ListItemSummariesResponse, error)
+	GetVaultItem(context.Context, *GetVaultItemRequest) (*VaultItem, error)
+	// Retries with the same idempotency-key metadata within the server's window return the original response.
 	SaveLoginPassword(context.Context, *SaveLoginPasswordRequest) (*SaveLoginPasswordResponse, error)
+	// TouchItem records that the item was viewed or its secret copied. Items shared read-only can't be touched.
+	TouchItem(context.Context, *TouchItemRequest) (*TouchItemResponse, error)
+	// PinItem pins or unpins an item, pinned items are listed first.
+	PinItem(context.Context, *PinItemRequest) (*PinItemResponse, error)
+	// ArchiveItem archives or restores an item. Archived items are hidden from ListItemSummaries
+	// and FindLoginsForURL unless include_archived is set, but are still synced, unlike deleted ones.
+	ArchiveItem(context.Context, *ArchiveItemRequest) (*ArchiveItemResponse, error)
+	// ReorderItems places the listed items in this order, ahead of items never ordered.
+	// Items not listed, like those of other vaults, keep their place.
+	ReorderItems(context.Context, *ReorderItemsRequest) (*ReorderItemsResponse, error)
 	DeleteLoginPassword(context.Context, *DeleteLoginPasswordRequest) (*DeleteLoginPasswordResponse, error)
+	GetWifiCredentials(context.Context, *GetWifiCredentialsRequest) (*GetWifiCredentialsResponse, error)
+	SaveWifiCredential(context.Context, *SaveWifiCredentialRequest) (*SaveWifiCredentialResponse, error)
+	DeleteWifiCredential(context.Context, *DeleteWifiCredentialRequest) (*DeleteWifiCredentialResponse, error)
+	GetSeedPhrases(context.Context, *GetSeedPhrasesRequest) (*GetSeedPhrasesResponse, error)
+	SaveSeedPhrase(context.Context, *SaveSeedPhraseRequest) (*SaveSeedPhraseResponse, error)
+	DeleteSeedPhrase(context.Context, *DeleteSeedPhraseRequest) (*DeleteSeedPhraseResponse, error)
+	// GetVaultStats reports how the vault grew and what it stores.
+	GetVaultStats(context.Context, *GetVaultStatsRequest) (*GetVaultStatsResponse, error)
+	GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error)
+	ShareItem(context.Context, *ShareItemRequest) (*ShareItemResponse, error)
+	ListMyShares(context.Context, *ListMySharesRequest) (*ListMySharesResponse, error)
+	RevokeShare(context.Context, *RevokeShareRequest) (*RevokeShareResponse, error)
+	GetVaultHealth(context.Context, *GetVaultHealthRequest) (*GetVaultHealthResponse, error)
+	// FindLoginsForURL returns login passwords with a URL matching the page URL, for autofill.
+	FindLoginsForURL(context.Context, *FindLoginsForURLRequest) (*FindLoginsForURLResponse, error)
+	// GetFavicon returns the icon of a login URL host as an image, so web clients can use it directly.
+	GetFavicon(context.Context, *GetFaviconRequest) (*httpbody.HttpBody, error)
+	// SetItemRotation sets how often the password of a login item should be changed, overriding its vault's policy.
+	SetItemRotation(context.Context, *SetItemRotationRequest) (*SetItemRotationResponse, error)
+	// SetVaultRotation sets how often the passwords of the login items in a vault should be changed.
+	SetVaultRotation(context.Context, *SetVaultRotationRequest) (*SetVaultRotationResponse, error)
+	// ListVaults lists the caller's vaults, oldest first. Users get the default vault on registration.
+	ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error)
+	CreateVault(context.Context, *CreateVaultRequest) (*CreateVaultResponse, error)
+	RenameVault(context.Context, *RenameVaultRequest) (*RenameVaultResponse, error)
+	// DeleteVault deletes an empty vault.
+	DeleteVault(context.Context, *DeleteVaultRequest) (*DeleteVaultResponse, error)
+	WatchVaultChanges(*WatchVaultChangesRequest, grpc.ServerStreamingServer[VaultChangeEvent]) error
 	mustEmbedUnimplementedVaultServiceServer()
 }
 
@@ -96,12 +511,96 @@ type UnimplementedVaultServiceServer struct{}
 func (UnimplementedVaultServiceServer) GetLoginPasswords(context.Context, *GetLoginPasswordsRequest) (*GetLoginPasswordsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoginPasswords not implemented")
 }
+func (UnimplementedVaultServiceServer) GetVaultItemsStream(*GetVaultItemsStreamRequest, grpc.ServerStreamingServer[VaultItem]) error {
+	return status.Errorf(codes.Unimplemented, "method GetVaultItemsStream not implemented")
+}
+func (UnimplementedVaultServiceServer) ListItemSummaries(context.Context, *ListItemSummariesRequest) (*ListItemSummariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItemSummaries not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultItem(context.Context, *GetVaultItemRequest) (*VaultItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultItem not implemented")
+}
 func (UnimplementedVaultServiceServer) SaveLoginPassword(context.Context, *SaveLoginPasswordRequest) (*SaveLoginPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SaveLoginPassword not implemented")
 }
+func (UnimplementedVaultServiceServer) TouchItem(context.Context, *TouchItemRequest) (*TouchItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchItem not implemented")
+}
+func (UnimplementedVaultServiceServer) PinItem(context.Context, *PinItemRequest) (*PinItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinItem not implemented")
+}
+func (UnimplementedVaultServiceServer) ArchiveItem(context.Context, *ArchiveItemRequest) (*ArchiveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveItem not implemented")
+}
+func (UnimplementedVaultServiceServer) ReorderItems(context.Context, *ReorderItemsRequest) (*ReorderItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderItems not implemented")
+}
 func (UnimplementedVaultServiceServer) DeleteLoginPassword(context.Context, *DeleteLoginPasswordRequest) (*DeleteLoginPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteLoginPassword not implemented")
 }
+func (UnimplementedVaultServiceServer) GetWifiCredentials(context.Context, *GetWifiCredentialsRequest) (*GetWifiCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWifiCredentials not implemented")
+}
+func (UnimplementedVaultServiceServer) SaveWifiCredential(context.Context, *SaveWifiCredentialRequest) (*SaveWifiCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveWifiCredential not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteWifiCredential(context.Context, *DeleteWifiCredentialRequest) (*DeleteWifiCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWifiCredential not implemented")
+}
+func (UnimplementedVaultServiceServer) GetSeedPhrases(context.Context, *GetSeedPhrasesRequest) (*GetSeedPhrasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSeedPhrases not implemented")
+}
+func (UnimplementedVaultServiceServer) SaveSeedPhrase(context.Context, *SaveSeedPhraseRequest) (*SaveSeedPhraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSeedPhrase not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteSeedPhrase(context.Context, *DeleteSeedPhraseRequest) (*DeleteSeedPhraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSeedPhrase not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultStats(context.Context, *GetVaultStatsRequest) (*GetVaultStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultStats not implemented")
+}
+func (UnimplementedVaultServiceServer) GetChangesSince(context.Context, *GetChangesSinceRequest) (*GetChangesSinceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangesSince not implemented")
+}
+func (UnimplementedVaultServiceServer) ShareItem(context.Context, *ShareItemRequest) (*ShareItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareItem not implemented")
+}
+func (UnimplementedVaultServiceServer) ListMyShares(context.Context, *ListMySharesRequest) (*ListMySharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMyShares not implemented")
+}
+func (UnimplementedVaultServiceServer) RevokeShare(context.Context, *RevokeShareRequest) (*RevokeShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShare not implemented")
+}
+func (UnimplementedVaultServiceServer) GetVaultHealth(context.Context, *GetVaultHealthRequest) (*GetVaultHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultHealth not implemented")
+}
+func (UnimplementedVaultServiceServer) FindLoginsForURL(context.Context, *FindLoginsForURLRequest) (*FindLoginsForURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindLoginsForURL not implemented")
+}
+func (UnimplementedVaultServiceServer) GetFavicon(context.Context, *GetFaviconRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFavicon not implemented")
+}
+func (UnimplementedVaultServiceServer) SetItemRotation(context.Context, *SetItemRotationRequest) (*SetItemRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetItemRotation not implemented")
+}
+func (UnimplementedVaultServiceServer) SetVaultRotation(context.Context, *SetVaultRotationRequest) (*SetVaultRotationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVaultRotation not implemented")
+}
+func (UnimplementedVaultServiceServer) ListVaults(context.Context, *ListVaultsRequest) (*ListVaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVaults not implemented")
+}
+func (UnimplementedVaultServiceServer) CreateVault(context.Context, *CreateVaultRequest) (*CreateVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVault not implemented")
+}
+func (UnimplementedVaultServiceServer) RenameVault(context.Context, *RenameVaultRequest) (*RenameVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameVault not implemented")
+}
+func (UnimplementedVaultServiceServer) DeleteVault(context.Context, *DeleteVaultRequest) (*DeleteVaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVault not implemented")
+}
+func (UnimplementedVaultServiceServer) WatchVaultChanges(*WatchVaultChangesRequest, grpc.ServerStreamingServer[VaultChangeEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchVaultChanges not implemented")
+}
 func (UnimplementedVaultServiceServer) mustEmbedUnimplementedVaultServiceServer() {}
 func (UnimplementedVaultServiceServer) testEmbeddedByValue()                      {}
 
@@ -141,62 +640,667 @@ func _VaultService_GetLoginPasswords_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VaultService_SaveLoginPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SaveLoginPasswordRequest)
+func _VaultService_GetVaultItemsStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetVaultItemsStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).GetVaultItemsStream(m, &grpc.GenericServerStream[GetVaultItemsStreamRequest, VaultItem]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_GetVaultItemsStreamServer = grpc.ServerStreamingServer[VaultItem]
+
+func _VaultService_ListItemSummaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemSummariesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VaultServiceServer).SaveLoginPassword(ctx, in)
+		return srv.(VaultServiceServer).ListItemSummaries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VaultService_SaveLoginPassword_FullMethodName,
+		FullMethod: VaultService_ListItemSummaries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VaultServiceServer).SaveLoginPassword(ctx, req.(*SaveLoginPasswordRequest))
+		return srv.(VaultServiceServer).ListItemSummaries(ctx, req.(*ListItemSummariesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _VaultService_DeleteLoginPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteLoginPasswordRequest)
+func _VaultService_GetVaultItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(VaultServiceServer).DeleteLoginPassword(ctx, in)
+		return srv.(VaultServiceServer).GetVaultItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: VaultService_DeleteLoginPassword_FullMethodName,
+		FullMethod: VaultService_GetVaultItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(VaultServiceServer).DeleteLoginPassword(ctx, req.(*DeleteLoginPasswordRequest))
+		return srv.(VaultServiceServer).GetVaultItem(ctx, req.(*GetVaultItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var VaultService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "v1.vault.VaultService",
-	HandlerType: (*VaultServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetLoginPasswords",
-			Handler:    _VaultService_GetLoginPasswords_Handler,
+func _VaultService_SaveLoginPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveLoginPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SaveLoginPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SaveLoginPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SaveLoginPassword(ctx, req.(*SaveLoginPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_TouchItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).TouchItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_TouchItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).TouchItem(ctx, req.(*TouchItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_PinItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).PinItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_PinItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).PinItem(ctx, req.(*PinItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ArchiveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ArchiveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ArchiveItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ArchiveItem(ctx, req.(*ArchiveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ReorderItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ReorderItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ReorderItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ReorderItems(ctx, req.(*ReorderItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteLoginPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLoginPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteLoginPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteLoginPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteLoginPassword(ctx, req.(*DeleteLoginPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetWifiCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWifiCredentialsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetWifiCredentials(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetWifiCredentials_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetWifiCredentials(ctx, req.(*GetWifiCredentialsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SaveWifiCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveWifiCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SaveWifiCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SaveWifiCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SaveWifiCredential(ctx, req.(*SaveWifiCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteWifiCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWifiCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteWifiCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteWifiCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteWifiCredential(ctx, req.(*DeleteWifiCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetSeedPhrases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeedPhrasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetSeedPhrases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetSeedPhrases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetSeedPhrases(ctx, req.(*GetSeedPhrasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SaveSeedPhrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSeedPhraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SaveSeedPhrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SaveSeedPhrase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SaveSeedPhrase(ctx, req.(*SaveSeedPhraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteSeedPhrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeedPhraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteSeedPhrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteSeedPhrase_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteSeedPhrase(ctx, req.(*DeleteSeedPhraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetVaultStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVaultStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetVaultStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVaultStats(ctx, req.(*GetVaultStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetChangesSince_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangesSinceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetChangesSince(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetChangesSince_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetChangesSince(ctx, req.(*GetChangesSinceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ShareItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ShareItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ShareItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ShareItem(ctx, req.(*ShareItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListMyShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMySharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListMyShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListMyShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListMyShares(ctx, req.(*ListMySharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RevokeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RevokeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RevokeShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RevokeShare(ctx, req.(*RevokeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetVaultHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetVaultHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetVaultHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetVaultHealth(ctx, req.(*GetVaultHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_FindLoginsForURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindLoginsForURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).FindLoginsForURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_FindLoginsForURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).FindLoginsForURL(ctx, req.(*FindLoginsForURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_GetFavicon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFaviconRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).GetFavicon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_GetFavicon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).GetFavicon(ctx, req.(*GetFaviconRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetItemRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItemRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetItemRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetItemRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetItemRotation(ctx, req.(*SetItemRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_SetVaultRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVaultRotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).SetVaultRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_SetVaultRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).SetVaultRotation(ctx, req.(*SetVaultRotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_ListVaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).ListVaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_ListVaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).ListVaults(ctx, req.(*ListVaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_CreateVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).CreateVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_CreateVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).CreateVault(ctx, req.(*CreateVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_RenameVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).RenameVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_RenameVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).RenameVault(ctx, req.(*RenameVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_DeleteVault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VaultServiceServer).DeleteVault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VaultService_DeleteVault_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VaultServiceServer).DeleteVault(ctx, req.(*DeleteVaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VaultService_WatchVaultChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchVaultChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VaultServiceServer).WatchVaultChanges(m, &grpc.GenericServerStream[WatchVaultChangesRequest, VaultChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type VaultService_WatchVaultChangesServer = grpc.ServerStreamingServer[VaultChangeEvent]
+
+// VaultService_ServiceDesc is the grpc.ServiceDesc for VaultService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VaultService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.vault.VaultService",
+	HandlerType: (*VaultServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetLoginPasswords",
+			Handler:    _VaultService_GetLoginPasswords_Handler,
+		},
+		{
+			MethodName: "ListItemSummaries",
+			Handler:    _VaultService_ListItemSummaries_Handler,
+		},
+		{
+			MethodName: "GetVaultItem",
+			Handler:    _VaultService_GetVaultItem_Handler,
 		},
 		{
 			MethodName: "SaveLoginPassword",
 			Handler:    _VaultService_SaveLoginPassword_Handler,
 		},
+		{
+			MethodName: "TouchItem",
+			Handler:    _VaultService_TouchItem_Handler,
+		},
+		{
+			MethodName: "PinItem",
+			Handler:    _VaultService_PinItem_Handler,
+		},
+		{
+			MethodName: "ArchiveItem",
+			Handler:    _VaultService_ArchiveItem_Handler,
+		},
+		{
+			MethodName: "ReorderItems",
+			Handler:    _VaultService_ReorderItems_Handler,
+		},
 		{
 			MethodName: "DeleteLoginPassword",
 			Handler:    _VaultService_DeleteLoginPassword_Handler,
 		},
+		{
+			MethodName: "GetWifiCredentials",
+			Handler:    _VaultService_GetWifiCredentials_Handler,
+		},
+		{
+			MethodName: "SaveWifiCredential",
+			Handler:    _VaultService_SaveWifiCredential_Handler,
+		},
+		{
+			MethodName: "DeleteWifiCredential",
+			Handler:    _VaultService_DeleteWifiCredential_Handler,
+		},
+		{
+			MethodName: "GetSeedPhrases",
+			Handler:    _VaultService_GetSeedPhrases_Handler,
+		},
+		{
+			MethodName: "SaveSeedPhrase",
+			Handler:    _VaultService_SaveSeedPhrase_Handler,
+		},
+		{
+			MethodName: "DeleteSeedPhrase",
+			Handler:    _VaultService_DeleteSeedPhrase_Handler,
+		},
+		{
+			MethodName: "GetVaultStats",
+			Handler:    _VaultService_GetVaultStats_Handler,
+		},
+		{
+			MethodName: "GetChangesSince",
+			Handler:    _VaultService_GetChangesSince_Handler,
+		},
+		{
+			MethodName: "ShareItem",
+			Handler:    _VaultService_ShareItem_Handler,
+		},
+		{
+			MethodName: "ListMyShares",
+			Handler:    _VaultService_ListMyShares_Handler,
+		},
+		{
+			MethodName: "RevokeShare",
+			Handler:    _VaultService_RevokeShare_Handler,
+		},
+		{
+			MethodName: "GetVaultHealth",
+			Handler:    _VaultService_GetVaultHealth_Handler,
+		},
+		{
+			MethodName: "FindLoginsForURL",
+			Handler:    _VaultService_FindLoginsForURL_Handler,
+		},
+		{
+			MethodName: "GetFavicon",
+			Handler:    _VaultService_GetFavicon_Handler,
+		},
+		{
+			MethodName: "SetItemRotation",
+			Handler:    _VaultService_SetItemRotation_Handler,
+		},
+		{
+			MethodName: "SetVaultRotation",
+			Handler:    _VaultService_SetVaultRotation_Handler,
+		},
+		{
+			MethodName: "ListVaults",
+			Handler:    _VaultService_ListVaults_Handler,
+		},
+		{
+			MethodName: "CreateVault",
+			Handler:    _VaultService_CreateVault_Handler,
+		},
+		{
+			MethodName: "RenameVault",
+			Handler:    _VaultService_RenameVault_Handler,
+		},
+		{
+			MethodName: "DeleteVault",
+			Handler:    _VaultService_DeleteVault_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetVaultItemsStream",
+			Handler:       _VaultService_GetVaultItemsStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchVaultChanges",
+			Handler:       _VaultService_WatchVaultChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/v1/vault/vault.proto",
 }
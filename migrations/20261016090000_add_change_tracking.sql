@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS login_password_user_id_updated_at_index ON login_password (user_id, updated_at);
+
+CREATE TABLE IF NOT EXISTS tombstone
+(
+    item_id    UUID PRIMARY KEY,
+    user_id    UUID NOT NULL REFERENCES "user" (id),
+    item_type  text NOT NULL,
+    deleted_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS tombstone_user_id_deleted_at_index ON tombstone (user_id, deleted_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS tombstone;
+DROP INDEX IF EXISTS login_password_user_id_updated_at_index;
+ALTER TABLE login_password DROP COLUMN IF EXISTS updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS revision bigint NOT NULL DEFAULT 0;
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS revision bigint NOT NULL DEFAULT 0;
+ALTER TABLE tombstone ADD COLUMN IF NOT EXISTS revision bigint NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE tombstone DROP COLUMN IF EXISTS revision;
+ALTER TABLE login_password DROP COLUMN IF EXISTS revision;
+ALTER TABLE "user" DROP COLUMN IF EXISTS revision;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS secret_link
+(
+    id         UUID PRIMARY KEY,
+    user_id    UUID NOT NULL REFERENCES "user" (id),
+    ciphertext bytea NOT NULL,
+    max_views  int NOT NULL,
+    views      int NOT NULL DEFAULT 0,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    expires_at timestamptz NOT NULL
+);
+CREATE INDEX IF NOT EXISTS secret_link_expires_at_index ON secret_link (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS secret_link;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS emergency_contact
+(
+    id           UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    owner_id     UUID NOT NULL REFERENCES "user" (id),
+    grantee_id   UUID NOT NULL REFERENCES "user" (id),
+    wait_seconds bigint NOT NULL,
+    status       text NOT NULL DEFAULT 'idle',
+    requested_at timestamptz,
+    created_at   timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS emergency_contact_owner_id_grantee_id_uindex ON emergency_contact (owner_id, grantee_id);
+CREATE INDEX IF NOT EXISTS emergency_contact_grantee_id_index ON emergency_contact (grantee_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS emergency_contact;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS share
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    item_id    UUID NOT NULL,
+    item_type  text NOT NULL,
+    owner_id   UUID NOT NULL REFERENCES "user" (id),
+    grantee_id UUID NOT NULL REFERENCES "user" (id),
+    read_only  boolean NOT NULL DEFAULT true,
+    expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS share_item_id_grantee_id_uindex ON share (item_id, grantee_id);
+CREATE INDEX IF NOT EXISTS share_owner_id_index ON share (owner_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS share;
+-- +goose StatementEnd
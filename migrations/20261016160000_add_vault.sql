@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS vault
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID NOT NULL REFERENCES "user" (id),
+    name       text NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS vault_user_id_name_uindex ON vault (user_id, name);
+
+INSERT INTO vault (user_id, name) SELECT id, 'Personal' FROM "user";
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS vault_id UUID REFERENCES vault (id);
+UPDATE login_password lp SET vault_id=v.id FROM vault v WHERE v.user_id=lp.user_id;
+ALTER TABLE login_password ALTER COLUMN vault_id SET NOT NULL;
+CREATE INDEX IF NOT EXISTS login_password_vault_id_index ON login_password (vault_id);
+-- Users now keep many login passwords, spread over their vaults.
+DROP INDEX IF EXISTS login_password_user_id_uindex;
+CREATE INDEX IF NOT EXISTS login_password_user_id_index ON login_password (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS login_password_user_id_index;
+CREATE UNIQUE INDEX IF NOT EXISTS login_password_user_id_uindex ON login_password (user_id);
+ALTER TABLE login_password DROP COLUMN IF EXISTS vault_id;
+DROP TABLE IF EXISTS vault;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS login_url
+(
+    item_id  UUID NOT NULL REFERENCES login_password (id) ON DELETE CASCADE,
+    user_id  UUID NOT NULL REFERENCES "user" (id),
+    position int NOT NULL,
+    url      text NOT NULL,
+    match    text NOT NULL,
+    host     text NOT NULL,
+    PRIMARY KEY (item_id, position)
+);
+CREATE INDEX IF NOT EXISTS login_url_user_id_host_index ON login_url (user_id, host);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS login_url;
+-- +goose StatementEnd
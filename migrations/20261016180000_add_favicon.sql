@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS favicon
+(
+    host         text PRIMARY KEY,
+    content_type text NOT NULL,
+    data         bytea NOT NULL,
+    fetched_at   timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS favicon;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS last_used_at timestamptz;
+CREATE INDEX IF NOT EXISTS login_password_user_id_last_used_at_index ON login_password (user_id, last_used_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS login_password_user_id_last_used_at_index;
+ALTER TABLE login_password DROP COLUMN IF EXISTS last_used_at;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS recovery_code_hash bytea;
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS recovery_key bytea;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "user" DROP COLUMN IF EXISTS recovery_key;
+ALTER TABLE "user" DROP COLUMN IF EXISTS recovery_code_hash;
+-- +goose StatementEnd
@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS wifi_credential
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID NOT NULL REFERENCES "user" (id),
+    vault_id   UUID NOT NULL REFERENCES vault (id),
+    ssid       text NOT NULL,
+    security   text NOT NULL,
+    password   bytea NOT NULL,
+    hidden     boolean NOT NULL DEFAULT false,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    revision   bigint NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS wifi_credential_user_id_updated_at_index ON wifi_credential (user_id, updated_at);
+CREATE INDEX IF NOT EXISTS wifi_credential_vault_id_index ON wifi_credential (vault_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS wifi_credential;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS seed_phrase
+(
+    id         UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id    UUID NOT NULL REFERENCES "user" (id),
+    vault_id   UUID NOT NULL REFERENCES vault (id),
+    name       text NOT NULL,
+    words      bytea NOT NULL,
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    revision   bigint NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS seed_phrase_user_id_updated_at_index ON seed_phrase (user_id, updated_at);
+CREATE INDEX IF NOT EXISTS seed_phrase_vault_id_index ON seed_phrase (vault_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS seed_phrase;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now();
+UPDATE login_password SET created_at=updated_at;
+ALTER TABLE wifi_credential ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now();
+UPDATE wifi_credential SET created_at=updated_at;
+ALTER TABLE seed_phrase ADD COLUMN IF NOT EXISTS created_at timestamptz NOT NULL DEFAULT now();
+UPDATE seed_phrase SET created_at=updated_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE seed_phrase DROP COLUMN IF EXISTS created_at;
+ALTER TABLE wifi_credential DROP COLUMN IF EXISTS created_at;
+ALTER TABLE login_password DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS rotation_days integer NOT NULL DEFAULT 0 CHECK (rotation_days >= 0);
+ALTER TABLE vault ADD COLUMN IF NOT EXISTS rotation_days integer NOT NULL DEFAULT 0 CHECK (rotation_days >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vault DROP COLUMN IF EXISTS rotation_days;
+ALTER TABLE login_password DROP COLUMN IF EXISTS rotation_days;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS sort_index integer NOT NULL DEFAULT 0;
+ALTER TABLE wifi_credential ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+ALTER TABLE wifi_credential ADD COLUMN IF NOT EXISTS sort_index integer NOT NULL DEFAULT 0;
+ALTER TABLE seed_phrase ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+ALTER TABLE seed_phrase ADD COLUMN IF NOT EXISTS sort_index integer NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE seed_phrase DROP COLUMN IF EXISTS sort_index;
+ALTER TABLE seed_phrase DROP COLUMN IF EXISTS pinned;
+ALTER TABLE wifi_credential DROP COLUMN IF EXISTS sort_index;
+ALTER TABLE wifi_credential DROP COLUMN IF EXISTS pinned;
+ALTER TABLE login_password DROP COLUMN IF EXISTS sort_index;
+ALTER TABLE login_password DROP COLUMN IF EXISTS pinned;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE login_password ADD COLUMN IF NOT EXISTS archived boolean NOT NULL DEFAULT false;
+ALTER TABLE wifi_credential ADD COLUMN IF NOT EXISTS archived boolean NOT NULL DEFAULT false;
+ALTER TABLE seed_phrase ADD COLUMN IF NOT EXISTS archived boolean NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE seed_phrase DROP COLUMN IF EXISTS archived;
+ALTER TABLE wifi_credential DROP COLUMN IF EXISTS archived;
+ALTER TABLE login_password DROP COLUMN IF EXISTS archived;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS tenant
+(
+    id                  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name                text NOT NULL,
+    storage_quota_bytes bigint NOT NULL DEFAULT 0,
+    created_at          timestamptz NOT NULL DEFAULT now()
+);
+CREATE UNIQUE INDEX IF NOT EXISTS tenant_name_uindex ON tenant (name);
+-- Item tables have no tenant_id: items belong to a tenant only through their owner.
+ALTER TABLE "user" ADD COLUMN IF NOT EXISTS tenant_id UUID REFERENCES tenant (id);
+CREATE INDEX IF NOT EXISTS user_tenant_id_index ON "user" (tenant_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "user" DROP COLUMN IF EXISTS tenant_id;
+DROP TABLE IF EXISTS tenant;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE wifi_credential ADD COLUMN IF NOT EXISTS last_used_at timestamptz;
+ALTER TABLE seed_phrase ADD COLUMN IF NOT EXISTS last_used_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE seed_phrase DROP COLUMN IF EXISTS last_used_at;
+ALTER TABLE wifi_credential DROP COLUMN IF EXISTS last_used_at;
+-- +goose StatementEnd
@@ -0,0 +1,83 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/admin"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/listener"
+)
+
+var errNoClientCA = errors.New("ADMIN_ADDR requires ADMIN_TLS_CERT, ADMIN_TLS_KEY and ADMIN_CLIENT_CA")
+
+// serveAdmin serves AdminService on the admin socket, only accessible to the server's user,
+// and on the admin address to clients with a certificate signed by the admin client CA.
+func (s *Server) serveAdmin() error {
+	if s.cfg.AdminSocket != "" {
+		lis, err := listener.PrivateUnix(s.cfg.AdminSocket)
+		if err != nil {
+			return err
+		}
+		s.serveAdminOn(grpc.NewServer(adminInterceptors(s.log)...), lis)
+	}
+	if s.cfg.AdminAddr != "" {
+		creds, err := adminCredentials(s.cfg)
+		if err != nil {
+			return err
+		}
+		lis, err := net.Listen("tcp", s.cfg.AdminAddr)
+		if err != nil {
+			return err
+		}
+		s.serveAdminOn(grpc.NewServer(append(adminInterceptors(s.log), grpc.Creds(creds))...), lis)
+	}
+	return nil
+}
+
+func adminInterceptors(log *slog.Logger) []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(interceptor.StatusErrorsUnary(log)),
+		grpc.ChainStreamInterceptor(interceptor.StatusErrorsStream(log)),
+	}
+}
+
+func (s *Server) serveAdminOn(srv *grpc.Server, lis net.Listener) {
+	admin.RegisterAdminServiceServer(srv, s.adminAPI)
+	s.adminServers = append(s.adminServers, srv)
+	s.serve("admin gRPC", lis, srv.Serve)
+}
+
+// adminCredentials requires clients of the admin address to present a certificate signed by the admin client CA.
+func adminCredentials(cfg *config.Config) (credentials.TransportCredentials, error) {
+	if cfg.AdminTLSCert == "" || cfg.AdminTLSKey == "" || cfg.AdminClientCA == "" {
+		return nil, errNoClientCA
+	}
+	cert, err := tls.LoadX509KeyPair(cfg.AdminTLSCert, cfg.AdminTLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load admin certificate: %w", err)
+	}
+	caPEM, err := os.ReadFile(cfg.AdminClientCA)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read admin client CA: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates in %s", cfg.AdminClientCA)
+	}
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientCAs:    pool,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}), nil
+}
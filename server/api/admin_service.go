@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/admin"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/backup"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// AdminServer implements AdminService.
+type AdminServer struct {
+	admin.UnimplementedAdminServiceServer
+
+	Service *service.AdminService
+	// Backups is nil if backups are not configured.
+	Backups     *service.BackupService
+	LogLevel    *slog.LevelVar
+	Maintenance *interceptor.Maintenance
+}
+
+var errBackupsDisabled = apierror.New(
+	codes.FailedPrecondition,
+	apierror.ReasonBackupsDisabled,
+	"backups are not configured",
+)
+
+func (s *AdminServer) ListUsers(ctx context.Context, in *admin.ListUsersRequest) (*admin.ListUsersResponse, error) {
+	tenantID, err := optionalTenantID(in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	users, err := s.Service.ListUsers(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.ListUsersResponse{}
+	for _, u := range users {
+		pu := &admin.ListUsersResponse_User{
+			Id:           u.ID.String(),
+			Login:        u.Login,
+			Items:        u.Items,
+			StorageBytes: u.StorageBytes,
+		}
+		if u.TenantID != nil {
+			pu.TenantId = u.TenantID.String()
+		}
+		if u.DisabledAt != nil {
+			pu.DisabledAt = timestamppb.New(*u.DisabledAt)
+		}
+		resp.Users = append(resp.Users, pu)
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) SetUserDisabled(
+	ctx context.Context,
+	in *admin.SetUserDisabledRequest,
+) (*admin.SetUserDisabledResponse, error) {
+	err := s.Service.SetUserDisabled(ctx, in.GetLogin(), in.GetDisabled())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("user does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &admin.SetUserDisabledResponse{}, nil
+}
+
+func (s *AdminServer) SetUserAllowedNetworks(
+	ctx context.Context,
+	in *admin.SetUserAllowedNetworksRequest,
+) (*admin.SetUserAllowedNetworksResponse, error) {
+	networks, err := s.Service.SetUserAllowedNetworks(ctx, in.GetLogin(), in.GetNetworks())
+	switch {
+	case errors.Is(err, auth.ErrBadNetwork):
+		return nil, apierror.InvalidField("networks", err.Error())
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("user does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &admin.SetUserAllowedNetworksResponse{Networks: networkStrings(networks)}, nil
+}
+
+func (s *AdminServer) GetStorageUsage(
+	ctx context.Context,
+	_ *admin.GetStorageUsageRequest,
+) (*admin.GetStorageUsageResponse, error) {
+	usage, err := s.Service.GetStorageUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.GetStorageUsageResponse{DatabaseBytes: usage.DatabaseBytes}
+	for _, t := range usage.Tables {
+		resp.Tables = append(resp.Tables, &admin.GetStorageUsageResponse_Table{Name: t.Name, Bytes: t.Bytes})
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) ListBackups(
+	ctx context.Context,
+	_ *admin.ListBackupsRequest,
+) (*admin.ListBackupsResponse, error) {
+	if s.Backups == nil {
+		return nil, errBackupsDisabled
+	}
+	names, err := s.Backups.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &admin.ListBackupsResponse{Names: names}, nil
+}
+
+func (s *AdminServer) RestoreBackup(
+	ctx context.Context,
+	in *admin.RestoreBackupRequest,
+) (*admin.RestoreBackupResponse, error) {
+	if s.Backups == nil {
+		return nil, errBackupsDisabled
+	}
+	var userID *uuid.UUID
+	if in.GetUserId() != "" {
+		id, err := uuid.Parse(in.GetUserId())
+		if err != nil {
+			return nil, apierror.InvalidField("user_id", "malformed user id")
+		}
+		userID = &id
+	}
+
+	b, restored, err := s.Backups.Restore(ctx, in.GetName(), userID, in.GetDryRun())
+	switch {
+	case errors.Is(err, service.ErrBackupNotFound):
+		return nil, apierror.NotFound("backup does not exist")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("user is not in the backup")
+	case errors.Is(err, backup.ErrBadArchive), errors.Is(err, backup.ErrWrongPassphrase):
+		return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonBackupUnreadable, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	resp := &admin.RestoreBackupResponse{
+		Name:          b.Name,
+		SchemaVersion: b.SchemaVersion,
+		CreatedAt:     timestamppb.New(b.CreatedAt),
+	}
+	for _, t := range restored {
+		resp.Tables = append(resp.Tables, &admin.RestoreBackupResponse_Table{Name: t.Name, Rows: t.Rows})
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) SetLogLevel(
+	_ context.Context,
+	in *admin.SetLogLevelRequest,
+) (*admin.SetLogLevelResponse, error) {
+	var level slog.Level
+	err := level.UnmarshalText([]byte(in.GetLevel()))
+	if err != nil {
+		return nil, apierror.InvalidField("level", "unknown log level")
+	}
+	previous := s.LogLevel.Level()
+	s.LogLevel.Set(level)
+	return &admin.SetLogLevelResponse{PreviousLevel: previous.String()}, nil
+}
+
+func (s *AdminServer) SetMaintenance(
+	_ context.Context,
+	in *admin.SetMaintenanceRequest,
+) (*admin.SetMaintenanceResponse, error) {
+	s.Maintenance.Set(in.GetEnabled(), in.GetMessage())
+	return &admin.SetMaintenanceResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *UserServer) GetAllowedNetworks(
+	ctx context.Context,
+	_ *user.GetAllowedNetworksRequest,
+) (*user.GetAllowedNetworksResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	networks, err := s.Allowlist.Networks(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.GetAllowedNetworksResponse{Networks: networkStrings(networks)}, nil
+}
+
+func (s *UserServer) SetAllowedNetworks(
+	ctx context.Context,
+	in *user.SetAllowedNetworksRequest,
+) (*user.SetAllowedNetworksResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	networks, err := s.Allowlist.SetNetworks(ctx, userID, in.GetNetworks(), interceptor.ClientHost(ctx))
+	switch {
+	case errors.Is(err, auth.ErrBadNetwork):
+		return nil, apierror.InvalidField("networks", err.Error())
+	case errors.Is(err, service.ErrAllowlistLockout):
+		return nil, apierror.New(codes.FailedPrecondition, apierror.ReasonAddressNotAllowed,
+			"the networks do not include the caller's address")
+	case err != nil:
+		return nil, err
+	}
+	return &user.SetAllowedNetworksResponse{Networks: networkStrings(networks)}, nil
+}
+
+func networkStrings(networks []netip.Prefix) []string {
+	s := make([]string, 0, len(networks))
+	for _, n := range networks {
+		s = append(s, n.String())
+	}
+	return s
+}
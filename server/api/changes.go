@@ -0,0 +1,140 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// GetChangesSince returns the items changed and deleted since the previous sync, the whole vault on the first one.
+func (s *VaultServer) GetChangesSince(
+	ctx context.Context,
+	in *vault.GetChangesSinceRequest,
+) (*vault.GetChangesSinceResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	var since time.Time
+	if in.GetSince() != nil {
+		since = in.GetSince().AsTime()
+	}
+	changes, err := s.Service.GetChangesSince(ctx, userID, since, in.GetSinceRevision())
+	if err != nil {
+		return nil, err
+	}
+	deviceID, _ := auth.DeviceID(ctx)
+	err = s.Service.RecordSync(ctx, userID, deviceID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetChangesSinceResponse{
+		LoginPasswords:  make([]*vault.GetChangesSinceResponse_LoginPassword, 0, len(changes.LoginPasswords)),
+		Deleted:         make([]*vault.GetChangesSinceResponse_Tombstone, 0, len(changes.Deleted)),
+		SyncedAt:        timestamppb.New(changes.SyncedAt),
+		Revision:        changes.Revision,
+		WifiCredentials: make([]*vault.WifiCredential, 0, len(changes.WifiCredentials)),
+		SeedPhrases:     make([]*vault.SeedPhrase, 0, len(changes.SeedPhrases)),
+	}
+	for _, lp := range changes.LoginPasswords {
+		out.LoginPasswords = append(out.LoginPasswords, &vault.GetChangesSinceResponse_LoginPassword{
+			Id:           lp.ID.String(),
+			Login:        lp.Login,
+			Password:     lp.Password,
+			UpdatedAt:    timestamppb.New(lp.UpdatedAt),
+			Revision:     lp.Revision,
+			VaultId:      lp.VaultID.String(),
+			Urls:         loginURLsToProto(lp.URLs),
+			LastUsedAt:   timestampOrNil(lp.LastUsedAt),
+			RotationDays: int32(lp.RotationDays), //nolint:gosec // Rotation is at most 3650 days.
+		})
+	}
+	for _, t := range changes.Deleted {
+		out.Deleted = append(out.Deleted, &vault.GetChangesSinceResponse_Tombstone{
+			ItemId:    t.ItemID.String(),
+			ItemType:  itemTypeToProto(t.ItemType),
+			DeletedAt: timestamppb.New(t.DeletedAt),
+			Revision:  t.Revision,
+		})
+	}
+	for _, w := range changes.WifiCredentials {
+		out.WifiCredentials = append(out.WifiCredentials, wifiCredentialToProto(w))
+	}
+	for _, sp := range changes.SeedPhrases {
+		out.SeedPhrases = append(out.SeedPhrases, seedPhraseToProto(sp))
+	}
+	return out, nil
+}
+
+func wifiCredentialToProto(w models.WifiCredential) *vault.WifiCredential {
+	return &vault.WifiCredential{
+		Id:         w.ID.String(),
+		VaultId:    w.VaultID.String(),
+		Ssid:       w.SSID,
+		Security:   wifiSecurityToProto(w.Security),
+		Password:   w.Password,
+		Hidden:     w.Hidden,
+		UpdatedAt:  timestamppb.New(w.UpdatedAt),
+		Revision:   w.Revision,
+		LastUsedAt: timestampOrNil(w.LastUsedAt),
+	}
+}
+
+func wifiSecurityToProto(sec models.WifiSecurity) vault.WifiSecurity {
+	switch sec {
+	case models.WifiSecurityNone:
+		return vault.WifiSecurity_WIFI_SECURITY_NONE
+	case models.WifiSecurityWEP:
+		return vault.WifiSecurity_WIFI_SECURITY_WEP
+	case models.WifiSecurityWPA:
+		return vault.WifiSecurity_WIFI_SECURITY_WPA
+	case models.WifiSecurityWPA3:
+		return vault.WifiSecurity_WIFI_SECURITY_WPA3
+	}
+	return vault.WifiSecurity_WIFI_SECURITY_UNSPECIFIED
+}
+
+func seedPhraseToProto(sp models.SeedPhrase) *vault.SeedPhrase {
+	return &vault.SeedPhrase{
+		Id:         sp.ID.String(),
+		VaultId:    sp.VaultID.String(),
+		Name:       sp.Name,
+		Words:      sp.Words,
+		UpdatedAt:  timestamppb.New(sp.UpdatedAt),
+		Revision:   sp.Revision,
+		LastUsedAt: timestampOrNil(sp.LastUsedAt),
+	}
+}
+
+func loginURLsToProto(urls []models.LoginURL) []*vault.LoginURL {
+	out := make([]*vault.LoginURL, 0, len(urls))
+	for _, u := range urls {
+		out = append(out, &vault.LoginURL{Url: u.URL, Match: urlMatchToProto(u.Match)})
+	}
+	return out
+}
+
+func urlMatchToProto(m models.URLMatch) vault.URLMatch {
+	switch m {
+	case models.URLMatchDomain:
+		return vault.URLMatch_URL_MATCH_DOMAIN
+	case models.URLMatchExact:
+		return vault.URLMatch_URL_MATCH_EXACT
+	case models.URLMatchPrefix:
+		return vault.URLMatch_URL_MATCH_PREFIX
+	}
+	return vault.URLMatch_URL_MATCH_UNSPECIFIED
+}
+
+// timestampOrNil leaves unset times unset.
+func timestampOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
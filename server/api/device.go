@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+)
+
+// ListDevices returns the caller's devices, revoked ones included.
+func (s *UserServer) ListDevices(ctx context.Context, _ *user.ListDevicesRequest) (*user.ListDevicesResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	devices, err := s.Devices.ListDevices(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &user.ListDevicesResponse{Devices: make([]*user.ListDevicesResponse_Device, 0, len(devices))}
+	for _, d := range devices {
+		out.Devices = append(out.Devices, &user.ListDevicesResponse_Device{
+			Id:         d.ID.String(),
+			Name:       d.Name,
+			CreatedAt:  timestamppb.New(d.CreatedAt),
+			LastSyncAt: timestampOrNil(d.LastSyncAt),
+			Revoked:    d.RevokedAt != nil,
+		})
+	}
+	return out, nil
+}
+
+func (s *UserServer) RenameDevice(
+	ctx context.Context,
+	in *user.RenameDeviceRequest,
+) (*user.RenameDeviceResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed device id")
+	}
+	err = s.Devices.RenameDevice(ctx, userID, id, in.GetName())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("device does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.RenameDeviceResponse{}, nil
+}
+
+// RevokeDevice revokes one of the caller's devices, revoking a revoked device again does nothing.
+func (s *UserServer) RevokeDevice(
+	ctx context.Context,
+	in *user.RevokeDeviceRequest,
+) (*user.RevokeDeviceResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed device id")
+	}
+	err = s.Devices.RevokeDevice(ctx, userID, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("device does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.RevokeDeviceResponse{}, nil
+}
+
+// GetAccountUsage returns the caller's item, vault and device counts and storage use.
+func (s *UserServer) GetAccountUsage(
+	ctx context.Context,
+	_ *user.GetAccountUsageRequest,
+) (*user.GetAccountUsageResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	usage, err := s.Devices.GetAccountUsage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items := make(map[string]int64, len(usage.Items))
+	for t, n := range usage.Items {
+		items[string(t)] = n
+	}
+	return &user.GetAccountUsageResponse{
+		Items:        items,
+		Vaults:       usage.Vaults,
+		Devices:      usage.Devices,
+		StorageBytes: usage.StorageBytes,
+		LastSyncAt:   timestampOrNil(usage.LastSyncAt),
+	}, nil
+}
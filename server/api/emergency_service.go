@@ -0,0 +1,201 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// EmergencyServer implements EmergencyAccessService.
+type EmergencyServer struct {
+	emergency.UnimplementedEmergencyAccessServiceServer
+
+	Service *service.EmergencyService
+}
+
+// AddTrustedContact designates a user of the caller's tenant as a trusted contact.
+func (s *EmergencyServer) AddTrustedContact(
+	ctx context.Context,
+	in *emergency.AddTrustedContactRequest,
+) (*emergency.AddTrustedContactResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	wait := time.Duration(in.GetWaitSeconds()) * time.Second
+	id, err := s.Service.AddContact(ctx, userID, in.GetGranteeLogin(), wait)
+	switch {
+	case errors.Is(err, service.ErrBadTrustedContact):
+		return nil, apierror.InvalidField("grantee_login",
+			"a trusted contact must be another user with a wait of at least 0 seconds")
+	case errors.Is(err, service.ErrTrustedContactExists):
+		return nil, apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, "user is already a trusted contact")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("user does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &emergency.AddTrustedContactResponse{Id: id.String()}, nil
+}
+
+func (s *EmergencyServer) RemoveTrustedContact(
+	ctx context.Context,
+	in *emergency.RemoveTrustedContactRequest,
+) (*emergency.RemoveTrustedContactResponse, error) {
+	userID, id, err := contactCall(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = contactError(s.Service.RemoveContact(ctx, userID, id))
+	if err != nil {
+		return nil, err
+	}
+	return &emergency.RemoveTrustedContactResponse{}, nil
+}
+
+func (s *EmergencyServer) ListTrustedContacts(
+	ctx context.Context,
+	_ *emergency.ListTrustedContactsRequest,
+) (*emergency.ListTrustedContactsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	contacts, err := s.Service.ListContacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &emergency.ListTrustedContactsResponse{}
+	for _, c := range contacts {
+		pc := &emergency.TrustedContact{
+			Id:           c.ID.String(),
+			OwnerLogin:   c.OwnerLogin,
+			GranteeLogin: c.GranteeLogin,
+			WaitSeconds:  int64(c.Wait / time.Second),
+			Status:       contactStatusToProto(c.Status),
+			RequestedAt:  timestampOrNil(c.RequestedAt),
+		}
+		if c.OwnerID == userID {
+			out.Trusted = append(out.Trusted, pc)
+		} else {
+			out.TrustedBy = append(out.TrustedBy, pc)
+		}
+	}
+	return out, nil
+}
+
+// RequestAccess starts the waiting period of a contact that designated the caller.
+func (s *EmergencyServer) RequestAccess(
+	ctx context.Context,
+	in *emergency.RequestAccessRequest,
+) (*emergency.RequestAccessResponse, error) {
+	userID, id, err := contactCall(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	grantedAt, err := s.Service.RequestAccess(ctx, userID, id)
+	if err != nil {
+		return nil, contactError(err)
+	}
+	return &emergency.RequestAccessResponse{GrantedAt: timestamppb.New(grantedAt)}, nil
+}
+
+func (s *EmergencyServer) ApproveAccess(
+	ctx context.Context,
+	in *emergency.ApproveAccessRequest,
+) (*emergency.ApproveAccessResponse, error) {
+	userID, id, err := contactCall(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = contactError(s.Service.ApproveAccess(ctx, userID, id))
+	if err != nil {
+		return nil, err
+	}
+	return &emergency.ApproveAccessResponse{}, nil
+}
+
+// RejectAccess rejects a pending request or takes back granted access.
+func (s *EmergencyServer) RejectAccess(
+	ctx context.Context,
+	in *emergency.RejectAccessRequest,
+) (*emergency.RejectAccessResponse, error) {
+	userID, id, err := contactCall(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = contactError(s.Service.RejectAccess(ctx, userID, id))
+	if err != nil {
+		return nil, err
+	}
+	return &emergency.RejectAccessResponse{}, nil
+}
+
+// GetOwnerItems returns the items of the owner who designated the caller, once access was granted.
+func (s *EmergencyServer) GetOwnerItems(
+	ctx context.Context,
+	in *emergency.GetOwnerItemsRequest,
+) (*emergency.GetOwnerItemsResponse, error) {
+	userID, id, err := contactCall(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.Service.OwnerItems(ctx, userID, id)
+	if errors.Is(err, service.ErrNoEmergencyAccess) {
+		return nil, apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied,
+			"emergency access was not granted")
+	}
+	if err != nil {
+		return nil, contactError(err)
+	}
+	out := &emergency.GetOwnerItemsResponse{Items: make([]*vault.VaultItem, 0, len(items))}
+	for _, it := range items {
+		out.Items = append(out.Items, vaultItemToProto(it))
+	}
+	return out, nil
+}
+
+// contactCall returns the caller and the trusted contact id of a call about one contact.
+func contactCall(ctx context.Context, rawID string) (uuid.UUID, uuid.UUID, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return uuid.Nil, uuid.Nil, errNoUser
+	}
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, apierror.InvalidField("id", "malformed trusted contact id")
+	}
+	return userID, id, nil
+}
+
+// contactError tells that a contact was not found, or not in the state the call needs.
+func contactError(err error) error {
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apierror.NotFound("trusted contact does not exist or does not allow this now")
+	}
+	return err
+}
+
+func contactStatusToProto(st models.EmergencyStatus) emergency.Status {
+	switch st {
+	case models.EmergencyStatusIdle:
+		return emergency.Status_STATUS_IDLE
+	case models.EmergencyStatusRequested:
+		return emergency.Status_STATUS_REQUESTED
+	case models.EmergencyStatusGranted:
+		return emergency.Status_STATUS_GRANTED
+	}
+	return emergency.Status_STATUS_UNSPECIFIED
+}
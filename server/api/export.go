@@ -0,0 +1,31 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+)
+
+func (s *UserServer) ExportPersonalData(
+	ctx context.Context,
+	_ *user.ExportPersonalDataRequest,
+) (*user.ExportPersonalDataResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	err := s.ExportLimiter.Check(userID.String())
+	if err != nil {
+		return nil, err
+	}
+	archive, err := s.Export.Export(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.ExportPersonalDataResponse{
+		Archive:  archive,
+		Filename: "gophkeeper-export-" + time.Now().UTC().Format("2006-01-02") + ".zip",
+	}, nil
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// GetFavicon returns the icon of a host of the caller's login URLs.
+func (s *VaultServer) GetFavicon(ctx context.Context, in *vault.GetFaviconRequest) (*httpbody.HttpBody, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	f, err := s.Favicons.Favicon(ctx, userID, in.GetHost())
+	switch {
+	case errors.Is(err, service.ErrBadURL):
+		return nil, apierror.InvalidField("host", "malformed host")
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, service.ErrNoFavicon):
+		return nil, apierror.NotFound("favicon does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &httpbody.HttpBody{ContentType: f.ContentType, Data: f.Data}, nil
+}
@@ -0,0 +1,55 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// GetVaultHealth reports weak, reused, breached, old and rotation-due passwords of the caller.
+func (s *VaultServer) GetVaultHealth(
+	ctx context.Context,
+	_ *vault.GetVaultHealthRequest,
+) (*vault.GetVaultHealthResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	report, err := s.Health.Report(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetVaultHealthResponse{
+		Weak:        findingsToProto(report.Weak),
+		Reused:      make([]*vault.GetVaultHealthResponse_ReuseGroup, 0, len(report.Reused)),
+		Breached:    findingsToProto(report.Breached),
+		Old:         findingsToProto(report.Old),
+		RotationDue: findingsToProto(report.RotationDue),
+	}
+	for _, g := range report.Reused {
+		out.Reused = append(out.Reused, &vault.GetVaultHealthResponse_ReuseGroup{Items: findingsToProto(g.Items)})
+	}
+	return out, nil
+}
+
+func findingsToProto(findings []models.PasswordFinding) []*vault.GetVaultHealthResponse_Finding {
+	out := make([]*vault.GetVaultHealthResponse_Finding, 0, len(findings))
+	for _, f := range findings {
+		pf := &vault.GetVaultHealthResponse_Finding{
+			ItemId:      f.ItemID.String(),
+			Login:       f.Login,
+			BreachCount: f.BreachCount,
+			Strength:    int32(f.Strength), //nolint:gosec // zxcvbn scores are 0 to 4.
+			UpdatedAt:   timestamppb.New(f.UpdatedAt),
+		}
+		if !f.RotationDueAt.IsZero() {
+			pf.RotationDueAt = timestamppb.New(f.RotationDueAt)
+		}
+		out = append(out, pf)
+	}
+	return out
+}
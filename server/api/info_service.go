@@ -0,0 +1,37 @@
+package api
+
+import (
+	"context"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/info"
+	"github.com/cmrd-a/GophKeeper/server/buildinfo"
+	"github.com/cmrd-a/GophKeeper/server/telemetry"
+)
+
+// InfoServer implements InfoService.
+type InfoServer struct {
+	info.UnimplementedInfoServiceServer
+
+	// Features lists optional features enabled on this server.
+	Features []string
+	// Telemetry is nil unless telemetry is enabled.
+	Telemetry *telemetry.Reporter
+}
+
+// GetServerInfo returns the server build, the features it supports and whether it reports telemetry.
+func (s *InfoServer) GetServerInfo(
+	_ context.Context,
+	_ *info.GetServerInfoRequest,
+) (*info.GetServerInfoResponse, error) {
+	resp := &info.GetServerInfoResponse{
+		Version:    buildinfo.Version,
+		Commit:     buildinfo.Commit,
+		ApiVersion: buildinfo.APIVersion,
+		Features:   s.Features,
+		Telemetry:  "off",
+	}
+	if s.Telemetry != nil {
+		resp.Telemetry = s.Telemetry.Status()
+	}
+	return resp, nil
+}
@@ -0,0 +1,184 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// ListItemSummaries lists the caller's items of every type without their secrets.
+func (s *VaultServer) ListItemSummaries(
+	ctx context.Context,
+	in *vault.ListItemSummariesRequest,
+) (*vault.ListItemSummariesResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	var vaultID *uuid.UUID
+	if in.VaultId != nil {
+		id, err := uuid.Parse(in.GetVaultId())
+		if err != nil {
+			return nil, apierror.InvalidField("vault_id", "malformed vault id")
+		}
+		vaultID = &id
+	}
+	items, err := s.Service.ListItemSummaries(ctx, userID, vaultID, in.GetIncludeArchived())
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.ListItemSummariesResponse{Items: make([]*vault.ListItemSummariesResponse_ItemSummary, 0, len(items))}
+	for _, it := range items {
+		out.Items = append(out.Items, &vault.ListItemSummariesResponse_ItemSummary{
+			Id:        it.ID.String(),
+			VaultId:   it.VaultID.String(),
+			Type:      itemTypeToProto(it.ItemType),
+			Title:     it.Title,
+			CreatedAt: timestamppb.New(it.CreatedAt),
+			UpdatedAt: timestamppb.New(it.UpdatedAt),
+			Size:      it.Size,
+			Pinned:    it.Pinned,
+			SortIndex: int32(it.SortIndex), //nolint:gosec // At most maxOrderedItems.
+			Archived:  it.Archived,
+		})
+	}
+	return out, nil
+}
+
+// GetVaultItem returns one item of the caller with its secrets, along with the item's revision.
+func (s *VaultServer) GetVaultItem(ctx context.Context, in *vault.GetVaultItemRequest) (*vault.VaultItem, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed item id")
+	}
+	item, err := s.Service.GetVaultItem(ctx, userID, id, itemTypeFromProto(in.GetType()))
+	switch {
+	case errors.Is(err, service.ErrUnknownItemType):
+		return nil, apierror.InvalidField("type", "unknown item type")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("item does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return vaultItemToProto(item), nil
+}
+
+// vaultItemToProto returns the item of any type along with its revision.
+func vaultItemToProto(item models.VaultItem) *vault.VaultItem {
+	switch {
+	case item.LoginPassword != nil:
+		return &vault.VaultItem{
+			Item:     &vault.VaultItem_LoginPassword_{LoginPassword: streamedLoginPassword(*item.LoginPassword)},
+			Revision: item.LoginPassword.Revision,
+		}
+	case item.WifiCredential != nil:
+		return &vault.VaultItem{
+			Item:     &vault.VaultItem_WifiCredential{WifiCredential: wifiCredentialToProto(*item.WifiCredential)},
+			Revision: item.WifiCredential.Revision,
+		}
+	default:
+		return &vault.VaultItem{
+			Item:     &vault.VaultItem_SeedPhrase{SeedPhrase: seedPhraseToProto(*item.SeedPhrase)},
+			Revision: item.SeedPhrase.Revision,
+		}
+	}
+}
+
+// PinItem pins or unpins one of the caller's items, pinned items are listed first.
+func (s *VaultServer) PinItem(ctx context.Context, in *vault.PinItemRequest) (*vault.PinItemResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetItemId())
+	if err != nil {
+		return nil, apierror.InvalidField("item_id", "malformed item id")
+	}
+	revision, err := s.Service.PinItem(ctx, userID, id, in.GetPinned())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("item does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &vault.PinItemResponse{Revision: revision}, nil
+}
+
+// ArchiveItem archives or restores one of the caller's items. Archived items are still synced.
+func (s *VaultServer) ArchiveItem(
+	ctx context.Context,
+	in *vault.ArchiveItemRequest,
+) (*vault.ArchiveItemResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetItemId())
+	if err != nil {
+		return nil, apierror.InvalidField("item_id", "malformed item id")
+	}
+	revision, err := s.Service.ArchiveItem(ctx, userID, id, in.GetArchived())
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("item does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &vault.ArchiveItemResponse{Revision: revision}, nil
+}
+
+// ReorderItems places the caller's items in the order of item_ids, ahead of the items never ordered.
+func (s *VaultServer) ReorderItems(
+	ctx context.Context,
+	in *vault.ReorderItemsRequest,
+) (*vault.ReorderItemsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	ids := make([]uuid.UUID, 0, len(in.GetItemIds()))
+	for _, raw := range in.GetItemIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, apierror.InvalidField("item_ids", "malformed item id")
+		}
+		ids = append(ids, id)
+	}
+	revision, err := s.Service.ReorderItems(ctx, userID, ids)
+	switch {
+	case errors.Is(err, service.ErrBadItemOrder):
+		return nil, apierror.InvalidField("item_ids", "item order must list up to 10000 distinct items")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("item does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &vault.ReorderItemsResponse{Revision: revision}, nil
+}
+
+// itemTypeFromProto leaves unspecified types empty, which the service rejects.
+func itemTypeFromProto(t vault.ItemType) models.ItemType {
+	switch t {
+	case vault.ItemType_ITEM_TYPE_LOGIN_PASSWORD:
+		return models.ItemTypeLoginPassword
+	case vault.ItemType_ITEM_TYPE_WIFI_CREDENTIAL:
+		return models.ItemTypeWifiCredential
+	case vault.ItemType_ITEM_TYPE_SEED_PHRASE:
+		return models.ItemTypeSeedPhrase
+	case vault.ItemType_ITEM_TYPE_UNSPECIFIED:
+	}
+	return ""
+}
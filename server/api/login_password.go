@@ -0,0 +1,108 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/fieldmask"
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// GetVaultItemsStream sends the caller's items of every type one by one, so large vaults aren't held in memory.
+func (s *VaultServer) GetVaultItemsStream(
+	in *vault.GetVaultItemsStreamRequest,
+	stream grpc.ServerStreamingServer[vault.VaultItem],
+) error {
+	ctx := stream.Context()
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return errNoUser
+	}
+	err := fieldmask.Check(in.GetReadMask(), &vault.VaultItem{})
+	if err != nil {
+		return apierror.InvalidField("read_mask", "read mask names an unknown field")
+	}
+	var vaultID *uuid.UUID
+	if in.VaultId != nil {
+		id, err := uuid.Parse(in.GetVaultId())
+		if err != nil {
+			return apierror.InvalidField("vault_id", "malformed vault id")
+		}
+		vaultID = &id
+	}
+	return s.Service.StreamItems(ctx, userID, vaultID, func(it models.VaultItem, revision int64) error {
+		item := vaultItemToProto(it)
+		item.Revision = revision
+		fieldmask.Prune(item, in.GetReadMask())
+		return stream.Send(item)
+	})
+}
+
+func streamedLoginPassword(lp models.LoginPassword) *vault.VaultItem_LoginPassword {
+	return &vault.VaultItem_LoginPassword{
+		Id:           lp.ID.String(),
+		Login:        lp.Login,
+		Password:     lp.Password,
+		VaultId:      lp.VaultID.String(),
+		Urls:         loginURLsToProto(lp.URLs),
+		UpdatedAt:    timestamppb.New(lp.UpdatedAt),
+		Revision:     lp.Revision,
+		LastUsedAt:   timestampOrNil(lp.LastUsedAt),
+		RotationDays: int32(lp.RotationDays), //nolint:gosec // Rotation is at most 3650 days.
+	}
+}
+
+// GetLoginPasswords lists the caller's login passwords, or the recently used ones first,
+// with only the fields of the read mask.
+func (s *VaultServer) GetLoginPasswords(
+	ctx context.Context,
+	in *vault.GetLoginPasswordsRequest,
+) (*vault.GetLoginPasswordsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	err := fieldmask.Check(in.GetReadMask(), &vault.GetLoginPasswordsResponse_LoginPassword{})
+	if err != nil {
+		return nil, apierror.InvalidField("read_mask", "read mask names an unknown field")
+	}
+	// Read the revision first, so that changes racing with the listing are fetched again.
+	revision, err := s.Service.GetRevision(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var lps []models.LoginPassword
+	if in.GetSort() == vault.SortOrder_SORT_ORDER_RECENTLY_USED {
+		lps, err = s.Service.ListRecentlyUsed(ctx, userID, in.GetUsedSince().AsTime(), int(in.GetLimit()))
+	} else {
+		lps, err = s.Service.ListLoginPasswords(ctx, userID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetLoginPasswordsResponse{
+		LoginPasswords: make([]*vault.GetLoginPasswordsResponse_LoginPassword, 0, len(lps)),
+		Revision:       revision,
+	}
+	for _, lp := range lps {
+		plp := &vault.GetLoginPasswordsResponse_LoginPassword{
+			Login:        lp.Login,
+			Password:     lp.Password,
+			VaultId:      lp.VaultID.String(),
+			Urls:         loginURLsToProto(lp.URLs),
+			Id:           lp.ID.String(),
+			LastUsedAt:   timestampOrNil(lp.LastUsedAt),
+			Revision:     lp.Revision,
+			RotationDays: int32(lp.RotationDays), //nolint:gosec // Rotation is at most 3650 days.
+		}
+		fieldmask.Prune(plp, in.GetReadMask())
+		out.LoginPasswords = append(out.LoginPasswords, plp)
+	}
+	return out, nil
+}
@@ -0,0 +1,42 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// FindLoginsForURL returns the caller's login passwords with a URL matching the page URL.
+func (s *VaultServer) FindLoginsForURL(
+	ctx context.Context,
+	in *vault.FindLoginsForURLRequest,
+) (*vault.FindLoginsForURLResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	found, err := s.Service.FindLoginsForURL(ctx, userID, in.GetUrl(), in.GetIncludeArchived())
+	if errors.Is(err, service.ErrBadURL) {
+		return nil, apierror.InvalidField("url", "malformed page URL")
+	}
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.FindLoginsForURLResponse{
+		LoginPasswords: make([]*vault.FindLoginsForURLResponse_LoginPassword, 0, len(found)),
+	}
+	for _, lp := range found {
+		out.LoginPasswords = append(out.LoginPasswords, &vault.FindLoginsForURLResponse_LoginPassword{
+			Id:       lp.ID.String(),
+			Login:    lp.Login,
+			Password: lp.Password,
+			VaultId:  lp.VaultID.String(),
+			Urls:     loginURLsToProto(lp.URLs),
+		})
+	}
+	return out, nil
+}
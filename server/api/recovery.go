@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *UserServer) CreateRecoveryKit(
+	ctx context.Context,
+	_ *user.CreateRecoveryKitRequest,
+) (*user.CreateRecoveryKitResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	kit, err := s.Recovery.CreateKit(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &user.CreateRecoveryKitResponse{
+		UserId:       kit.UserID.String(),
+		RecoveryCode: kit.Code,
+		CreatedAt:    timestamppb.New(kit.CreatedAt),
+	}, nil
+}
+
+func (s *UserServer) SetRecoveryKey(
+	ctx context.Context,
+	in *user.SetRecoveryKeyRequest,
+) (*user.SetRecoveryKeyResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	err := s.Recovery.SetKey(ctx, userID, in.GetRecoveryCode(), in.GetWrappedKey())
+	switch {
+	case errors.Is(err, service.ErrRecoveryKeyTooLarge):
+		return nil, apierror.InvalidField("wrapped_key", "wrapped recovery key is too large")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.InvalidField("recovery_code", "not the code of the current recovery kit")
+	case err != nil:
+		return nil, err
+	}
+	return &user.SetRecoveryKeyResponse{}, nil
+}
+
+// RecoverAccount doesn't tell whether the login or the code was wrong.
+func (s *UserServer) RecoverAccount(
+	ctx context.Context,
+	in *user.RecoverAccountRequest,
+) (*user.RecoverAccountResponse, error) {
+	wrappedKey, token, err := s.Recovery.Recover(
+		ctx,
+		in.GetLogin(),
+		in.GetRecoveryCode(),
+		in.GetNewPassword(),
+		in.GetDeviceName(),
+	)
+	if errors.Is(err, service.ErrBadPassword) {
+		return nil, apierror.InvalidField("new_password", "password must be 8 to 1024 characters")
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.New(
+			codes.Unauthenticated,
+			apierror.ReasonUnauthenticated,
+			"invalid login or recovery code",
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.RecoverAccountResponse{WrappedKey: wrappedKey, Token: token}, nil
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/pow"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *UserServer) GetRegistrationChallenge(
+	_ context.Context,
+	_ *user.GetRegistrationChallengeRequest,
+) (*user.GetRegistrationChallengeResponse, error) {
+	resp := &user.GetRegistrationChallengeResponse{
+		Open:            s.Registration.Open(),
+		CaptchaRequired: s.Registration.CaptchaRequired(),
+	}
+	c, ok, err := s.Registration.Challenge()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		resp.Challenge = c.Token
+		resp.Difficulty = int32(c.Bits) //nolint:gosec // At most 32 bits, see the config.
+		resp.ExpiresAt = timestamppb.New(c.ExpiresAt)
+	}
+	return resp, nil
+}
+
+// checkRegistration runs the registration checks, telling the client which one failed.
+func (s *UserServer) checkRegistration(ctx context.Context, in *user.RegisterRequest) error {
+	err := s.Registration.Check(ctx, service.Registration{
+		Host:              interceptor.ClientHost(ctx),
+		InviteCode:        in.GetInviteCode(),
+		Challenge:         in.GetChallenge(),
+		ChallengeSolution: in.GetChallengeSolution(),
+		CaptchaToken:      in.GetCaptchaToken(),
+	})
+	switch {
+	case errors.Is(err, service.ErrRegistrationClosed):
+		return apierror.New(codes.PermissionDenied, apierror.ReasonRegistrationClosed, "registration is closed")
+	case errors.Is(err, service.ErrBadInviteCode):
+		return apierror.InvalidField("invite_code", "invalid invite code")
+	case errors.Is(err, pow.ErrBadChallenge):
+		return apierror.InvalidField("challenge", "invalid or expired challenge")
+	case errors.Is(err, pow.ErrBadSolution):
+		return apierror.InvalidField("challenge_solution", "challenge solution does not have enough zero bits")
+	case errors.Is(err, service.ErrBadCaptcha):
+		return apierror.InvalidField("captcha_token", "CAPTCHA verification failed")
+	case errors.Is(err, service.ErrRegistrationLimit):
+		return apierror.New(
+			codes.ResourceExhausted,
+			apierror.ReasonRateLimited,
+			"too many accounts created from this address",
+		)
+	}
+	return err
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// SetItemRotation sets how often the password of one of the caller's login items should be changed.
+func (s *VaultServer) SetItemRotation(
+	ctx context.Context,
+	in *vault.SetItemRotationRequest,
+) (*vault.SetItemRotationResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetItemId())
+	if err != nil {
+		return nil, apierror.InvalidField("item_id", "malformed item id")
+	}
+	revision, err := s.Service.SetItemRotation(ctx, userID, id, int(in.GetRotationDays()))
+	switch {
+	case errors.Is(err, service.ErrBadRotation):
+		return nil, apierror.InvalidField("rotation_days", "rotation must be 0 to 3650 days")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("item does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &vault.SetItemRotationResponse{Revision: revision}, nil
+}
+
+// SetVaultRotation sets how often the passwords of the login items in one of the caller's vaults should be changed.
+func (s *VaultServer) SetVaultRotation(
+	ctx context.Context,
+	in *vault.SetVaultRotationRequest,
+) (*vault.SetVaultRotationResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetVaultId())
+	if err != nil {
+		return nil, apierror.InvalidField("vault_id", "malformed vault id")
+	}
+	revision, err := s.Service.SetVaultRotation(ctx, userID, id, int(in.GetRotationDays()))
+	switch {
+	case errors.Is(err, service.ErrBadRotation):
+		return nil, apierror.InvalidField("rotation_days", "rotation must be 0 to 3650 days")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("vault does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &vault.SetVaultRotationResponse{Revision: revision}, nil
+}
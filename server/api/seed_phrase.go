@@ -0,0 +1,84 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *VaultServer) GetSeedPhrases(
+	ctx context.Context,
+	_ *vault.GetSeedPhrasesRequest,
+) (*vault.GetSeedPhrasesResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	phrases, err := s.Service.GetSeedPhrases(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetSeedPhrasesResponse{SeedPhrases: make([]*vault.SeedPhrase, 0, len(phrases))}
+	for _, sp := range phrases {
+		out.SeedPhrases = append(out.SeedPhrases, seedPhraseToProto(sp))
+	}
+	return out, nil
+}
+
+// SaveSeedPhrase creates a seed phrase without an id or updates the one with it.
+func (s *VaultServer) SaveSeedPhrase(
+	ctx context.Context,
+	in *vault.SaveSeedPhraseRequest,
+) (*vault.SaveSeedPhraseResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	sp := models.SeedPhrase{
+		UserID:           userID,
+		Name:             in.GetName(),
+		Words:            in.GetWords(),
+		ExpectedRevision: in.ExpectedRevision,
+	}
+	var err error
+	sp.ID, sp.VaultID, err = savedItemIDs(in.Id, in.VaultId) //nolint:protogetter // Unset ids are nil.
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Service.SaveSeedPhrase(ctx, sp)
+	switch {
+	case errors.Is(err, service.ErrBadSeedPhraseName):
+		return nil, apierror.InvalidField("name", "seed phrase name must be 1 to 64 characters")
+	case errors.Is(err, service.ErrBadSeedPhrase):
+		return nil, apierror.InvalidField("words", "seed phrases must have 12 or 24 lowercase words")
+	case err != nil:
+		return nil, itemError(err)
+	}
+	return &vault.SaveSeedPhraseResponse{Revision: revision}, nil
+}
+
+func (s *VaultServer) DeleteSeedPhrase(
+	ctx context.Context,
+	in *vault.DeleteSeedPhraseRequest,
+) (*vault.DeleteSeedPhraseResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed item id")
+	}
+	revision, err := s.Service.DeleteSeedPhrase(ctx, userID, id, in.ExpectedRevision)
+	if err != nil {
+		return nil, itemError(err)
+	}
+	return &vault.DeleteSeedPhraseResponse{Revision: revision}, nil
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/send"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/gateway"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// SendServer implements SendService.
+type SendServer struct {
+	send.UnimplementedSendServiceServer
+
+	Service *service.SendService
+}
+
+// RevealSecretLink returns the secret once per allowed view.
+func (s *SendServer) RevealSecretLink(
+	ctx context.Context,
+	in *send.RevealSecretLinkRequest,
+) (*send.RevealSecretLinkResponse, error) {
+	text, viewsLeft, err := s.Service.Reveal(ctx, in.GetToken())
+	switch {
+	case errors.Is(err, service.ErrBadSecretLink):
+		return nil, apierror.InvalidField("token", "malformed secret link")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("secret link does not exist or has expired")
+	case err != nil:
+		return nil, err
+	}
+	return &send.RevealSecretLinkResponse{Text: text, ViewsLeft: viewsLeft}, nil
+}
+
+// CreateSecretLink stores a login password item or text for a limited number of reveals.
+func (s *SendServer) CreateSecretLink(
+	ctx context.Context,
+	in *send.CreateSecretLinkRequest,
+) (*send.CreateSecretLinkResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	ttl := time.Duration(in.GetTtlSeconds()) * time.Second
+	var token string
+	var expiresAt time.Time
+	var err error
+	switch secret := in.GetSecret().(type) {
+	case *send.CreateSecretLinkRequest_ItemId:
+		itemID, parseErr := uuid.Parse(secret.ItemId)
+		if parseErr != nil {
+			return nil, apierror.InvalidField("item_id", "malformed item id")
+		}
+		token, expiresAt, err = s.Service.CreateItemLink(ctx, userID, itemID, ttl, in.GetMaxViews())
+	case *send.CreateSecretLinkRequest_Text:
+		token, expiresAt, err = s.Service.CreateLink(ctx, userID, secret.Text, ttl, in.GetMaxViews())
+	default:
+		return nil, apierror.InvalidField("secret", "either item_id or text is required")
+	}
+	switch {
+	case errors.Is(err, service.ErrBadSecretLink):
+		return nil, apierror.InvalidField("ttl_seconds", "secret links must expire in 1 second to 30 days")
+	case errors.Is(err, service.ErrSecretTooLarge):
+		return nil, apierror.InvalidField("text", "secret links hold at most 64 KiB of text")
+	case errors.Is(err, service.ErrQuotaExceeded):
+		return nil, apierror.New(codes.ResourceExhausted, apierror.ReasonQuotaExceeded, "tenant storage quota exceeded")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("item does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &send.CreateSecretLinkResponse{
+		Url:       gateway.RevealPagePath + "#" + token,
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
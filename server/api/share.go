@@ -0,0 +1,94 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// ShareItem grants a user of the caller's tenant access to a login password item.
+// Sharing an item with the same user again updates the grant.
+func (s *VaultServer) ShareItem(ctx context.Context, in *vault.ShareItemRequest) (*vault.ShareItemResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	itemID, err := uuid.Parse(in.GetItemId())
+	if err != nil {
+		return nil, apierror.InvalidField("item_id", "malformed item id")
+	}
+	var expiresAt *time.Time
+	if in.GetExpiresAt() != nil {
+		t := in.GetExpiresAt().AsTime()
+		expiresAt = &t
+	}
+	id, err := s.Shares.ShareItem(ctx, userID, itemID, in.GetGranteeLogin(), in.GetReadOnly(), expiresAt)
+	switch {
+	case errors.Is(err, service.ErrBadShare):
+		return nil, apierror.InvalidField("grantee_login", "items can be shared with other users until a future time")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("item or user does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &vault.ShareItemResponse{Id: id.String()}, nil
+}
+
+// ListMyShares returns the grants made by the caller, expired ones included.
+func (s *VaultServer) ListMyShares(
+	ctx context.Context,
+	_ *vault.ListMySharesRequest,
+) (*vault.ListMySharesResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	shares, err := s.Shares.ListMyShares(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.ListMySharesResponse{Shares: make([]*vault.ListMySharesResponse_Share, 0, len(shares))}
+	for _, sh := range shares {
+		out.Shares = append(out.Shares, &vault.ListMySharesResponse_Share{
+			Id:           sh.ID.String(),
+			ItemId:       sh.ItemID.String(),
+			ItemType:     itemTypeToProto(sh.ItemType),
+			GranteeLogin: sh.GranteeLogin,
+			ReadOnly:     sh.ReadOnly,
+			ExpiresAt:    timestampOrNil(sh.ExpiresAt),
+			CreatedAt:    timestamppb.New(sh.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+func (s *VaultServer) RevokeShare(
+	ctx context.Context,
+	in *vault.RevokeShareRequest,
+) (*vault.RevokeShareResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed share id")
+	}
+	err = s.Shares.RevokeShare(ctx, userID, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("share does not exist")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &vault.RevokeShareResponse{}, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+)
+
+// GetVaultStats returns how the caller's vault grew, its storage by item type and its oldest passwords.
+func (s *VaultServer) GetVaultStats(
+	ctx context.Context,
+	_ *vault.GetVaultStatsRequest,
+) (*vault.GetVaultStatsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	stats, err := s.Service.GetVaultStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetVaultStatsResponse{
+		AddedPerMonth:   make([]*vault.GetVaultStatsResponse_MonthCount, 0, len(stats.AddedPerMonth)),
+		StorageBytes:    make(map[string]int64, len(stats.StorageBytes)),
+		OldestPasswords: make([]*vault.GetVaultStatsResponse_OldPassword, 0, len(stats.OldestPasswords)),
+	}
+	for _, m := range stats.AddedPerMonth {
+		out.AddedPerMonth = append(out.AddedPerMonth, &vault.GetVaultStatsResponse_MonthCount{
+			Month: timestamppb.New(m.Month),
+			Count: m.Count,
+		})
+	}
+	for t, n := range stats.StorageBytes {
+		out.StorageBytes[string(t)] = n
+	}
+	for _, lp := range stats.OldestPasswords {
+		out.OldestPasswords = append(out.OldestPasswords, &vault.GetVaultStatsResponse_OldPassword{
+			Id:        lp.ID.String(),
+			Login:     lp.Login,
+			UpdatedAt: timestamppb.New(lp.UpdatedAt),
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/admin"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *AdminServer) CreateTenant(
+	ctx context.Context,
+	in *admin.CreateTenantRequest,
+) (*admin.CreateTenantResponse, error) {
+	id, err := s.Service.CreateTenant(ctx, in.GetName(), in.GetStorageQuotaBytes())
+	switch {
+	case errors.Is(err, service.ErrBadTenantName):
+		return nil, apierror.InvalidField("name", err.Error())
+	case errors.Is(err, service.ErrBadQuota):
+		return nil, apierror.InvalidField("storage_quota_bytes", err.Error())
+	case errors.Is(err, service.ErrTenantExists):
+		return nil, apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, err.Error())
+	case err != nil:
+		return nil, err
+	}
+	return &admin.CreateTenantResponse{Id: id.String()}, nil
+}
+
+func (s *AdminServer) ListTenants(
+	ctx context.Context,
+	_ *admin.ListTenantsRequest,
+) (*admin.ListTenantsResponse, error) {
+	tenants, err := s.Service.ListTenants(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &admin.ListTenantsResponse{}
+	for _, t := range tenants {
+		resp.Tenants = append(resp.Tenants, &admin.ListTenantsResponse_Tenant{
+			Id:                t.ID.String(),
+			Name:              t.Name,
+			StorageQuotaBytes: t.StorageQuotaBytes,
+			CreatedAt:         timestamppb.New(t.CreatedAt),
+			Users:             t.Users,
+			StorageBytes:      t.StorageBytes,
+		})
+	}
+	return resp, nil
+}
+
+func (s *AdminServer) SetTenantQuota(
+	ctx context.Context,
+	in *admin.SetTenantQuotaRequest,
+) (*admin.SetTenantQuotaResponse, error) {
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed tenant id")
+	}
+	err = s.Service.SetTenantQuota(ctx, id, in.GetStorageQuotaBytes())
+	switch {
+	case errors.Is(err, service.ErrBadQuota):
+		return nil, apierror.InvalidField("storage_quota_bytes", err.Error())
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("tenant does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &admin.SetTenantQuotaResponse{}, nil
+}
+
+func (s *AdminServer) SetUserTenant(
+	ctx context.Context,
+	in *admin.SetUserTenantRequest,
+) (*admin.SetUserTenantResponse, error) {
+	tenantID, err := optionalTenantID(in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+	err = s.Service.SetUserTenant(ctx, in.GetLogin(), tenantID)
+	switch {
+	case errors.Is(err, service.ErrNoTenant):
+		return nil, apierror.NotFound("tenant does not exist")
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, apierror.NotFound("user does not exist")
+	case err != nil:
+		return nil, err
+	}
+	return &admin.SetUserTenantResponse{}, nil
+}
+
+// optionalTenantID parses a tenant_id field, nil if unset.
+func optionalTenantID(s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil //nolint:nilnil // No tenant.
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, apierror.InvalidField("tenant_id", "malformed tenant id")
+	}
+	return &id, nil
+}
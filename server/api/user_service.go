@@ -2,28 +2,92 @@ package api
 
 import (
 	"context"
-	"os"
+	"errors"
 
-	"log"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
 
 	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
-	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/service"
 )
 
+// errNoUser is returned by handlers of authenticated methods when the auth interceptor was skipped.
+var errNoUser = apierror.New(codes.Unauthenticated, apierror.ReasonUnauthenticated, "missing access token")
+
 // UserServer implements UserService.
 type UserServer struct {
 	user.UnimplementedUserServiceServer
+
+	Accounts  *service.AccountService
+	Allowlist *service.AllowlistService
+	Devices   *service.DeviceService
+	Export    *service.ExportService
+	// ExportLimiter limits the exports of each user.
+	ExportLimiter *interceptor.Limiter
+	Recovery      *service.RecoveryService
+	Registration  *service.RegistrationService
 }
 
-// Register implements EchoHandlerServer.Echo.
-func (s *UserServer) Register(_ context.Context, in *user.RegisterRequest) (*user.RegisterResponse, error) {
-	login := in.GetLogin()
-	log.Printf("login: %v", login)
-	log.Print("password: ***")
-	r, err := repository.NewRepository(context.Background(), os.Getenv("DATABASE_URL"))
+// Register creates an account once the registration checks pass.
+func (s *UserServer) Register(ctx context.Context, in *user.RegisterRequest) (*user.RegisterResponse, error) {
+	err := s.checkRegistration(ctx, in)
 	if err != nil {
 		return nil, err
 	}
-	r.InsertUser("1")
+	_, err = s.Accounts.Register(ctx, in.GetLogin(), in.GetPassword())
+	switch {
+	case errors.Is(err, service.ErrBadLogin):
+		return nil, apierror.InvalidField("login", "login must be 1 to 64 characters without spaces")
+	case errors.Is(err, service.ErrBadPassword):
+		return nil, apierror.InvalidField("password", "password must be 8 to 1024 characters")
+	case errors.Is(err, service.ErrLoginTaken):
+		return nil, apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, "login is already taken")
+	case err != nil:
+		return nil, err
+	}
 	return &user.RegisterResponse{}, nil
 }
+
+// Login doesn't tell whether the login or the password was wrong.
+func (s *UserServer) Login(ctx context.Context, in *user.LoginRequest) (*user.LoginResponse, error) {
+	var deviceID uuid.UUID
+	if in.GetDeviceId() != "" {
+		var err error
+		deviceID, err = uuid.Parse(in.GetDeviceId())
+		if err != nil {
+			return nil, apierror.InvalidField("device_id", "malformed device id")
+		}
+	}
+	token, deviceID, err := s.Accounts.Login(ctx, in.GetLogin(), in.GetPassword(), in.GetDeviceName(), deviceID)
+	if errors.Is(err, service.ErrBadCredentials) {
+		return nil, apierror.New(codes.Unauthenticated, apierror.ReasonUnauthenticated, "invalid login or password")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user.LoginResponse{Token: token, DeviceId: deviceID.String()}, nil
+}
+
+func (s *UserServer) ChangePassword(
+	ctx context.Context,
+	in *user.ChangePasswordRequest,
+) (*user.ChangePasswordResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	deviceID, _ := auth.DeviceID(ctx)
+	token, err := s.Accounts.ChangePassword(ctx, userID, deviceID, in.GetCurrentPassword(), in.GetNewPassword())
+	switch {
+	case errors.Is(err, service.ErrBadCredentials):
+		return nil, apierror.InvalidField("current_password", "current password is wrong")
+	case errors.Is(err, service.ErrBadPassword):
+		return nil, apierror.InvalidField("new_password", "password must be 8 to 1024 characters")
+	case err != nil:
+		return nil, err
+	}
+	return &user.ChangePasswordResponse{Token: token}, nil
+}
@@ -1,10 +1,293 @@
 package api
 
 import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/service"
 )
 
 // VaultServer implements VaultService.
 type VaultServer struct {
 	vault.UnimplementedVaultServiceServer
+
+	Service  *service.VaultService
+	Health   *service.HealthService
+	Shares   *service.ShareService
+	Favicons *service.FaviconService
+}
+
+// SaveLoginPassword creates a login password without an id or updates the one with it.
+func (s *VaultServer) SaveLoginPassword(
+	ctx context.Context,
+	in *vault.SaveLoginPasswordRequest,
+) (*vault.SaveLoginPasswordResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	lp := models.LoginPassword{
+		UserID:           userID,
+		Login:            in.GetLogin(),
+		Password:         in.GetPassword(),
+		URLs:             loginURLsFromProto(in.GetUrls()),
+		ExpectedRevision: in.ExpectedRevision,
+	}
+	var err error
+	lp.ID, lp.VaultID, err = savedItemIDs(in.Id, in.VaultId)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Service.SaveLoginPassword(ctx, lp)
+	if err != nil {
+		return nil, itemError(err)
+	}
+	return &vault.SaveLoginPasswordResponse{Revision: revision}, nil
+}
+
+func (s *VaultServer) DeleteLoginPassword(
+	ctx context.Context,
+	in *vault.DeleteLoginPasswordRequest,
+) (*vault.DeleteLoginPasswordResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed item id")
+	}
+	revision, err := s.Service.DeleteLoginPassword(ctx, userID, id, in.ExpectedRevision)
+	if err != nil {
+		return nil, itemError(err)
+	}
+	return &vault.DeleteLoginPasswordResponse{Revision: revision}, nil
+}
+
+// TouchItem records that the caller viewed or copied an item of any type, so login passwords are listed
+// among the recently used ones.
+func (s *VaultServer) TouchItem(ctx context.Context, in *vault.TouchItemRequest) (*vault.TouchItemResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetItemId())
+	if err != nil {
+		return nil, apierror.InvalidField("item_id", "malformed item id")
+	}
+	revision, err := s.Service.TouchItem(ctx, userID, id)
+	if err != nil {
+		return nil, itemError(err)
+	}
+	return &vault.TouchItemResponse{Revision: revision}, nil
+}
+
+func (s *VaultServer) ListVaults(ctx context.Context, _ *vault.ListVaultsRequest) (*vault.ListVaultsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	vaults, err := s.Service.ListVaults(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.ListVaultsResponse{Vaults: make([]*vault.ListVaultsResponse_Vault, 0, len(vaults))}
+	for _, v := range vaults {
+		out.Vaults = append(out.Vaults, &vault.ListVaultsResponse_Vault{
+			Id:           v.ID.String(),
+			Name:         v.Name,
+			CreatedAt:    timestamppb.New(v.CreatedAt),
+			RotationDays: int32(v.RotationDays), //nolint:gosec // Rotation is at most 3650 days.
+		})
+	}
+	return out, nil
+}
+
+func (s *VaultServer) CreateVault(
+	ctx context.Context,
+	in *vault.CreateVaultRequest,
+) (*vault.CreateVaultResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, revision, err := s.Service.CreateVault(ctx, userID, in.GetName())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.CreateVaultResponse{Id: id.String(), Revision: revision}, nil
+}
+
+func (s *VaultServer) RenameVault(
+	ctx context.Context,
+	in *vault.RenameVaultRequest,
+) (*vault.RenameVaultResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed vault id")
+	}
+	revision, err := s.Service.RenameVault(ctx, userID, id, in.GetName())
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.RenameVaultResponse{Revision: revision}, nil
+}
+
+func (s *VaultServer) DeleteVault(
+	ctx context.Context,
+	in *vault.DeleteVaultRequest,
+) (*vault.DeleteVaultResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed vault id")
+	}
+	revision, err := s.Service.DeleteVault(ctx, userID, id)
+	if err != nil {
+		return nil, vaultError(err)
+	}
+	return &vault.DeleteVaultResponse{Revision: revision}, nil
+}
+
+// WatchVaultChanges streams the changes of the caller's vault until the call ends.
+func (s *VaultServer) WatchVaultChanges(
+	_ *vault.WatchVaultChangesRequest,
+	stream grpc.ServerStreamingServer[vault.VaultChangeEvent],
+) error {
+	ctx := stream.Context()
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return errNoUser
+	}
+	for ev := range s.Service.WatchChanges(ctx, userID) {
+		err := stream.Send(&vault.VaultChangeEvent{
+			ItemId:    ev.ItemID.String(),
+			ItemType:  itemTypeToProto(ev.ItemType),
+			Operation: operationToProto(ev.Operation),
+			Revision:  ev.Revision,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// savedItemIDs parses the optional ids of a saved item, leaving them unset for new items and the default vault.
+func savedItemIDs(rawID, rawVaultID *string) (*uuid.UUID, uuid.UUID, error) {
+	var id *uuid.UUID
+	if rawID != nil {
+		parsed, err := uuid.Parse(*rawID)
+		if err != nil {
+			return nil, uuid.Nil, apierror.InvalidField("id", "malformed item id")
+		}
+		id = &parsed
+	}
+	if rawVaultID == nil {
+		return id, uuid.Nil, nil
+	}
+	vaultID, err := uuid.Parse(*rawVaultID)
+	if err != nil {
+		return nil, uuid.Nil, apierror.InvalidField("vault_id", "malformed vault id")
+	}
+	return id, vaultID, nil
+}
+
+// itemError maps the errors of writing vault items to API errors.
+func itemError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrRevisionMismatch):
+		return apierror.New(codes.FailedPrecondition, apierror.ReasonRevisionMismatch,
+			"item was changed since the expected revision")
+	case errors.Is(err, service.ErrReadOnlyShare):
+		return apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied, "item is shared read-only")
+	case errors.Is(err, service.ErrNotItemOwner):
+		return apierror.New(codes.PermissionDenied, apierror.ReasonPermissionDenied,
+			"only the owner of the item can do this")
+	case errors.Is(err, service.ErrQuotaExceeded):
+		return apierror.New(codes.ResourceExhausted, apierror.ReasonQuotaExceeded, "tenant storage quota exceeded")
+	case errors.Is(err, service.ErrBadURL):
+		return apierror.InvalidField("urls", "malformed login URL")
+	case errors.Is(err, pgx.ErrNoRows):
+		return apierror.NotFound("item or vault does not exist")
+	}
+	return err
+}
+
+// vaultError maps the errors of managing vaults to API errors.
+func vaultError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrBadVaultName):
+		return apierror.InvalidField("name", "vault name must be 1 to 64 characters")
+	case errors.Is(err, service.ErrVaultExists):
+		return apierror.New(codes.AlreadyExists, apierror.ReasonAlreadyExists, "vault with this name already exists")
+	case errors.Is(err, service.ErrVaultNotEmpty):
+		return apierror.New(codes.FailedPrecondition, apierror.ReasonVaultNotEmpty, "vault is not empty")
+	case errors.Is(err, pgx.ErrNoRows):
+		return apierror.NotFound("vault does not exist")
+	}
+	return err
+}
+
+func loginURLsFromProto(urls []*vault.LoginURL) []models.LoginURL {
+	out := make([]models.LoginURL, 0, len(urls))
+	for _, u := range urls {
+		out = append(out, models.LoginURL{URL: u.GetUrl(), Match: urlMatchFromProto(u.GetMatch())})
+	}
+	return out
+}
+
+// urlMatchFromProto leaves unspecified matches empty, for the service to default them to domain matching.
+func urlMatchFromProto(m vault.URLMatch) models.URLMatch {
+	switch m {
+	case vault.URLMatch_URL_MATCH_DOMAIN:
+		return models.URLMatchDomain
+	case vault.URLMatch_URL_MATCH_EXACT:
+		return models.URLMatchExact
+	case vault.URLMatch_URL_MATCH_PREFIX:
+		return models.URLMatchPrefix
+	case vault.URLMatch_URL_MATCH_UNSPECIFIED:
+	}
+	return ""
+}
+
+func itemTypeToProto(t models.ItemType) vault.ItemType {
+	switch t {
+	case models.ItemTypeLoginPassword:
+		return vault.ItemType_ITEM_TYPE_LOGIN_PASSWORD
+	case models.ItemTypeWifiCredential:
+		return vault.ItemType_ITEM_TYPE_WIFI_CREDENTIAL
+	case models.ItemTypeSeedPhrase:
+		return vault.ItemType_ITEM_TYPE_SEED_PHRASE
+	}
+	return vault.ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func operationToProto(op models.Operation) vault.Operation {
+	switch op {
+	case models.OperationCreated:
+		return vault.Operation_OPERATION_CREATED
+	case models.OperationUpdated:
+		return vault.Operation_OPERATION_UPDATED
+	case models.OperationDeleted:
+		return vault.Operation_OPERATION_DELETED
+	}
+	return vault.Operation_OPERATION_UNSPECIFIED
 }
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+func (s *VaultServer) GetWifiCredentials(
+	ctx context.Context,
+	_ *vault.GetWifiCredentialsRequest,
+) (*vault.GetWifiCredentialsResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	creds, err := s.Service.GetWifiCredentials(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	out := &vault.GetWifiCredentialsResponse{WifiCredentials: make([]*vault.WifiCredential, 0, len(creds))}
+	for _, w := range creds {
+		out.WifiCredentials = append(out.WifiCredentials, wifiCredentialToProto(w))
+	}
+	return out, nil
+}
+
+// SaveWifiCredential creates a WiFi credential without an id or updates the one with it.
+func (s *VaultServer) SaveWifiCredential(
+	ctx context.Context,
+	in *vault.SaveWifiCredentialRequest,
+) (*vault.SaveWifiCredentialResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	w := models.WifiCredential{
+		UserID:           userID,
+		SSID:             in.GetSsid(),
+		Security:         wifiSecurityFromProto(in.GetSecurity()),
+		Password:         in.GetPassword(),
+		Hidden:           in.GetHidden(),
+		ExpectedRevision: in.ExpectedRevision,
+	}
+	var err error
+	w.ID, w.VaultID, err = savedItemIDs(in.Id, in.VaultId) //nolint:protogetter // Unset ids are nil.
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.Service.SaveWifiCredential(ctx, w)
+	switch {
+	case errors.Is(err, service.ErrBadSSID):
+		return nil, apierror.InvalidField("ssid", "SSID must be 1 to 32 bytes")
+	case errors.Is(err, service.ErrBadWifiSecurity):
+		return nil, apierror.InvalidField("security", "unknown WiFi security type")
+	case errors.Is(err, service.ErrBadWifiPassword):
+		return nil, apierror.InvalidField("password",
+			"WPA passwords must be 8 to 63 characters, open networks have none")
+	case err != nil:
+		return nil, itemError(err)
+	}
+	return &vault.SaveWifiCredentialResponse{Revision: revision}, nil
+}
+
+func (s *VaultServer) DeleteWifiCredential(
+	ctx context.Context,
+	in *vault.DeleteWifiCredentialRequest,
+) (*vault.DeleteWifiCredentialResponse, error) {
+	userID, ok := auth.UserID(ctx)
+	if !ok {
+		return nil, errNoUser
+	}
+	id, err := uuid.Parse(in.GetId())
+	if err != nil {
+		return nil, apierror.InvalidField("id", "malformed item id")
+	}
+	revision, err := s.Service.DeleteWifiCredential(ctx, userID, id, in.ExpectedRevision)
+	if err != nil {
+		return nil, itemError(err)
+	}
+	return &vault.DeleteWifiCredentialResponse{Revision: revision}, nil
+}
+
+// wifiSecurityFromProto leaves unspecified security empty, which the service rejects.
+func wifiSecurityFromProto(sec vault.WifiSecurity) models.WifiSecurity {
+	switch sec {
+	case vault.WifiSecurity_WIFI_SECURITY_NONE:
+		return models.WifiSecurityNone
+	case vault.WifiSecurity_WIFI_SECURITY_WEP:
+		return models.WifiSecurityWEP
+	case vault.WifiSecurity_WIFI_SECURITY_WPA:
+		return models.WifiSecurityWPA
+	case vault.WifiSecurity_WIFI_SECURITY_WPA3:
+		return models.WifiSecurityWPA3
+	case vault.WifiSecurity_WIFI_SECURITY_UNSPECIFIED:
+	}
+	return ""
+}
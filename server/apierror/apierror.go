@@ -0,0 +1,88 @@
+// Package apierror builds the gRPC status errors of the API, carrying google.rpc.ErrorInfo
+// with a machine readable reason and google.rpc.BadRequest field violations.
+package apierror
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain is the ErrorInfo domain of the API errors.
+const Domain = "gophkeeper"
+
+// Reasons of the API errors, stable for clients to match on.
+const (
+	ReasonInvalidArgument  = "INVALID_ARGUMENT"
+	ReasonNotFound         = "NOT_FOUND"
+	ReasonUnauthenticated  = "UNAUTHENTICATED"
+	ReasonRateLimited      = "RATE_LIMITED"
+	ReasonMaintenance      = "MAINTENANCE"
+	ReasonBackupsDisabled  = "BACKUPS_DISABLED"
+	ReasonBackupUnreadable = "BACKUP_UNREADABLE"
+	// ReasonRevisionMismatch is returned when an item changed since the expected revision.
+	ReasonRevisionMismatch = "REVISION_MISMATCH"
+	// ReasonIdempotencyKeyReused is returned when a retry's request differs from the original.
+	ReasonIdempotencyKeyReused = "IDEMPOTENCY_KEY_REUSED"
+	// ReasonRegistrationClosed is returned by Register when open registration is disabled without invite codes.
+	ReasonRegistrationClosed = "REGISTRATION_CLOSED"
+	// ReasonAddressNotAllowed is returned when the caller's address is outside the account's allowed networks.
+	ReasonAddressNotAllowed = "ADDRESS_NOT_ALLOWED"
+	// ReasonAlreadyExists is returned when a name is already taken.
+	ReasonAlreadyExists = "ALREADY_EXISTS"
+	// ReasonPermissionDenied is returned when the caller may see an item but not change it.
+	ReasonPermissionDenied = "PERMISSION_DENIED"
+	// ReasonQuotaExceeded is returned when a write would take the tenant over its storage quota.
+	ReasonQuotaExceeded = "QUOTA_EXCEEDED"
+	// ReasonVaultNotEmpty is returned when deleting a vault that still has items.
+	ReasonVaultNotEmpty = "VAULT_NOT_EMPTY"
+	ReasonInternal      = "INTERNAL"
+)
+
+// New returns a status error with the code and message, and an ErrorInfo with the reason.
+func New(code codes.Code, reason, msg string, details ...protoadapt.MessageV1) error {
+	st := status.New(code, msg)
+	details = append([]protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: reason, Domain: Domain}}, details...)
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// InvalidField returns an INVALID_ARGUMENT error with a field violation.
+func InvalidField(field, description string) error {
+	return New(codes.InvalidArgument, ReasonInvalidArgument, description, &errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: field, Description: description}},
+	})
+}
+
+// NotFound returns a NOT_FOUND error.
+func NotFound(msg string) error {
+	return New(codes.NotFound, ReasonNotFound, msg)
+}
+
+// FromError converts errors that are not status errors: missing rows become NOT_FOUND,
+// context errors keep their code and anything else becomes a generic INTERNAL error,
+// so database and crypto errors don't leak to clients.
+func FromError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return NotFound("not found")
+	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+		return status.FromContextError(err).Err()
+	default:
+		return New(codes.Internal, ReasonInternal, "internal error")
+	}
+}
@@ -0,0 +1,114 @@
+package apierror
+
+import (
+	"golang.org/x/text/language"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// catalog translates user facing error messages, keyed by their English text.
+var catalog = map[language.Tag]map[string]string{
+	language.Russian: {
+		"not found":                                         "не найдено",
+		"internal error":                                    "внутренняя ошибка сервера",
+		"missing access token":                              "требуется вход в систему",
+		"authorization is not a bearer token":               "неверный формат авторизации",
+		"invalid access token":                              "сессия недействительна, войдите снова",
+		"access token was revoked":                          "сессия была отозвана, войдите снова",
+		"device was revoked":                                "устройство было отключено, войдите снова",
+		"access from this address is not allowed":           "доступ с этого адреса запрещён",
+		"rate limit exceeded":                               "слишком много запросов, повторите позже",
+		"server is under maintenance":                       "на сервере идут технические работы",
+		"idempotency key was used for a different request":  "ключ идемпотентности уже использован для другого запроса",
+		"idempotency key is too long":                       "слишком длинный ключ идемпотентности",
+		"item was changed since the expected revision":      "запись была изменена, обновите её и повторите",
+		"If-Match must be a single entity tag from an ETag": "If-Match должен содержать один тег из ETag",
+		"malformed secret link":                             "некорректная секретная ссылка",
+		"secret link does not exist or has expired":         "секретная ссылка не существует или истекла",
+		"wrapped recovery key is too large":                 "зашифрованный ключ восстановления слишком большой",
+		"not the code of the current recovery kit":          "это не код текущего набора восстановления",
+		"invalid login or recovery code":                    "неверный логин или код восстановления",
+		"registration is closed":                            "регистрация закрыта",
+		"invalid invite code":                               "неверный код приглашения",
+		"invalid or expired challenge":                      "задача недействительна или истекла, запросите новую",
+		"challenge solution does not have enough zero bits": "неверное решение задачи",
+		"CAPTCHA verification failed":                       "проверка CAPTCHA не пройдена",
+		"too many accounts created from this address":       "с этого адреса создано слишком много учётных записей",
+		"login must be 1 to 64 characters without spaces":   "логин должен содержать от 1 до 64 символов без пробелов",
+		"password must be 8 to 1024 characters":             "пароль должен содержать от 8 до 1024 символов",
+		"login is already taken":                            "этот логин уже занят",
+		"emergency access was not granted":                  "экстренный доступ не был предоставлен",
+		"invalid login or password":                         "неверный логин или пароль",
+		"current password is wrong":                         "текущий пароль указан неверно",
+		"malformed item id":                                 "некорректный идентификатор записи",
+		"malformed vault id":                                "некорректный идентификатор хранилища",
+		"malformed login URL":                               "некорректный адрес сайта",
+		"item is shared read-only":                          "запись доступна только для чтения",
+		"only the owner of the item can do this":            "это может сделать только владелец записи",
+		"tenant storage quota exceeded":                     "превышена квота хранилища организации",
+		"item or vault does not exist":                      "запись или хранилище не существует",
+		"vault name must be 1 to 64 characters":             "название хранилища должно содержать от 1 до 64 символов",
+		"vault with this name already exists":               "хранилище с таким названием уже существует",
+		"vault is not empty":                                "хранилище не пустое",
+		"malformed device id":                               "некорректный идентификатор устройства",
+		"device does not exist":                             "устройство не существует",
+		"either item_id or text is required":                "укажите item_id или text",
+		"secret links hold at most 64 KiB of text":          "секретная ссылка может содержать не более 64 КиБ текста",
+		"secret links must expire in 1 second to 30 days":   "срок действия секретной ссылки должен быть от 1 секунды до 30 дней",
+		"item does not exist":                               "запись не существует",
+		"a trusted contact must be another user with a wait of at least 0 seconds": "доверенным контактом может быть только другой пользователь с неотрицательным ожиданием",
+		"user is already a trusted contact":                                        "пользователь уже является доверенным контактом",
+		"user does not exist":                                                      "пользователь не существует",
+		"malformed trusted contact id":                                             "некорректный идентификатор доверенного контакта",
+		"trusted contact does not exist or does not allow this now":                "доверенный контакт не существует или сейчас это действие недоступно",
+		"items can be shared with other users until a future time":                 "записью можно поделиться только с другим пользователем и до момента в будущем",
+		"item or user does not exist":                                              "запись или пользователь не существует",
+		"malformed share id":                                                       "некорректный идентификатор доступа",
+		"share does not exist":                                                     "доступ не существует",
+		"malformed page URL":                                                       "некорректный адрес страницы",
+		"malformed host":                                                           "некорректное имя сайта",
+		"favicon does not exist":                                                   "значок сайта не найден",
+		"read mask names an unknown field":                                         "маска чтения содержит неизвестное поле",
+		"SSID must be 1 to 32 bytes":                                               "SSID должен занимать от 1 до 32 байт",
+		"unknown WiFi security type":                                               "неизвестный тип защиты WiFi",
+		"WPA passwords must be 8 to 63 characters, open networks have none":        "пароль WPA должен содержать от 8 до 63 символов, у открытых сетей пароля нет",
+		"seed phrase name must be 1 to 64 characters":                              "название сид-фразы должно содержать от 1 до 64 символов",
+		"seed phrases must have 12 or 24 lowercase words":                          "сид-фраза должна состоять из 12 или 24 слов в нижнем регистре",
+		"unknown item type":                                                        "неизвестный тип записи",
+		"rotation must be 0 to 3650 days":                                          "период смены пароля должен быть от 0 до 3650 дней",
+		"item order must list up to 10000 distinct items":                          "порядок может включать до 10000 разных записей",
+		"vault does not exist":                                                     "хранилище не существует",
+	},
+}
+
+// supported are the languages of the messages, English first as the default.
+var supported = []language.Tag{language.English, language.Russian}
+
+var matcher = language.NewMatcher(supported)
+
+// Localize adds a google.rpc.LocalizedMessage in the best language of the Accept-Language
+// header value to a status error whose message is in the catalog.
+func Localize(err error, acceptLanguage string) error {
+	st, ok := status.FromError(err)
+	if !ok || acceptLanguage == "" {
+		return err
+	}
+	tags, _, parseErr := language.ParseAcceptLanguage(acceptLanguage)
+	if parseErr != nil || len(tags) == 0 {
+		return err
+	}
+	_, i, confidence := matcher.Match(tags...)
+	if confidence == language.No {
+		return err
+	}
+	tag := supported[i]
+	msg, ok := catalog[tag][st.Message()]
+	if !ok {
+		return err
+	}
+	localized, detailsErr := st.WithDetails(&errdetails.LocalizedMessage{Locale: tag.String(), Message: msg})
+	if detailsErr != nil {
+		return err
+	}
+	return localized.Err()
+}
@@ -0,0 +1,143 @@
+// Package auth issues and validates the access tokens of users.
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// DefaultTokenTTL is how long an access token is valid unless configured otherwise.
+const DefaultTokenTTL = 24 * time.Hour
+
+var (
+	ErrBadToken = errors.New("invalid access token")
+	// ErrDeviceRevoked is returned for the tokens of a device the user revoked.
+	ErrDeviceRevoked = errors.New("device is revoked")
+)
+
+// Tokens are the settings of the access tokens of a deployment.
+type Tokens struct {
+	// Secret signs the tokens.
+	Secret string
+	// TTL is how long a token is valid, DefaultTokenTTL when zero.
+	TTL time.Duration
+	// Issuer and Audience are set in the claims of new tokens and required from
+	// validated ones when not empty, so tokens of other deployments are rejected.
+	Issuer   string
+	Audience string
+	// ClockSkew is tolerated when checking the expiry and issue times of tokens.
+	ClockSkew time.Duration
+}
+
+// Claims are the claims of a valid access token.
+type Claims struct {
+	UserID uuid.UUID
+	// DeviceID is the device the token was issued to, its tokens are rejected once it is revoked.
+	DeviceID uuid.UUID
+	// Generation is the user's token generation when the token was issued, tokens of older
+	// generations are revoked.
+	Generation int64
+}
+
+// tokenClaims are the JWT claims of access tokens.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+
+	Generation int64  `json:"gen,omitempty"`
+	Device     string `json:"dev,omitempty"`
+}
+
+// TokenTTL returns how long new tokens are valid.
+func (t Tokens) TokenTTL() time.Duration {
+	if t.TTL <= 0 {
+		return DefaultTokenTTL
+	}
+	return t.TTL
+}
+
+// NewToken returns an access token of the user's device, of their current token generation.
+func NewToken(t Tokens, userID, deviceID uuid.UUID, generation int64) (string, error) {
+	now := time.Now()
+	claims := tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    t.Issuer,
+			Subject:   userID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(t.TokenTTL())),
+		},
+		Generation: generation,
+		Device:     deviceID.String(),
+	}
+	if t.Audience != "" {
+		claims.Audience = jwt.ClaimStrings{t.Audience}
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(t.Secret))
+}
+
+// ParseAndValidate checks the token's signature, expiry, issuer and audience and returns its claims.
+// Whether the token's generation or device is revoked is up to the caller.
+// Tokens without a device, issued before devices were tracked, are invalid.
+func ParseAndValidate(t Tokens, token string) (Claims, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithLeeway(t.ClockSkew),
+	}
+	if t.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(t.Issuer))
+	}
+	if t.Audience != "" {
+		opts = append(opts, jwt.WithAudience(t.Audience))
+	}
+	var claims tokenClaims
+	_, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		func(*jwt.Token) (any, error) { return []byte(t.Secret), nil },
+		opts...,
+	)
+	if err != nil {
+		return Claims{}, errors.Join(ErrBadToken, err)
+	}
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return Claims{}, errors.Join(ErrBadToken, err)
+	}
+	deviceID, err := uuid.Parse(claims.Device)
+	if err != nil {
+		return Claims{}, errors.Join(ErrBadToken, err)
+	}
+	return Claims{UserID: userID, DeviceID: deviceID, Generation: claims.Generation}, nil
+}
+
+type (
+	userIDKey   struct{}
+	deviceIDKey struct{}
+)
+
+// WithUserID returns a context carrying the authenticated user's ID.
+func WithUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserID returns the authenticated user's ID, false for calls exempt from authentication.
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uuid.UUID)
+	return userID, ok
+}
+
+// WithDeviceID returns a context carrying the device the caller's token was issued to.
+func WithDeviceID(ctx context.Context, deviceID uuid.UUID) context.Context {
+	return context.WithValue(ctx, deviceIDKey{}, deviceID)
+}
+
+// DeviceID returns the device the caller's token was issued to, false for calls exempt from authentication.
+func DeviceID(ctx context.Context) (uuid.UUID, bool) {
+	deviceID, ok := ctx.Value(deviceIDKey{}).(uuid.UUID)
+	return deviceID, ok
+}
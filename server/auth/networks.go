@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// MaxAllowedNetworks bounds the network allowlist of an account.
+const MaxAllowedNetworks = 100
+
+var ErrBadNetwork = errors.New("invalid network")
+
+// ParseNetworks parses networks in CIDR notation, or single addresses.
+func ParseNetworks(networks []string) ([]netip.Prefix, error) {
+	if len(networks) > MaxAllowedNetworks {
+		return nil, fmt.Errorf("%w: more than %d networks", ErrBadNetwork, MaxAllowedNetworks)
+	}
+	prefixes := make([]netip.Prefix, 0, len(networks))
+	for _, n := range networks {
+		p, err := netip.ParsePrefix(n)
+		if err != nil {
+			addr, addrErr := netip.ParseAddr(n)
+			if addrErr != nil {
+				return nil, fmt.Errorf("%w: %q", ErrBadNetwork, n)
+			}
+			p = netip.PrefixFrom(addr, addr.BitLen())
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// AddressAllowed reports whether the host is an address in one of the networks.
+// Any host is allowed if there are no networks, and hosts that are not IP addresses,
+// like unix socket clients, are allowed by none.
+func AddressAllowed(networks []netip.Prefix, host string) bool {
+	if len(networks) == 0 {
+		return true
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap().WithZone("")
+	for _, n := range networks {
+		if n.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
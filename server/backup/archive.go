@@ -0,0 +1,194 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// An archive is the magic string, the key salt, the nonce and then
+// a gzipped tar of manifest.json and one <table>.csv per table, sealed with AES-256-GCM.
+// The key is derived from the backup passphrase with PBKDF2.
+const (
+	magic         = "GKBACKUP1"
+	saltSize      = 16
+	keySize       = 32
+	keyIterations = 600_000
+	manifestName  = "manifest.json"
+)
+
+var (
+	ErrBadArchive      = errors.New("bad backup archive")
+	ErrWrongPassphrase = errors.New("wrong backup passphrase or corrupted archive")
+)
+
+// Manifest describes the content of an archive.
+type Manifest struct {
+	SchemaVersion int64     `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Tables        []string  `json:"tables"`
+}
+
+// Seal packs the table dumps into an archive encrypted with passphrase.
+// The manifest lists the tables in the order given.
+func Seal(passphrase string, m Manifest, tables []models.TableDump) ([]byte, error) {
+	m.Tables = make([]string, 0, len(tables))
+	for _, t := range tables {
+		m.Tables = append(m.Tables, t.Name)
+	}
+
+	var plain bytes.Buffer
+	gz := gzip.NewWriter(&plain)
+	tw := tar.NewWriter(gz)
+
+	manifest, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+	err = writeFile(tw, manifestName, manifest, m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range tables {
+		err = writeFile(tw, t.Name+".csv", t.Data, m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = tw.Close()
+	if err != nil {
+		return nil, err
+	}
+	err = gz.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, saltSize)
+	_, err = rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(magic)+len(salt)+len(nonce)+plain.Len()+gcm.Overhead())
+	out = append(out, magic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+	// The header is authenticated too, so it can not be swapped between archives.
+	return gcm.Seal(out, nonce, plain.Bytes(), out), nil
+}
+
+// Open decrypts an archive made by Seal and returns its manifest and table dumps.
+func Open(passphrase string, data []byte) (Manifest, []models.TableDump, error) {
+	if len(data) < len(magic)+saltSize || string(data[:len(magic)]) != magic {
+		return Manifest{}, nil, ErrBadArchive
+	}
+	salt := data[len(magic) : len(magic)+saltSize]
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return Manifest{}, nil, err
+	}
+	headerSize := len(magic) + saltSize + gcm.NonceSize()
+	if len(data) < headerSize {
+		return Manifest{}, nil, ErrBadArchive
+	}
+	nonce := data[len(magic)+saltSize : headerSize]
+	plain, err := gcm.Open(nil, nonce, data[headerSize:], data[:headerSize])
+	if err != nil {
+		return Manifest{}, nil, ErrWrongPassphrase
+	}
+
+	m, files, err := readTar(plain)
+	if err != nil {
+		return Manifest{}, nil, fmt.Errorf("%w: %w", ErrBadArchive, err)
+	}
+	tables := make([]models.TableDump, 0, len(m.Tables))
+	for _, name := range m.Tables {
+		content, ok := files[name]
+		if !ok {
+			return Manifest{}, nil, fmt.Errorf("%w: table %s is missing", ErrBadArchive, name)
+		}
+		tables = append(tables, models.TableDump{Name: name, Data: content})
+	}
+	return m, tables, nil
+}
+
+// readTar unpacks the gzipped tar of an archive into the manifest and the table files by table name.
+func readTar(plain []byte) (Manifest, map[string][]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(plain))
+	if err != nil {
+		return Manifest{}, nil, err
+	}
+	var m Manifest
+	files := make(map[string][]byte)
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return m, files, nil
+		}
+		if err != nil {
+			return Manifest{}, nil, err
+		}
+		content, err := io.ReadAll(tr)
+		if err != nil {
+			return Manifest{}, nil, err
+		}
+		if h.Name == manifestName {
+			err = json.Unmarshal(content, &m)
+			if err != nil {
+				return Manifest{}, nil, err
+			}
+			continue
+		}
+		files[strings.TrimSuffix(h.Name, ".csv")] = content
+	}
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte, modTime time.Time) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name:    name,
+		Mode:    0o600,
+		Size:    int64(len(data)),
+		ModTime: modTime,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(data)
+	return err
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, passphrase, salt, keyIterations, keySize)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
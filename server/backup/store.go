@@ -0,0 +1,157 @@
+package backup
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+const (
+	namePrefix = "gophkeeper-"
+	nameSuffix = ".gkb"
+	nameLayout = "20060102T150405Z"
+)
+
+// Store keeps backup archives. Names sort in creation order.
+type Store interface {
+	Put(ctx context.Context, name string, data []byte) error
+	Get(ctx context.Context, name string) ([]byte, error)
+	List(ctx context.Context) ([]string, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// NewStore returns a store for target, which is either a local directory
+// or s3://bucket/prefix. S3 credentials are read from the standard AWS environment variables.
+func NewStore(target, s3Endpoint string) (Store, error) {
+	location, ok := strings.CutPrefix(target, "s3://")
+	if !ok {
+		return &Dir{path: target}, nil
+	}
+	bucket, prefix, _ := strings.Cut(location, "/")
+	if bucket == "" {
+		return nil, fmt.Errorf("bad backup target %q: no bucket", target)
+	}
+	endpoint, insecure := strings.CutPrefix(s3Endpoint, "http://")
+	endpoint = strings.TrimPrefix(endpoint, "https://")
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewEnvAWS(),
+		Secure: !insecure,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if prefix != "" {
+		prefix = strings.TrimSuffix(prefix, "/") + "/"
+	}
+	return &S3{client: client, bucket: bucket, prefix: prefix}, nil
+}
+
+// Name returns the archive name for the backup described by m.
+func Name(m Manifest) string {
+	return namePrefix + m.CreatedAt.UTC().Format(nameLayout) + nameSuffix
+}
+
+func isArchive(name string) bool {
+	return strings.HasPrefix(name, namePrefix) && strings.HasSuffix(name, nameSuffix)
+}
+
+// Dir stores archives in a local directory.
+type Dir struct {
+	path string
+}
+
+func (d *Dir) Put(_ context.Context, name string, data []byte) error {
+	err := os.MkdirAll(d.path, 0o700)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so a crash never leaves a truncated archive.
+	tmp := filepath.Join(d.path, "."+name+".tmp")
+	err = os.WriteFile(tmp, data, 0o600)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(d.path, name))
+}
+
+func (d *Dir) Get(_ context.Context, name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(d.path, filepath.Base(name)))
+}
+
+func (d *Dir) List(_ context.Context) ([]string, error) {
+	entries, err := os.ReadDir(d.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.Type().IsRegular() && isArchive(e.Name()) {
+			names = append(names, e.Name())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (d *Dir) Delete(_ context.Context, name string) error {
+	return os.Remove(filepath.Join(d.path, filepath.Base(name)))
+}
+
+// S3 stores archives in an S3 compatible bucket.
+type S3 struct {
+	client *minio.Client
+	bucket string
+	prefix string
+}
+
+func (s *S3) Put(ctx context.Context, name string, data []byte) error {
+	_, err := s.client.PutObject(
+		ctx,
+		s.bucket,
+		s.prefix+name,
+		bytes.NewReader(data),
+		int64(len(data)),
+		minio.PutObjectOptions{ContentType: "application/octet-stream"},
+	)
+	return err
+}
+
+func (s *S3) Get(ctx context.Context, name string) ([]byte, error) {
+	obj, err := s.client.GetObject(ctx, s.bucket, s.prefix+name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Close()
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(obj)
+	return buf.Bytes(), err
+}
+
+func (s *S3) List(ctx context.Context) ([]string, error) {
+	var names []string
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: s.prefix}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		name := strings.TrimPrefix(obj.Key, s.prefix)
+		if isArchive(name) {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func (s *S3) Delete(ctx context.Context, name string) error {
+	return s.client.RemoveObject(ctx, s.bucket, s.prefix+name, minio.RemoveObjectOptions{})
+}
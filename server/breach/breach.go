@@ -0,0 +1,61 @@
+package breach
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultURL = "https://api.pwnedpasswords.com"
+
+// Client checks passwords against the Have I Been Pwned range API.
+// Only the first 5 hex characters of the password SHA-1 hash leave the server.
+type Client struct {
+	baseURL string
+	http    *http.Client
+}
+
+func NewClient(baseURL string) *Client {
+	return &Client{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		http:    &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Count returns how many times the password appears in known breaches.
+func (c *Client) Count(ctx context.Context, password string) (int64, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/range/"+prefix, nil)
+	if err != nil {
+		return 0, err
+	}
+	// Padding hides the real number of matching suffixes from observers.
+	req.Header.Set("Add-Padding", "true")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("breach range request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("breach range request failed: %s", resp.Status)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		s, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if !ok || s != suffix {
+			continue
+		}
+		return strconv.ParseInt(count, 10, 64)
+	}
+	return 0, scanner.Err()
+}
@@ -0,0 +1,11 @@
+package buildinfo
+
+// APIVersion is the version of the public API served by this build.
+const APIVersion = "v1"
+
+// Set at build time via -ldflags "-X github.com/cmrd-a/GophKeeper/server/buildinfo.Version=...".
+var (
+	Version   = "N/A"
+	Commit    = "N/A"
+	BuildDate = "N/A"
+)
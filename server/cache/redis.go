@@ -0,0 +1,43 @@
+// Package cache stores cached API responses outside of the server process,
+// so every server instance shares them.
+package cache
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis stores values in Redis.
+type Redis struct {
+	client *redis.Client
+}
+
+// NewRedis connects to the Redis server at a redis:// or rediss:// URL.
+func NewRedis(url string) (*Redis, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &Redis{client: redis.NewClient(opts)}, nil
+}
+
+// Get returns the value of the key, nil if it is missing.
+func (r *Redis) Get(ctx context.Context, key string) ([]byte, error) {
+	b, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return b, err
+}
+
+// Set stores the value of the key for ttl.
+func (r *Redis) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (r *Redis) Close() error {
+	return r.client.Close()
+}
@@ -2,36 +2,194 @@ package config
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/spf13/viper"
 
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/breach"
 	"github.com/cmrd-a/GophKeeper/server/logger"
 )
 
+// maxChallengeBits keeps registration challenges solvable in a reasonable time.
+const maxChallengeBits = 32
+
 type Config struct {
-	LogLevel    string `mapstructure:"LOG_LEVEL"`
-	GRPCPort    int16  `mapstructure:"GRPC_PORT"`
-	HTTPPort    int16  `mapstructure:"HTTP_PORT"`
-	DatabaseURI string `mapstructure:"DATABASE_URI"`
-	SaltSecret  string `mapstructure:"SALT_SECRET"`
-	JWTSecret   string `mapstructure:"JWT_SECRET"`
+	LogLevel string `mapstructure:"LOG_LEVEL"`
+	// LogFormat is text or json.
+	LogFormat string `mapstructure:"LOG_FORMAT"`
+	// LogFile is written instead of stdout when set, rotated when it grows past
+	// LogMaxSize bytes or gets older than LogRotateEvery, keeping LogKeep old files.
+	LogFile        string        `mapstructure:"LOG_FILE"`
+	LogMaxSize     int64         `mapstructure:"LOG_MAX_SIZE"`
+	LogRotateEvery time.Duration `mapstructure:"LOG_ROTATE_EVERY"`
+	LogKeep        int           `mapstructure:"LOG_KEEP"`
+	// AccessLog logs every gRPC call, to AccessLogFile if set or with the other logs.
+	AccessLog     bool   `mapstructure:"ACCESS_LOG"`
+	AccessLogFile string `mapstructure:"ACCESS_LOG_FILE"`
+	GRPCPort      int16  `mapstructure:"GRPC_PORT"`
+	HTTPPort      int16  `mapstructure:"HTTP_PORT"`
+	GRPCSocket    string `mapstructure:"GRPC_SOCKET"`
+	HTTPSocket    string `mapstructure:"HTTP_SOCKET"`
+	// GRPCReflection registers the gRPC reflection service, see Help.
+	GRPCReflection bool `mapstructure:"GRPC_REFLECTION"`
+	// GRPCWeb serves gRPC-Web on the HTTP port, for requests from the same origin or GRPCWebOrigins.
+	GRPCWeb        bool     `mapstructure:"GRPC_WEB"`
+	GRPCWebOrigins []string `mapstructure:"GRPC_WEB_ORIGINS"`
+	// AdminSocket is the unix socket serving AdminService, which has no other authentication.
+	AdminSocket string `mapstructure:"ADMIN_SOCKET"`
+	// AdminAddr is the TCP address serving AdminService to clients with a certificate
+	// signed by AdminClientCA, so it can be firewalled apart from the public ports.
+	AdminAddr     string `mapstructure:"ADMIN_ADDR"`
+	AdminTLSCert  string `mapstructure:"ADMIN_TLS_CERT"`
+	AdminTLSKey   string `mapstructure:"ADMIN_TLS_KEY"`
+	AdminClientCA string `mapstructure:"ADMIN_CLIENT_CA"`
+	// MetricsAddr is the TCP address serving the expvar metrics at /debug/vars, unauthenticated,
+	// so it should only be reachable by monitoring.
+	MetricsAddr string `mapstructure:"METRICS_ADDR"`
+	// LogGRPCPayloads logs gRPC requests and responses at debug level.
+	LogGRPCPayloads bool `mapstructure:"LOG_GRPC_PAYLOADS"`
+	// LogPayloadSample logs every LogPayloadSample-th call, 0 logs only slow calls.
+	LogPayloadSample uint64 `mapstructure:"LOG_PAYLOAD_SAMPLE"`
+	// LogSlowCalls logs the payloads of calls slower than it, 0 disables it.
+	LogSlowCalls time.Duration `mapstructure:"LOG_SLOW_CALLS"`
+	// LogAsync writes logs in the background, dropping records when the output can't keep up.
+	LogAsync bool `mapstructure:"LOG_ASYNC"`
+	// LogRedactFields are the proto field names masked in logged payloads.
+	LogRedactFields []string `mapstructure:"LOG_REDACT_FIELDS"`
+	// Maintenance rejects calls with MaintenanceMessage, like the SetMaintenance admin call.
+	Maintenance        bool   `mapstructure:"MAINTENANCE"`
+	MaintenanceMessage string `mapstructure:"MAINTENANCE_MESSAGE"`
+	// RateLimit is the calls per second allowed per client host, 0 disables it.
+	// Calls through the HTTP gateway all come from the gateway's host.
+	RateLimit float64 `mapstructure:"RATE_LIMIT"`
+	RateBurst int     `mapstructure:"RATE_BURST"`
+	// InterceptorPolicy enables or disables interceptors per method, like
+	// "payload_log:-/v1.user.UserService/,rate_limit:-/v1.info.InfoService/".
+	InterceptorPolicy string `mapstructure:"INTERCEPTOR_POLICY"`
+	// AuthExemptMethods can be called without an access token. Entries are full method
+	// names, or service prefixes ending with "/", and must match registered services.
+	AuthExemptMethods []string `mapstructure:"AUTH_EXEMPT_METHODS"`
+	// IdempotencyWindow is how long the results of Save calls are replayed to retries with the same idempotency-key.
+	IdempotencyWindow time.Duration `mapstructure:"IDEMPOTENCY_WINDOW"`
+	// RedisURL enables caching read-only vault responses in Redis for CacheTTL.
+	RedisURL    string        `mapstructure:"REDIS_URL"`
+	CacheTTL    time.Duration `mapstructure:"CACHE_TTL"`
+	DatabaseURI string        `mapstructure:"DATABASE_URI"`
+	DBWait      time.Duration `mapstructure:"DB_WAIT"`
+	// DBDialect is postgres or cockroachdb.
+	DBDialect string `mapstructure:"DB_DIALECT"`
+	// DBBootstrap creates the schema at startup when the database has no tables.
+	DBBootstrap bool `mapstructure:"DB_BOOTSTRAP"`
+	// The DB pool settings keep the pgx defaults when zero.
+	DBMaxConns          int32         `mapstructure:"DB_MAX_CONNS"`
+	DBMinConns          int32         `mapstructure:"DB_MIN_CONNS"`
+	DBMaxConnLifetime   time.Duration `mapstructure:"DB_MAX_CONN_LIFETIME"`
+	DBMaxConnIdleTime   time.Duration `mapstructure:"DB_MAX_CONN_IDLE_TIME"`
+	DBHealthCheckPeriod time.Duration `mapstructure:"DB_HEALTH_CHECK_PERIOD"`
+	SaltSecret          string        `mapstructure:"SALT_SECRET"`
+	JWTSecret           string        `mapstructure:"JWT_SECRET"`
+	// JWTTTL is how long access tokens are valid.
+	JWTTTL time.Duration `mapstructure:"JWT_TTL"`
+	// JWTIssuer and JWTAudience are required in access tokens when set,
+	// so that tokens issued by other deployments are rejected.
+	JWTIssuer   string `mapstructure:"JWT_ISSUER"`
+	JWTAudience string `mapstructure:"JWT_AUDIENCE"`
+	// JWTClockSkew is tolerated when checking when access tokens were issued and expire.
+	JWTClockSkew time.Duration `mapstructure:"JWT_CLOCK_SKEW"`
+	// RegistrationOpen lets anyone register, otherwise registering requires one of RegistrationInviteCodes.
+	RegistrationOpen        bool     `mapstructure:"REGISTRATION_OPEN"`
+	RegistrationInviteCodes []string `mapstructure:"REGISTRATION_INVITE_CODES"`
+	// RegistrationLimit is the accounts each client host may create per RegistrationLimitWindow, 0 disables it.
+	// Registrations through the HTTP gateway all come from the gateway's host.
+	RegistrationLimit       int           `mapstructure:"REGISTRATION_LIMIT"`
+	RegistrationLimitWindow time.Duration `mapstructure:"REGISTRATION_LIMIT_WINDOW"`
+	// RegistrationChallengeBits is the difficulty of the proof of work required to register, 0 disables it.
+	RegistrationChallengeBits int `mapstructure:"REGISTRATION_CHALLENGE_BITS"`
+	// RegistrationCaptchaURL is the siteverify URL of the CAPTCHA provider, which enables CAPTCHAs when set.
+	RegistrationCaptchaURL    string `mapstructure:"REGISTRATION_CAPTCHA_URL"`
+	RegistrationCaptchaSecret string `mapstructure:"REGISTRATION_CAPTCHA_SECRET"`
+	// BreachCheck enables checking passwords against Have I Been Pwned.
+	BreachCheck bool   `mapstructure:"BREACH_CHECK"`
+	BreachURL   string `mapstructure:"BREACH_URL"`
+	// FaviconFetch enables fetching icons of login URL sites.
+	FaviconFetch bool `mapstructure:"FAVICON_FETCH"`
+	// Telemetry reports anonymous usage counters to TelemetryURL every TelemetryInterval. It is off by default.
+	Telemetry         bool          `mapstructure:"TELEMETRY"`
+	TelemetryURL      string        `mapstructure:"TELEMETRY_URL"`
+	TelemetryInterval time.Duration `mapstructure:"TELEMETRY_INTERVAL"`
+	// BackupInterval is how often backups are made, 0 disables them.
+	BackupInterval   time.Duration `mapstructure:"BACKUP_INTERVAL"`
+	BackupTarget     string        `mapstructure:"BACKUP_TARGET"`
+	BackupKeep       int           `mapstructure:"BACKUP_KEEP"`
+	BackupPassphrase string        `mapstructure:"BACKUP_PASSPHRASE"`
+	BackupS3Endpoint string        `mapstructure:"BACKUP_S3_ENDPOINT"`
 }
 
 func NewConfig(log *slog.Logger, lvl *slog.LevelVar) (*Config, error) {
-	viper.SetDefault("LOG_LEVEL", "DEBUG")
-	viper.SetDefault("GRPC_PORT", "8082")
-	viper.SetDefault("HTTP_PORT", "8080")
+	setDefaults(viper.GetViper())
+	config, err := read(log, viper.GetViper())
+	if err != nil {
+		return nil, err
+	}
+	newLvl := logger.GetLogLevelFromEnv(config.LogLevel)
+	lvl.Set(newLvl)
+
+	log.Info("Configuration loaded",
+		"LogLevel", config.LogLevel,
+		"LogFormat", config.LogFormat,
+		"LogFile", config.LogFile,
+		"AccessLog", config.AccessLog,
+		"AccessLogFile", config.AccessLogFile,
+		"HTTPPort", config.HTTPPort,
+		"GRPCSocket", config.GRPCSocket,
+		"HTTPSocket", config.HTTPSocket,
+		"GRPCReflection", config.GRPCReflection,
+		"GRPCWeb", config.GRPCWeb,
+		"GRPCWebOrigins", config.GRPCWebOrigins,
+		"AdminSocket", config.AdminSocket,
+		"AdminAddr", config.AdminAddr,
+		"LogGRPCPayloads", config.LogGRPCPayloads,
+		"RateLimit", config.RateLimit,
+		"InterceptorPolicy", config.InterceptorPolicy,
+		"AuthExemptMethods", config.AuthExemptMethods,
+		"CacheTTL", config.CacheTTL,
+		"DatabaseURI", redactDSN(config.DatabaseURI),
+		"DBDialect", config.DBDialect,
+		"DBMaxConns", config.DBMaxConns,
+		"DBMinConns", config.DBMinConns,
+		"JWTTTL", config.JWTTTL,
+		"JWTIssuer", config.JWTIssuer,
+		"JWTAudience", config.JWTAudience,
+		"RegistrationOpen", config.RegistrationOpen,
+		"RegistrationLimit", config.RegistrationLimit,
+		"RegistrationChallengeBits", config.RegistrationChallengeBits,
+		"RegistrationCaptchaURL", config.RegistrationCaptchaURL,
+		"BackupInterval", config.BackupInterval,
+		"BackupTarget", config.BackupTarget,
+		"Telemetry", config.Telemetry,
+		"TelemetryURL", config.TelemetryURL,
+	)
+	return config, nil
+}
 
-	viper.SetDefault("SALT_SECRET", "changeme")
-	viper.SetDefault("JWT_SECRET", "changeme")
+// Reload reads the configuration again, for the server to apply what changed.
+func Reload(log *slog.Logger) (*Config, error) {
+	v := viper.New()
+	setDefaults(v)
+	return read(log, v)
+}
 
-	viper.SetConfigName(".env")
-	viper.SetConfigType("env")
-	viper.AddConfigPath("../../.")
-	viper.AutomaticEnv()
+// read reads the configuration from the environment and the .env file into v.
+func read(log *slog.Logger, v *viper.Viper) (*Config, error) {
+	v.SetConfigName(".env")
+	v.SetConfigType("env")
+	v.AddConfigPath("../../.")
+	v.AutomaticEnv()
 
-	if err := viper.ReadInConfig(); err != nil {
+	if err := v.ReadInConfig(); err != nil {
 		var configFileNotFoundError viper.ConfigFileNotFoundError
 		if errors.As(err, &configFileNotFoundError) {
 			log.Info("No .env file found, relying on environment variables.")
@@ -40,19 +198,168 @@ func NewConfig(log *slog.Logger, lvl *slog.LevelVar) (*Config, error) {
 			return nil, err
 		}
 	}
+	if err := loadSecretFiles(log, v); err != nil {
+		log.Error("Unable to read secret file", "error", err)
+		return nil, err
+	}
 	config := Config{}
 
-	if err := viper.Unmarshal(&config); err != nil {
+	if err := v.Unmarshal(&config); err != nil {
 		log.Error("Unable to decode config into struct", "error", err)
 		return nil, err
 	}
-	newLvl := logger.GetLogLevelFromEnv(config.LogLevel)
-	lvl.Set(newLvl)
+	if err := config.validate(); err != nil {
+		log.Error("Invalid configuration", "error", err)
+		return nil, err
+	}
+	return &config, nil
+}
 
-	log.Info("Configuration loaded",
-		"LogLevel", config.LogLevel,
-		"HTTPPort", config.HTTPPort,
-		"DatabaseURI", config.DatabaseURI,
-	)
+// Default returns the configuration with every default, ignoring the environment and the .env file.
+func Default() (*Config, error) {
+	v := viper.New()
+	setDefaults(v)
+	config := Config{}
+	err := v.Unmarshal(&config)
+	if err != nil {
+		return nil, err
+	}
 	return &config, nil
 }
+
+// Tokens returns the settings of access tokens.
+func (c *Config) Tokens() auth.Tokens {
+	return auth.Tokens{
+		Secret:    c.JWTSecret,
+		TTL:       c.JWTTTL,
+		Issuer:    c.JWTIssuer,
+		Audience:  c.JWTAudience,
+		ClockSkew: c.JWTClockSkew,
+	}
+}
+
+func (c *Config) validate() error {
+	if c.JWTTTL <= 0 {
+		return errors.New("JWT_TTL must be positive")
+	}
+	if c.JWTClockSkew < 0 {
+		return errors.New("JWT_CLOCK_SKEW must not be negative")
+	}
+	if c.RegistrationLimit > 0 && c.RegistrationLimitWindow <= 0 {
+		return errors.New("REGISTRATION_LIMIT_WINDOW must be positive")
+	}
+	if c.RegistrationChallengeBits < 0 || c.RegistrationChallengeBits > maxChallengeBits {
+		return fmt.Errorf("REGISTRATION_CHALLENGE_BITS must be between 0 and %d", maxChallengeBits)
+	}
+	if c.RegistrationCaptchaURL != "" && c.RegistrationCaptchaSecret == "" {
+		return errors.New("REGISTRATION_CAPTCHA_SECRET is required with REGISTRATION_CAPTCHA_URL")
+	}
+	if c.DBDialect != "postgres" && c.DBDialect != "cockroachdb" {
+		return errors.New("DB_DIALECT must be postgres or cockroachdb")
+	}
+	if c.DBDialect == "cockroachdb" && c.BackupInterval > 0 {
+		return errors.New("BACKUP_INTERVAL is not supported on cockroachdb, use its BACKUP statement")
+	}
+	if c.Telemetry && c.TelemetryURL == "" {
+		return errors.New("TELEMETRY_URL is required with TELEMETRY")
+	}
+	if c.Telemetry && c.TelemetryInterval <= 0 {
+		return errors.New("TELEMETRY_INTERVAL must be positive")
+	}
+	return nil
+}
+
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("LOG_LEVEL", "DEBUG")
+	v.SetDefault("LOG_FORMAT", "text")
+	v.SetDefault("LOG_FILE", "")
+	v.SetDefault("LOG_MAX_SIZE", 100<<20)
+	v.SetDefault("LOG_ROTATE_EVERY", "24h")
+	v.SetDefault("LOG_KEEP", 7)
+	v.SetDefault("ACCESS_LOG", false)
+	v.SetDefault("ACCESS_LOG_FILE", "")
+	v.SetDefault("GRPC_PORT", "8082")
+	v.SetDefault("HTTP_PORT", "8080")
+	v.SetDefault("GRPC_SOCKET", "")
+	v.SetDefault("HTTP_SOCKET", "")
+	v.SetDefault("GRPC_REFLECTION", false)
+	v.SetDefault("GRPC_WEB", false)
+	v.SetDefault("GRPC_WEB_ORIGINS", []string{})
+	v.SetDefault("ADMIN_SOCKET", "")
+	v.SetDefault("ADMIN_ADDR", "")
+	v.SetDefault("ADMIN_TLS_CERT", "")
+	v.SetDefault("ADMIN_TLS_KEY", "")
+	v.SetDefault("ADMIN_CLIENT_CA", "")
+	v.SetDefault("METRICS_ADDR", "")
+
+	v.SetDefault("LOG_GRPC_PAYLOADS", false)
+	v.SetDefault("LOG_PAYLOAD_SAMPLE", 1)
+	v.SetDefault("LOG_SLOW_CALLS", "0s")
+	v.SetDefault("LOG_ASYNC", false)
+	v.SetDefault("LOG_REDACT_FIELDS", []string{
+		"password", "text", "token", "ciphertext", "card_number", "cvv", "secret", "passphrase",
+	})
+	v.SetDefault("MAINTENANCE", false)
+	v.SetDefault("MAINTENANCE_MESSAGE", "")
+	v.SetDefault("RATE_LIMIT", 0)
+	v.SetDefault("RATE_BURST", 20)
+	v.SetDefault("INTERCEPTOR_POLICY", "")
+	v.SetDefault("AUTH_EXEMPT_METHODS", []string{
+		"/v1.user.UserService/Register",
+		"/v1.user.UserService/GetRegistrationChallenge",
+		"/v1.user.UserService/Login",
+		"/v1.user.UserService/RecoverAccount",
+		"/v1.info.InfoService/",
+		"/v1.send.SendService/RevealSecretLink",
+	})
+
+	v.SetDefault("IDEMPOTENCY_WINDOW", "1h")
+	v.SetDefault("REDIS_URL", "")
+	v.SetDefault("CACHE_TTL", "5m")
+
+	setDatabaseDefaults(v)
+	setAuthDefaults(v)
+
+	v.SetDefault("BREACH_CHECK", false)
+	v.SetDefault("BREACH_URL", breach.DefaultURL)
+	v.SetDefault("FAVICON_FETCH", false)
+	v.SetDefault("TELEMETRY", false)
+	v.SetDefault("TELEMETRY_URL", "")
+	v.SetDefault("TELEMETRY_INTERVAL", "24h")
+}
+
+// setDatabaseDefaults sets the defaults of the database and its backups.
+func setDatabaseDefaults(v *viper.Viper) {
+	v.SetDefault("DATABASE_URI", "")
+	v.SetDefault("DB_WAIT", "30s")
+	v.SetDefault("DB_DIALECT", "postgres")
+	v.SetDefault("DB_BOOTSTRAP", false)
+	v.SetDefault("DB_MAX_CONNS", 0)
+	v.SetDefault("DB_MIN_CONNS", 0)
+	v.SetDefault("DB_MAX_CONN_LIFETIME", "0s")
+	v.SetDefault("DB_MAX_CONN_IDLE_TIME", "0s")
+	v.SetDefault("DB_HEALTH_CHECK_PERIOD", "0s")
+
+	v.SetDefault("BACKUP_INTERVAL", "0s")
+	v.SetDefault("BACKUP_TARGET", "backups")
+	v.SetDefault("BACKUP_KEEP", 7)
+	v.SetDefault("BACKUP_PASSPHRASE", "")
+	v.SetDefault("BACKUP_S3_ENDPOINT", "s3.amazonaws.com")
+}
+
+func setAuthDefaults(v *viper.Viper) {
+	v.SetDefault("SALT_SECRET", "changeme")
+	v.SetDefault("JWT_SECRET", "changeme")
+	v.SetDefault("JWT_TTL", "24h")
+	v.SetDefault("JWT_ISSUER", "")
+	v.SetDefault("JWT_AUDIENCE", "")
+	v.SetDefault("JWT_CLOCK_SKEW", "0s")
+
+	v.SetDefault("REGISTRATION_OPEN", true)
+	v.SetDefault("REGISTRATION_INVITE_CODES", []string{})
+	v.SetDefault("REGISTRATION_LIMIT", 0)
+	v.SetDefault("REGISTRATION_LIMIT_WINDOW", "24h")
+	v.SetDefault("REGISTRATION_CHALLENGE_BITS", 0)
+	v.SetDefault("REGISTRATION_CAPTCHA_URL", "")
+	v.SetDefault("REGISTRATION_CAPTCHA_SECRET", "")
+}
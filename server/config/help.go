@@ -0,0 +1,127 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/viper"
+)
+
+// variable documents a configuration variable.
+type variable struct {
+	name string
+	help string
+}
+
+var variables = []variable{
+	{"LOG_LEVEL", "DEBUG, INFO, WARN or ERROR"},
+	{"LOG_FORMAT", "text or json"},
+	{"LOG_FILE", "log file, stdout when empty"},
+	{"LOG_MAX_SIZE", "bytes after which log files are rotated, 0 disables it"},
+	{"LOG_ROTATE_EVERY", "age after which log files are rotated, 0 disables it"},
+	{"LOG_KEEP", "number of rotated log files kept"},
+	{"ACCESS_LOG", "log every gRPC call at info level"},
+	{"ACCESS_LOG_FILE", "access log file, with the other logs when empty"},
+	{"GRPC_PORT", "port of the gRPC API"},
+	{"HTTP_PORT", "port of the HTTP gateway"},
+	{"GRPC_SOCKET", "unix socket of the gRPC API instead of the port"},
+	{"HTTP_SOCKET", "unix socket of the HTTP gateway instead of the port"},
+	{"GRPC_REFLECTION", "serve gRPC reflection without authentication; it lets anyone reaching the port " +
+		"list every service, method and message, so keep it off in production"},
+	{"GRPC_WEB", "serve gRPC-Web, including streams over WebSockets, on the HTTP port"},
+	{"GRPC_WEB_ORIGINS", "other origins allowed to call gRPC-Web, * allows any"},
+	{"ADMIN_SOCKET", "unix socket of the admin API, empty disables it"},
+	{"ADMIN_ADDR", "TCP address of the admin API, requiring client certificates; empty disables it"},
+	{"ADMIN_TLS_CERT", "certificate of the admin API"},
+	{"ADMIN_TLS_KEY", "private key of the admin API certificate"},
+	{"ADMIN_CLIENT_CA", "CA signing the client certificates allowed to call the admin API"},
+	{"METRICS_ADDR", "TCP address serving expvar metrics at /debug/vars without authentication; empty disables it"},
+	{"LOG_GRPC_PAYLOADS", "log gRPC requests and responses at debug level"},
+	{"LOG_PAYLOAD_SAMPLE", "log the payloads of every Nth call, 0 logs only slow calls"},
+	{"LOG_SLOW_CALLS", "log the payloads of calls slower than this, 0 disables it"},
+	{"LOG_ASYNC", "write logs in the background, dropping records when the output can't keep up"},
+	{"LOG_REDACT_FIELDS", "proto field names masked in logged payloads"},
+	{"MAINTENANCE", "reject calls other than InfoService ones, as under maintenance"},
+	{"MAINTENANCE_MESSAGE", "message of the calls rejected in maintenance"},
+	{"RATE_LIMIT", "calls per second allowed per client host, 0 disables it"},
+	{"RATE_BURST", "calls a client host can make at once"},
+	{"INTERCEPTOR_POLICY", "interceptors enabled per method, like payload_log:-/v1.user.UserService/"},
+	{"AUTH_EXEMPT_METHODS", "methods callable without an access token"},
+	{"IDEMPOTENCY_WINDOW", "how long Save results are replayed to retries with the same idempotency-key"},
+	{"REDIS_URL", "redis:// URL of the cache of read-only vault responses, empty disables it"},
+	{"CACHE_TTL", "how long cached responses are kept; items shared by others may be this stale"},
+	{"DATABASE_URI", "PostgreSQL connection string"},
+	{"DB_WAIT", "how long to wait for the database at startup"},
+	{"DB_DIALECT", "postgres, or cockroachdb for a distributed deployment; backups are postgres only"},
+	{"DB_BOOTSTRAP", "create the schema at startup if the database has no tables, instead of running goose"},
+	{"DB_MAX_CONNS", "most database connections, 0 uses the larger of 4 and the CPU count"},
+	{"DB_MIN_CONNS", "database connections kept open when idle"},
+	{"DB_MAX_CONN_LIFETIME", "age after which database connections are replaced, 0 uses 1h"},
+	{"DB_MAX_CONN_IDLE_TIME", "idle time after which database connections are closed, 0 uses 30m"},
+	{"DB_HEALTH_CHECK_PERIOD", "how often idle database connections are checked, 0 uses 1m"},
+	{"SALT_SECRET", "secret salting password hashes"},
+	{"JWT_SECRET", "secret signing access tokens"},
+	{"JWT_TTL", "how long access tokens are valid"},
+	{"JWT_ISSUER", "issuer of access tokens, required in tokens when set"},
+	{"JWT_AUDIENCE", "audience of access tokens, required in tokens when set"},
+	{"JWT_CLOCK_SKEW", "clock difference tolerated when checking access token times"},
+	{"REGISTRATION_OPEN", "let anyone register, otherwise an invite code is required"},
+	{"REGISTRATION_INVITE_CODES", "invite codes accepted when registration is not open"},
+	{"REGISTRATION_LIMIT", "accounts each client host may create per window, 0 disables it"},
+	{"REGISTRATION_LIMIT_WINDOW", "window of REGISTRATION_LIMIT"},
+	{"REGISTRATION_CHALLENGE_BITS", "difficulty of the proof of work required to register, 0 disables it"},
+	{"REGISTRATION_CAPTCHA_URL", "siteverify URL of the CAPTCHA provider, enables CAPTCHAs when set"},
+	{"REGISTRATION_CAPTCHA_SECRET", "site secret of the CAPTCHA provider"},
+	{"BREACH_CHECK", "check passwords against Have I Been Pwned"},
+	{"BREACH_URL", "URL of the Pwned Passwords API"},
+	{"FAVICON_FETCH", "fetch icons of login URL sites"},
+	{"TELEMETRY", "report anonymous usage counters: the version, bucketed user and item counts and the features " +
+		"enabled; off unless set"},
+	{"TELEMETRY_URL", "endpoint receiving telemetry reports as JSON"},
+	{"TELEMETRY_INTERVAL", "how often telemetry is reported"},
+	{"BACKUP_INTERVAL", "how often backups are made, 0 disables them"},
+	{"BACKUP_TARGET", "backup directory or s3://bucket/prefix"},
+	{"BACKUP_KEEP", "number of backups kept"},
+	{"BACKUP_PASSPHRASE", "passphrase encrypting backups"},
+	{"BACKUP_S3_ENDPOINT", "S3 endpoint of s3:// backup targets"},
+}
+
+// Help writes the configuration variables with their defaults.
+func Help(w io.Writer) error {
+	setDefaults(viper.GetViper())
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, err := fmt.Fprintln(tw, "VARIABLE\tDEFAULT\tDESCRIPTION")
+	if err != nil {
+		return err
+	}
+	for _, v := range variables {
+		def := viper.Get(v.name)
+		if list, ok := def.([]string); ok {
+			def = strings.Join(list, ",")
+		}
+		_, err = fmt.Fprintf(tw, "%s\t%v\t%s\n", v.name, def, v.help)
+		if err != nil {
+			return err
+		}
+	}
+	err = tw.Flush()
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(
+		w,
+		"\n%s can instead be read from a file, like a Docker secret, named by the variable with a _FILE suffix.\n",
+		strings.Join(secretVariables, ", "),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(
+		w,
+		"On SIGHUP the configuration is read again and changes of %s applied, the others need a restart.\n",
+		strings.Join(reloadable, ", "),
+	)
+	return err
+}
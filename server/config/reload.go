@@ -0,0 +1,51 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+)
+
+// reloadable are the variables the server applies when reloaded, the others need a restart.
+var reloadable = []string{
+	"LOG_LEVEL",
+	"LOG_GRPC_PAYLOADS",
+	"LOG_PAYLOAD_SAMPLE",
+	"LOG_SLOW_CALLS",
+	"LOG_REDACT_FIELDS",
+	"MAINTENANCE",
+	"MAINTENANCE_MESSAGE",
+	"RATE_LIMIT",
+	"RATE_BURST",
+}
+
+// Change is a variable whose value differs between two configurations.
+type Change struct {
+	Name     string
+	Old, New any
+	// Reloadable changes are applied by reloading the server, the others need a restart.
+	Reloadable bool
+}
+
+// Diff returns the variables changed from old to next, with the values of secrets hidden.
+func Diff(old, next *Config) []Change {
+	var changes []Change
+	o, n := reflect.ValueOf(*old), reflect.ValueOf(*next)
+	for i := range o.NumField() {
+		if reflect.DeepEqual(o.Field(i).Interface(), n.Field(i).Interface()) {
+			continue
+		}
+		name := o.Type().Field(i).Tag.Get("mapstructure")
+		change := Change{
+			Name:       name,
+			Old:        o.Field(i).Interface(),
+			New:        n.Field(i).Interface(),
+			Reloadable: slices.Contains(reloadable, name),
+		}
+		// Invite codes are secrets too, though not read from files.
+		if slices.Contains(secretVariables, name) || name == "REGISTRATION_INVITE_CODES" {
+			change.Old, change.New = "xxxxx", "xxxxx"
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
@@ -0,0 +1,88 @@
+package config
+
+import (
+	"fmt"
+	"log/slog"
+	"net/url"
+	"os"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// secretVariables can instead be read from the file named by the variable with a _FILE suffix,
+// like JWT_SECRET_FILE=/run/secrets/jwt, which takes precedence.
+var secretVariables = []string{
+	"DATABASE_URI",
+	"REDIS_URL",
+	"SALT_SECRET",
+	"JWT_SECRET",
+	"REGISTRATION_CAPTCHA_SECRET",
+	"BACKUP_PASSPHRASE",
+}
+
+// loadSecretFiles sets the secret variables whose _FILE variable is set from those files.
+func loadSecretFiles(log *slog.Logger, v *viper.Viper) error {
+	for _, name := range secretVariables {
+		path := v.GetString(name + "_FILE")
+		if path == "" {
+			continue
+		}
+		secret, err := readSecretFile(log, path)
+		if err != nil {
+			return fmt.Errorf("%s_FILE: %w", name, err)
+		}
+		v.Set(name, secret)
+	}
+	return nil
+}
+
+// readSecretFile returns the content of the file without the trailing newline. Files others can write
+// to are refused, as they could swap the secret; files others can read are only warned about,
+// since Docker and Kubernetes mount secrets readable by all.
+func readSecretFile(log *slog.Logger, path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", err
+	}
+	if !info.Mode().IsRegular() {
+		return "", fmt.Errorf("%s is not a regular file", path)
+	}
+	// Windows has no such permission bits.
+	if runtime.GOOS != "windows" {
+		perm := info.Mode().Perm()
+		if perm&0o022 != 0 {
+			return "", fmt.Errorf("%s is writable by others (mode %v)", path, perm)
+		}
+		if perm&0o004 != 0 {
+			log.Warn("Secret file is readable by all users", "path", path, "mode", perm.String())
+		}
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	secret := strings.TrimRight(string(data), "\r\n")
+	if secret == "" {
+		return "", fmt.Errorf("%s is empty", path)
+	}
+	return secret, nil
+}
+
+var dsnPassword = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S+)`)
+
+// redactDSN hides the password of a database URL or key=value connection string, for logging.
+func redactDSN(dsn string) string {
+	u, err := url.Parse(dsn)
+	if err == nil && u.Scheme != "" {
+		q := u.Query()
+		if q.Has("password") {
+			q.Set("password", "xxxxx")
+			u.RawQuery = q.Encode()
+		}
+		return u.Redacted()
+	}
+	return dsnPassword.ReplaceAllString(dsn, "${1}xxxxx")
+}
@@ -0,0 +1,75 @@
+// Package demo fills an in-memory repository with sample data, so the server can be tried without Postgres.
+package demo
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// Login is the login of the demo user.
+const Login = "demo"
+
+// workVault is the second vault of the demo user, next to the default one.
+const workVault = "Work"
+
+// Seed creates the demo user with sample items of every type and returns the user's ID.
+func Seed(ctx context.Context, repo *repository.Memory) (uuid.UUID, error) {
+	userID, err := repo.InsertUser(ctx, Login, nil)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	vaults := service.NewService(repo)
+	workID, _, err := vaults.CreateVault(ctx, userID, workVault)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	for _, lp := range []models.LoginPassword{
+		{Login: "demo@example.com", Password: "correct horse battery staple", URLs: []models.LoginURL{
+			{URL: "https://mail.example.com"},
+		}},
+		{Login: "demo", Password: "hunter2", URLs: []models.LoginURL{
+			{URL: "https://forum.example.org/login", Match: models.URLMatchPrefix},
+		}},
+		{Login: "d.emo", Password: "Q7#vN2!pLx9@", VaultID: workID, URLs: []models.LoginURL{
+			{URL: "https://git.example.net"},
+			{URL: "https://ci.example.net"},
+		}},
+	} {
+		lp.UserID = userID
+		_, err = vaults.SaveLoginPassword(ctx, lp)
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	for _, w := range []models.WifiCredential{
+		{SSID: "Home", Security: models.WifiSecurityWPA, Password: "sunflower-42"},
+		{SSID: "Office", Security: models.WifiSecurityWPA3, Password: "open sesame!", Hidden: true, VaultID: workID},
+		{SSID: "Cafe Guest", Security: models.WifiSecurityNone},
+	} {
+		w.UserID = userID
+		_, err = vaults.SaveWifiCredential(ctx, w)
+		if err != nil {
+			return uuid.Nil, err
+		}
+	}
+
+	_, err = vaults.SaveSeedPhrase(ctx, models.SeedPhrase{
+		UserID: userID,
+		Name:   "Test wallet",
+		// The BIP-39 test vector, never use it for real funds.
+		Words: strings.Fields("abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon " +
+			"abandon about"),
+	})
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return userID, nil
+}
@@ -0,0 +1,39 @@
+// Package etag converts item and vault revisions to HTTP entity tags and back.
+package etag
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrBadETag = errors.New("entity tag is not a quoted revision")
+
+// Format returns the strong entity tag of a revision.
+func Format(revision int64) string {
+	return strconv.Quote(strconv.FormatInt(revision, 10))
+}
+
+// Parse returns the revision of a strong entity tag made by Format.
+func Parse(tag string) (int64, error) {
+	tag = strings.TrimSpace(tag)
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, ErrBadETag
+	}
+	revision, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil {
+		return 0, ErrBadETag
+	}
+	return revision, nil
+}
+
+// Matches reports whether a tag is in an If-None-Match header value, weak tags matching too.
+func Matches(header, tag string) bool {
+	for t := range strings.SplitSeq(header, ",") {
+		t = strings.TrimPrefix(strings.TrimSpace(t), "W/")
+		if t == "*" || t == tag {
+			return true
+		}
+	}
+	return false
+}
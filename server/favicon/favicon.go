@@ -0,0 +1,125 @@
+package favicon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// MaxSize is the largest favicon accepted.
+	MaxSize      = 100 << 10
+	maxRedirects = 3
+)
+
+var ErrForbiddenAddress = errors.New("address is not allowed")
+
+// deniedPrefixes are special purpose ranges not covered by the netip.Addr predicates.
+var deniedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Fetcher downloads site icons.
+// It only connects to public addresses on ports 80 and 443, checked on every connection,
+// so neither DNS answers nor redirects can point it at internal services.
+type Fetcher struct {
+	http *http.Client
+}
+
+func NewFetcher() *Fetcher {
+	dialer := &net.Dialer{Timeout: 5 * time.Second, Control: checkAddress}
+	return &Fetcher{
+		http: &http.Client{
+			Timeout: 10 * time.Second,
+			Transport: &http.Transport{
+				// No proxy: it would connect to the target on our behalf, bypassing the checks.
+				Proxy:               nil,
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: 5 * time.Second,
+				MaxIdleConns:        10,
+			},
+			CheckRedirect: func(_ *http.Request, via []*http.Request) error {
+				if len(via) >= maxRedirects {
+					return errors.New("too many redirects")
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// Fetch downloads /favicon.ico of host and returns its content type and data. SVG icons are refused.
+func (f *Fetcher) Fetch(ctx context.Context, host string) (string, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+host+"/favicon.ico", nil)
+	if err != nil {
+		return "", nil, err
+	}
+	req.Header.Set("Accept", "image/*")
+	resp, err := f.http.Do(req)
+	if err != nil {
+		return "", nil, fmt.Errorf("favicon request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", nil, fmt.Errorf("favicon request failed: %s", resp.Status)
+	}
+
+	contentType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(contentType, "image/") {
+		return "", nil, fmt.Errorf("favicon has content type %q", resp.Header.Get("Content-Type"))
+	}
+	// SVG images can run scripts, in the origin of the server once it serves them.
+	if contentType == "image/svg+xml" {
+		return "", nil, errors.New("SVG favicons are not supported")
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, MaxSize+1))
+	if err != nil {
+		return "", nil, err
+	}
+	if len(data) > MaxSize {
+		return "", nil, errors.New("favicon is too large")
+	}
+	return contentType, data, nil
+}
+
+// checkAddress is called with the resolved address right before connecting.
+func checkAddress(_, address string, _ syscall.RawConn) error {
+	ap, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if ap.Port() != 80 && ap.Port() != 443 {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+	if !IsPublic(ap.Addr()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, address)
+	}
+	return nil
+}
+
+// IsPublic reports whether addr is a globally routable unicast address.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, p := range deniedPrefixes {
+		if p.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
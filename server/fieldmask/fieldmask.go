@@ -0,0 +1,112 @@
+// Package fieldmask applies the read masks of read RPCs to the messages they return.
+package fieldmask
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var ErrBadMask = errors.New("bad read mask")
+
+// tree holds the masked paths by field name, a nil subtree keeping the whole field.
+type tree map[protoreflect.Name]tree
+
+// Check validates the paths of the mask against the message the mask applies to.
+// Like Prune, it accepts paths reaching into repeated fields, but not into maps.
+func Check(mask *fieldmaskpb.FieldMask, m proto.Message) error {
+	for _, p := range mask.GetPaths() {
+		if !validPath(m.ProtoReflect().Descriptor(), p) {
+			return fmt.Errorf("%w: %q", ErrBadMask, p)
+		}
+	}
+	return nil
+}
+
+func validPath(md protoreflect.MessageDescriptor, path string) bool {
+	for name := range strings.SplitSeq(path, ".") {
+		if md == nil {
+			// The path goes on past a scalar or a map.
+			return false
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			return false
+		}
+		md = fd.Message()
+		if fd.IsMap() {
+			md = nil
+		}
+	}
+	return true
+}
+
+// Includes reports whether the mask returns the field at path, so callers can skip
+// loading or decrypting fields that would be cleared. Empty masks include everything.
+func Includes(mask *fieldmaskpb.FieldMask, path string) bool {
+	if len(mask.GetPaths()) == 0 {
+		return true
+	}
+	for _, p := range mask.GetPaths() {
+		if p == path || strings.HasPrefix(path, p+".") || strings.HasPrefix(p, path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// Prune clears the fields of m the mask doesn't cover. Masks reaching into repeated
+// fields apply to each element. Empty masks keep everything.
+func Prune(m proto.Message, mask *fieldmaskpb.FieldMask) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	t := make(tree)
+paths:
+	for _, p := range mask.GetPaths() {
+		names := strings.Split(p, ".")
+		node := t
+		for _, name := range names[:len(names)-1] {
+			child, ok := node[protoreflect.Name(name)]
+			if ok && child == nil {
+				// A shorter path already keeps the whole field.
+				continue paths
+			}
+			if !ok {
+				child = make(tree)
+				node[protoreflect.Name(name)] = child
+			}
+			node = child
+		}
+		node[protoreflect.Name(names[len(names)-1])] = nil
+	}
+	prune(m.ProtoReflect(), t)
+}
+
+func prune(m protoreflect.Message, t tree) {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	for _, fd := range fields {
+		sub, ok := t[fd.Name()]
+		switch {
+		case !ok:
+			m.Clear(fd)
+		case sub == nil || fd.Message() == nil || fd.IsMap():
+			// The whole field is kept.
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for i := range list.Len() {
+				prune(list.Get(i).Message(), sub)
+			}
+		default:
+			prune(m.Mutable(fd).Message(), sub)
+		}
+	}
+}
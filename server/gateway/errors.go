@@ -0,0 +1,128 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"net/http"
+	"strconv"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+)
+
+// errorBody is the JSON envelope of every REST error.
+type errorBody struct {
+	Error errorJSON `json:"error"`
+}
+
+type errorJSON struct {
+	// Code is the gRPC code name, like NOT_FOUND.
+	Code    string `json:"code"`
+	Status  int    `json:"status"`
+	Message string `json:"message"`
+	// Reason is the ErrorInfo reason of API errors.
+	Reason           string           `json:"reason,omitempty"`
+	LocalizedMessage string           `json:"localized_message,omitempty"`
+	FieldViolations  []fieldViolation `json:"field_violations,omitempty"`
+	RetryAfter       int              `json:"retry_after,omitempty"`
+}
+
+type fieldViolation struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// httpStatuses overrides the HTTP status of some reasons where HTTP has a better fit than the gRPC code.
+var httpStatuses = map[string]int{
+	apierror.ReasonRevisionMismatch: http.StatusPreconditionFailed,
+}
+
+// errorHandler writes errors as an errorBody with the HTTP status of their code.
+// Errors without an API reason that aren't about the request get a generic message,
+// so connection and other internal errors don't leak.
+func errorHandler(
+	_ context.Context,
+	_ *runtime.ServeMux,
+	_ runtime.Marshaler,
+	w http.ResponseWriter,
+	_ *http.Request,
+	err error,
+) {
+	st := status.Convert(err)
+	body := errorJSON{
+		Code:    codeName(st.Code()),
+		Status:  runtime.HTTPStatusFromCode(st.Code()),
+		Message: st.Message(),
+	}
+	for _, d := range st.Details() {
+		switch d := d.(type) {
+		case *errdetails.ErrorInfo:
+			body.Reason = d.GetReason()
+		case *errdetails.LocalizedMessage:
+			body.LocalizedMessage = d.GetMessage()
+		case *errdetails.BadRequest:
+			for _, v := range d.GetFieldViolations() {
+				body.FieldViolations = append(body.FieldViolations, fieldViolation{v.GetField(), v.GetDescription()})
+			}
+		case *errdetails.RetryInfo:
+			body.RetryAfter = int(math.Ceil(d.GetRetryDelay().AsDuration().Seconds()))
+		}
+	}
+	if s, ok := httpStatuses[body.Reason]; ok {
+		body.Status = s
+	}
+	if body.Reason == "" && isServerError(st.Code()) {
+		body.Message = http.StatusText(body.Status)
+	}
+
+	w.Header().Del("Trailer")
+	w.Header().Del("Transfer-Encoding")
+	w.Header().Set("Content-Type", "application/json")
+	if body.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(body.RetryAfter))
+	}
+	w.WriteHeader(body.Status)
+	_ = json.NewEncoder(w).Encode(errorBody{Error: body})
+}
+
+// isServerError reports whether the code is about the server rather than the request.
+func isServerError(c codes.Code) bool {
+	switch c { //nolint:exhaustive // the other codes are about the request
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// codeName returns the canonical name of a code, like NOT_FOUND.
+func codeName(c codes.Code) string {
+	names := map[codes.Code]string{
+		codes.OK:                 "OK",
+		codes.Canceled:           "CANCELLED",
+		codes.Unknown:            "UNKNOWN",
+		codes.InvalidArgument:    "INVALID_ARGUMENT",
+		codes.DeadlineExceeded:   "DEADLINE_EXCEEDED",
+		codes.NotFound:           "NOT_FOUND",
+		codes.AlreadyExists:      "ALREADY_EXISTS",
+		codes.PermissionDenied:   "PERMISSION_DENIED",
+		codes.ResourceExhausted:  "RESOURCE_EXHAUSTED",
+		codes.FailedPrecondition: "FAILED_PRECONDITION",
+		codes.Aborted:            "ABORTED",
+		codes.OutOfRange:         "OUT_OF_RANGE",
+		codes.Unimplemented:      "UNIMPLEMENTED",
+		codes.Internal:           "INTERNAL",
+		codes.Unavailable:        "UNAVAILABLE",
+		codes.DataLoss:           "DATA_LOSS",
+		codes.Unauthenticated:    "UNAUTHENTICATED",
+	}
+	if name, ok := names[c]; ok {
+		return name
+	}
+	return "UNKNOWN"
+}
@@ -0,0 +1,64 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cmrd-a/GophKeeper/server/etag"
+)
+
+// revisionField is the response field exposed as the ETag.
+const revisionField = "revision"
+
+// setETag exposes the revision of responses carrying one as their ETag.
+func setETag(_ context.Context, w http.ResponseWriter, m proto.Message) error {
+	msg := m.ProtoReflect()
+	fd := msg.Descriptor().Fields().ByName(revisionField)
+	if fd == nil || fd.Kind() != protoreflect.Int64Kind || fd.IsList() || !msg.Has(fd) {
+		return nil
+	}
+	w.Header().Set("ETag", etag.Format(msg.Get(fd).Int()))
+	return nil
+}
+
+// notModified answers requests whose If-None-Match matches the response's ETag with 304 Not Modified.
+func notModified(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ifNoneMatch := r.Header.Get("If-None-Match")
+		if ifNoneMatch == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		rec := &recorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		tag := w.Header().Get("ETag")
+		if rec.status == http.StatusOK && tag != "" && etag.Matches(ifNoneMatch, tag) {
+			w.Header().Del("Content-Length")
+			w.Header().Del("Content-Type")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(rec.body.Bytes())
+	})
+}
+
+// recorder buffers a response until it is known whether it is sent.
+type recorder struct {
+	http.ResponseWriter
+
+	status int
+	body   bytes.Buffer
+}
+
+func (r *recorder) WriteHeader(status int) {
+	r.status = status
+}
+
+func (r *recorder) Write(p []byte) (int, error) {
+	return r.body.Write(p)
+}
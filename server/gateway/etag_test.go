@@ -0,0 +1,162 @@
+package gateway_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/gateway"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+// restClient calls the gateway as a user.
+type restClient struct {
+	t       *testing.T
+	url     string
+	token   string
+	handler http.Handler
+	repo    *repository.Memory
+	userID  uuid.UUID
+}
+
+// startGateway serves the gRPC API on a local port with the gateway in front of it, as a user.
+func startGateway(t *testing.T) *restClient {
+	t.Helper()
+	cfg, err := config.Default()
+	if err != nil {
+		t.Fatalf("default config: %v", err)
+	}
+	repo := repository.NewMemory()
+	srv, err := server.New(cfg, slog.New(slog.DiscardHandler), server.Options{Repo: repo})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	go srv.ServeGRPC(lis) //nolint:errcheck // Serve only fails once the server is stopped.
+	t.Cleanup(func() {
+		err := srv.Stop(context.Background())
+		if err != nil {
+			t.Errorf("stop server: %v", err)
+		}
+	})
+	gw, conn, err := gateway.New(lis.Addr().String(), nil)
+	if err != nil {
+		t.Fatalf("new gateway: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	ts := httptest.NewServer(gw.Handler)
+	t.Cleanup(ts.Close)
+
+	ctx := context.Background()
+	userID, err := repo.InsertUser(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("add user: %v", err)
+	}
+	generation, err := repo.GetTokenGeneration(ctx, userID)
+	if err != nil {
+		t.Fatalf("token generation: %v", err)
+	}
+	deviceID, err := repo.InsertDevice(ctx, userID, "laptop")
+	if err != nil {
+		t.Fatalf("add device: %v", err)
+	}
+	token, err := auth.NewToken(cfg.Tokens(), userID, deviceID, generation)
+	if err != nil {
+		t.Fatalf("new token: %v", err)
+	}
+	return &restClient{t: t, url: ts.URL, token: token, handler: gw.Handler, repo: repo, userID: userID}
+}
+
+// post sends body to the path with the headers, given as name and value pairs,
+// and decodes the response into out unless it is nil.
+func (c *restClient) post(path, body string, out any, headers ...string) *http.Response {
+	c.t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, c.url+path, strings.NewReader(body))
+	if err != nil {
+		c.t.Fatalf("new request: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		c.t.Fatalf("POST %s: %v", path, err)
+	}
+	defer resp.Body.Close()
+	if out != nil && resp.StatusCode == http.StatusOK {
+		err = json.NewDecoder(resp.Body).Decode(out)
+		if err != nil {
+			c.t.Fatalf("decode %s response: %v", path, err)
+		}
+	}
+	return resp
+}
+
+// TestETag reads an item with its ETag, then updates it with If-Match and checks If-None-Match.
+func TestETag(t *testing.T) {
+	c := startGateway(t)
+
+	resp := c.post("/api/v1/vault/save-login-password", `{"login": "alice", "password": "first"}`, nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == "" {
+		t.Fatalf("save: got status %d and ETag %q, want 200 with an ETag", resp.StatusCode, resp.Header.Get("ETag"))
+	}
+	var summaries struct {
+		Items []struct {
+			ID string `json:"id"`
+		} `json:"items"`
+	}
+	c.post("/api/v1/vault/list-item-summaries", `{}`, &summaries)
+	if len(summaries.Items) != 1 {
+		t.Fatalf("got %d items, want 1", len(summaries.Items))
+	}
+	getItem := `{"id": "` + summaries.Items[0].ID + `", "type": "ITEM_TYPE_LOGIN_PASSWORD"}`
+	update := `{"id": "` + summaries.Items[0].ID + `", "login": "alice", "password": "second"}`
+
+	resp = c.post("/api/v1/vault/get-vault-item", getItem, nil)
+	tag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || tag == "" {
+		t.Fatalf("get item: got status %d and ETag %q, want 200 with an ETag", resp.StatusCode, tag)
+	}
+	resp = c.post("/api/v1/vault/get-vault-item", getItem, nil, "If-None-Match", tag)
+	if resp.StatusCode != http.StatusNotModified {
+		t.Errorf("get item with If-None-Match of its ETag: got status %d, want 304", resp.StatusCode)
+	}
+
+	// Another write to the vault doesn't change the item, so its ETag still matches.
+	c.post("/api/v1/vault/save-login-password", `{"login": "bob", "password": "other"}`, nil)
+	resp = c.post("/api/v1/vault/save-login-password", update, nil, "If-Match", tag)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("update with If-Match of the item's ETag: got status %d, want 200", resp.StatusCode)
+	}
+
+	resp = c.post("/api/v1/vault/save-login-password", update, nil, "If-Match", tag)
+	if resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("update with a stale If-Match: got status %d, want 412", resp.StatusCode)
+	}
+	resp = c.post("/api/v1/vault/get-vault-item", getItem, nil, "If-None-Match", tag)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("ETag") == tag {
+		t.Errorf(
+			"get updated item with If-None-Match of the old ETag: got status %d and ETag %q, want 200 with a new one",
+			resp.StatusCode,
+			resp.Header.Get("ETag"),
+		)
+	}
+	resp = c.post("/api/v1/vault/save-login-password", update, nil, "If-Match", "W/"+tag)
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("update with a weak If-Match: got status %d, want 400", resp.StatusCode)
+	}
+}
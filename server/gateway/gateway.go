@@ -4,18 +4,24 @@ import (
 	"context"
 
 	"fmt"
-	"log"
 
 	"net/http"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
 
 	thirdparty "github.com/cmrd-a/GophKeeper/gen"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/info"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/send"
 	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
 	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
 	"github.com/cmrd-a/GophKeeper/server/insecure"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
 
 	"io/fs"
 	"mime"
@@ -36,48 +42,113 @@ func getOpenAPIHandler() http.Handler {
 	return http.FileServer(http.FS(subFS))
 }
 
-// Run runs the gRPC-Gateway, dialling the provided address.
-func Run(dialAddr string, HTTPPort int16) error {
-	// Create a client connection to the gRPC Server we just started.
+// faviconPath prefixes the paths of GetFavicon, which serves images fetched from other sites.
+const faviconPath = "/api/v1/vault/favicon/"
+
+// marshalOptions sends zero values, so clients always see the same fields.
+var marshalOptions = protojson.MarshalOptions{EmitUnpopulated: true}
+
+// headerMatcher also forwards the Idempotency-Key and If-Match headers, as is, to the gRPC metadata.
+// The metadata the gateway sets itself can't come from clients.
+func headerMatcher(key string) (string, bool) {
+	switch {
+	case strings.HasPrefix(strings.ToLower(key), "grpc-metadata-x-gophkeeper-"):
+		return "", false
+	case strings.EqualFold(key, "Idempotency-Key"):
+		return "idempotency-key", true
+	case strings.EqualFold(key, "If-Match"):
+		return "if-match", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// New returns the gRPC-Gateway server, along with its connection to the gRPC server at dialAddr,
+// which the caller closes once the gateway is shut down.
+// gRPC-Web requests go to web instead, unless it is nil.
+func New(dialAddr string, web *grpcweb.WrappedGrpcServer) (*http.Server, *grpc.ClientConn, error) {
+	// Create a client connection to the gRPC Server.
 	// This is where the gRPC-Gateway proxies the requests.
 	conn, err := grpc.NewClient(
 		"dns:///"+dialAddr,
 		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(insecure.CertPool, "")),
 	)
 	if err != nil {
-		return fmt.Errorf("failed to dial server: %w", err)
+		return nil, nil, fmt.Errorf("failed to dial server: %w", err)
+	}
+	handler, err := newHandler(conn, web)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	return &http.Server{Handler: handler}, conn, nil
+}
+
+func newHandler(conn *grpc.ClientConn, web *grpcweb.WrappedGrpcServer) (http.Handler, error) {
+	gwmux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+		runtime.WithMetadata(func(_ context.Context, r *http.Request) metadata.MD {
+			return interceptor.GatewayMetadata(r.RemoteAddr)
+		}),
+		runtime.WithForwardResponseOption(setETag),
+		runtime.WithErrorHandler(errorHandler),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: marshalOptions,
+			// Fields added by newer clients are ignored rather than rejected.
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+	)
+	err := emergency.RegisterEmergencyAccessServiceHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
+	}
+
+	err = info.RegisterInfoServiceHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
+	}
+
+	err = send.RegisterSendServiceHandler(context.Background(), gwmux, conn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
 	}
 
-	gwmux := runtime.NewServeMux()
 	err = user.RegisterUserServiceHandler(context.Background(), gwmux, conn)
 	if err != nil {
-		return fmt.Errorf("failed to register gateway: %w", err)
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
 	}
 
 	err = vault.RegisterVaultServiceHandler(context.Background(), gwmux, conn)
 	if err != nil {
-		return fmt.Errorf("failed to register gateway: %w", err)
+		return nil, fmt.Errorf("failed to register gateway: %w", err)
 	}
 
 	oa := getOpenAPIHandler()
-
-	gatewayAddr := fmt.Sprintf("0.0.0.0:%d", HTTPPort)
-	gwServer := &http.Server{
-		Addr: gatewayAddr,
-		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if strings.HasPrefix(r.URL.Path, "/api") {
-				gwmux.ServeHTTP(w, r)
-				return
-			}
-			oa.ServeHTTP(w, r)
-		}),
-	}
-	// Empty parameters mean use the TLS Config specified with the server.
-	// if strings.ToLower(os.Getenv("SERVE_HTTP")) == "true" {
-	log.Println("Serving gRPC-Gateway and OpenAPI Documentation on http://", gatewayAddr)
-	return fmt.Errorf("serving gRPC-Gateway server: %w", gwServer.ListenAndServe())
-	// }
-
-	// log.Println("Serving gRPC-Gateway and OpenAPI Documentation on https://", gatewayAddr)
-	// return fmt.Errorf("serving gRPC-Gateway server: %w", gwServer.ListenAndServeTLS("", ""))
+	ws := watchHandler(conn)
+	reveal := revealPage()
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if web != nil && (web.IsGrpcWebRequest(r) || web.IsAcceptableGrpcCorsRequest(r) ||
+			web.IsGrpcWebSocketRequest(r)) {
+			web.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path == WebSocketPath {
+			ws.ServeHTTP(w, r)
+			return
+		}
+		if r.URL.Path == RevealPagePath {
+			reveal.ServeHTTP(w, r)
+			return
+		}
+		if strings.HasPrefix(r.URL.Path, faviconPath) {
+			// Browsers must neither sniff the icons as another type nor run anything in them.
+			w.Header().Set("X-Content-Type-Options", "nosniff")
+			w.Header().Set("Content-Security-Policy", "default-src 'none'; sandbox")
+		}
+		if strings.HasPrefix(r.URL.Path, "/api") {
+			notModified(gwmux).ServeHTTP(w, r)
+			return
+		}
+		oa.ServeHTTP(w, r)
+	}), nil
 }
@@ -0,0 +1,67 @@
+package gateway_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+// postFrom sends body to the path as a client at remoteAddr and returns the response status.
+func (c *restClient) postFrom(remoteAddr, path, body string, headers ...string) int {
+	c.t.Helper()
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodPost, path, strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	for i := 0; i+1 < len(headers); i += 2 {
+		req.Header.Set(headers[i], headers[i+1])
+	}
+	rec := httptest.NewRecorder()
+	c.handler.ServeHTTP(rec, req)
+	return rec.Code
+}
+
+// TestClientHost checks the account's network allowlist applies to the gateway's clients, not to the gateway.
+func TestClientHost(t *testing.T) {
+	c := startGateway(t)
+	err := c.repo.SetAllowedNetworks(context.Background(), c.userID,
+		[]netip.Prefix{netip.MustParsePrefix("203.0.113.0/24")})
+	if err != nil {
+		t.Fatalf("set allowed networks: %v", err)
+	}
+	const path = "/api/v1/user/get-allowed-networks"
+
+	if code := c.postFrom("203.0.113.7:40000", path, `{}`); code != http.StatusOK {
+		t.Errorf("from an allowed address: got status %d, want 200", code)
+	}
+	if code := c.postFrom("198.51.100.1:40000", path, `{}`); code != http.StatusForbidden {
+		t.Errorf("from another address: got status %d, want 403", code)
+	}
+	// The gateway itself calls from loopback, which isn't allowed either.
+	if code := c.postFrom("127.0.0.1:40000", path, `{}`); code != http.StatusForbidden {
+		t.Errorf("from loopback: got status %d, want 403", code)
+	}
+	code := c.postFrom("198.51.100.1:40000", path, `{}`, "Grpc-Metadata-X-Gophkeeper-Client-Host", "203.0.113.7")
+	if code != http.StatusForbidden {
+		t.Errorf("from another address claiming an allowed one: got status %d, want 403", code)
+	}
+}
+
+// TestFaviconHeaders checks that icons, which come from other sites, are served so browsers can't run them.
+func TestFaviconHeaders(t *testing.T) {
+	c := startGateway(t)
+	const path = "/api/v1/vault/favicon/example.com"
+	req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, path, nil)
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	rec := httptest.NewRecorder()
+	c.handler.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("X-Content-Type-Options"); got != "nosniff" {
+		t.Errorf("got X-Content-Type-Options %q, want nosniff", got)
+	}
+	if got := rec.Header().Get("Content-Security-Policy"); got != "default-src 'none'; sandbox" {
+		t.Errorf("got Content-Security-Policy %q, want default-src 'none'; sandbox", got)
+	}
+}
@@ -0,0 +1,23 @@
+package gateway
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// RevealPagePath is where revealPage is served, secret link tokens following it in the fragment.
+const RevealPagePath = "/send"
+
+//go:embed reveal.html
+var revealHTML []byte
+
+// revealPage serves the page that reveals a secret link when opened in a browser.
+// Opening it uses up no view, only pressing its button does.
+func revealPage() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Referrer-Policy", "no-referrer")
+		_, _ = w.Write(revealHTML)
+	})
+}
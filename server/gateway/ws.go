@@ -0,0 +1,88 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/coder/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+)
+
+// WebSocketPath is where watchHandler is served.
+const WebSocketPath = "/api/v1/ws"
+
+// watchHandler bridges WatchVaultChanges to a WebSocket, sending each event as a JSON text message.
+// Browsers can't set headers on WebSockets, so the token may also come as the access_token query parameter.
+// When the stream fails, the socket is closed with its status, hiding the message of server errors
+// like the error handler does.
+func watchHandler(conn grpc.ClientConnInterface) http.Handler {
+	client := vault.NewVaultServiceClient(conn)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := websocket.Accept(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.CloseNow()
+		// Nothing is read from clients, but reading handles their pings and close frames.
+		ctx := ws.CloseRead(r.Context())
+
+		ctx = metadata.NewOutgoingContext(ctx, interceptor.GatewayMetadata(r.RemoteAddr))
+		authorization := r.Header.Get("Authorization")
+		if token := r.URL.Query().Get("access_token"); token != "" {
+			authorization = "Bearer " + token
+		}
+		if authorization != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+		}
+
+		stream, err := client.WatchVaultChanges(ctx, &vault.WatchVaultChangesRequest{})
+		if err == nil {
+			err = forwardEvents(ctx, ws, stream)
+		}
+		if errors.Is(err, io.EOF) {
+			ws.Close(websocket.StatusNormalClosure, "")
+			return
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		st := status.Convert(err)
+		if isServerError(st.Code()) {
+			ws.Close(websocket.StatusInternalError, st.Code().String())
+			return
+		}
+		ws.Close(websocket.StatusPolicyViolation, closeReason(st))
+	})
+}
+
+func forwardEvents(ctx context.Context, ws *websocket.Conn, stream vault.VaultService_WatchVaultChangesClient) error {
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		b, err := marshalOptions.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err = ws.Write(ctx, websocket.MessageText, b); err != nil {
+			return err
+		}
+	}
+}
+
+// closeReason is the code and message of the status, cut to the 123 bytes a close frame allows.
+func closeReason(st *status.Status) string {
+	reason := st.Code().String() + ": " + st.Message()
+	if len(reason) > 123 {
+		reason = reason[:123]
+	}
+	return reason
+}
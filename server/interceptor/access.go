@@ -0,0 +1,47 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AccessLog is the policy name of the access logging interceptors.
+const AccessLog = "access_log"
+
+// AccessLogUnary logs every unary call at info level.
+func AccessLogUnary(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		logAccess(ctx, log, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// AccessLogStream logs every stream at info level when it ends.
+func AccessLogStream(log *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, ss)
+		logAccess(ss.Context(), log, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func logAccess(ctx context.Context, log *slog.Logger, method string, start time.Time, err error) {
+	addr := ""
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+	log.InfoContext(ctx, "gRPC access",
+		"method", method,
+		"code", status.Code(err),
+		"duration", time.Since(start),
+		"peer", addr,
+	)
+}
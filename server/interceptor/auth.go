@@ -0,0 +1,156 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+var ErrUnknownMethod = errors.New("unknown method")
+
+// AccountFunc returns the authentication settings of the user's account, pgx.ErrNoRows for unknown users.
+type AccountFunc func(ctx context.Context, userID uuid.UUID) (models.AccountAuth, error)
+
+// DeviceFunc checks the device a token was issued to. It returns pgx.ErrNoRows
+// for unknown devices and auth.ErrDeviceRevoked for revoked ones.
+type DeviceFunc func(ctx context.Context, userID, deviceID uuid.UUID) error
+
+// Auth requires an access token on every call, except for the exempt methods.
+type Auth struct {
+	log     *slog.Logger
+	tokens  auth.Tokens
+	account AccountFunc
+	device  DeviceFunc
+	// exempt holds full method names, or service prefixes ending with "/".
+	exempt []string
+}
+
+// NewAuth returns the auth interceptors validating tokens with the settings, rejecting the tokens
+// of older generations than the user's current one or of revoked devices, and calls from outside
+// the account's allowed networks. Denied addresses are logged to log.
+func NewAuth(log *slog.Logger, tokens auth.Tokens, account AccountFunc, device DeviceFunc, exempt []string) *Auth {
+	return &Auth{log: log, tokens: tokens, account: account, device: device, exempt: exempt}
+}
+
+// Exempt reports whether the full method name can be called without a token.
+func (a *Auth) Exempt(method string) bool {
+	for _, e := range a.exempt {
+		if method == e || (strings.HasSuffix(e, "/") && strings.HasPrefix(method, e)) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckExempt verifies every exempt method names a method of the registered services,
+// so a typo can't silently lock a public method behind authentication.
+func (a *Auth) CheckExempt(services map[string]grpc.ServiceInfo) error {
+	known := make(map[string]bool)
+	for name, info := range services {
+		known["/"+name+"/"] = true
+		for _, m := range info.Methods {
+			known["/"+name+"/"+m.Name] = true
+		}
+	}
+	for _, e := range a.exempt {
+		if !known[e] {
+			return fmt.Errorf("%w: %q is exempt from authentication", ErrUnknownMethod, e)
+		}
+	}
+	return nil
+}
+
+func (a *Auth) authenticate(ctx context.Context, method string) (context.Context, error) {
+	if a.Exempt(method) {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, unauthenticated("missing access token")
+	}
+	token, ok := strings.CutPrefix(values[0], "Bearer ")
+	if !ok {
+		return nil, unauthenticated("authorization is not a bearer token")
+	}
+	claims, err := auth.ParseAndValidate(a.tokens, token)
+	if err != nil {
+		return nil, unauthenticated("invalid access token")
+	}
+	account, err := a.account(ctx, claims.UserID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, unauthenticated("invalid access token")
+	}
+	if err != nil {
+		return nil, apierror.FromError(err)
+	}
+	if claims.Generation < account.TokenGeneration {
+		return nil, unauthenticated("access token was revoked")
+	}
+	err = a.device(ctx, claims.UserID, claims.DeviceID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return nil, unauthenticated("invalid access token")
+	case errors.Is(err, auth.ErrDeviceRevoked):
+		return nil, unauthenticated("device was revoked")
+	case err != nil:
+		return nil, apierror.FromError(err)
+	}
+	host := ClientHost(ctx)
+	if !auth.AddressAllowed(account.AllowedNetworks, host) {
+		a.log.WarnContext(ctx, "Access denied by the account's network allowlist",
+			"event", "ip_allowlist_denied", "user_id", claims.UserID, "addr", host, "method", method)
+		return nil, apierror.New(codes.PermissionDenied, apierror.ReasonAddressNotAllowed,
+			"access from this address is not allowed")
+	}
+	return auth.WithDeviceID(auth.WithUserID(ctx, claims.UserID), claims.DeviceID), nil
+}
+
+func unauthenticated(msg string) error {
+	return apierror.New(codes.Unauthenticated, apierror.ReasonUnauthenticated, msg)
+}
+
+// Unary returns the unary auth interceptor.
+func (a *Auth) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream auth interceptor.
+func (a *Auth) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
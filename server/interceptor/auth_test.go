@@ -0,0 +1,115 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+var services = map[string]grpc.ServiceInfo{
+	"v1.user.UserService": {Methods: []grpc.MethodInfo{{Name: "Login"}, {Name: "ChangePassword"}}},
+	"v1.info.InfoService": {Methods: []grpc.MethodInfo{{Name: "GetServerInfo"}}},
+}
+
+func TestExempt(t *testing.T) {
+	a := interceptor.NewAuth(slog.New(slog.DiscardHandler), auth.Tokens{}, nil, nil, []string{
+		"/v1.user.UserService/Login",
+		"/v1.info.InfoService/",
+	})
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{"/v1.user.UserService/Login", true},
+		{"/v1.user.UserService/ChangePassword", false},
+		{"/v1.user.UserService/LoginAs", false},
+		{"/v1.info.InfoService/GetServerInfo", true},
+		{"/v1.info.InfoServiceV2/GetServerInfo", false},
+	}
+	for _, tt := range tests {
+		if got := a.Exempt(tt.method); got != tt.want {
+			t.Errorf("Exempt(%q) = %v, want %v", tt.method, got, tt.want)
+		}
+	}
+}
+
+func TestCheckExempt(t *testing.T) {
+	tests := []struct {
+		name   string
+		exempt []string
+		ok     bool
+	}{
+		{"methods and services", []string{"/v1.user.UserService/Login", "/v1.info.InfoService/"}, true},
+		{"unknown method", []string{"/v1.user.UserService/Logn"}, false},
+		{"unknown service", []string{"/v1.user.UserServices/"}, false},
+		{"service without slash", []string{"/v1.info.InfoService"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := interceptor.NewAuth(slog.New(slog.DiscardHandler), auth.Tokens{}, nil, nil, tt.exempt)
+			err := a.CheckExempt(services)
+			if tt.ok && err != nil {
+				t.Errorf("CheckExempt() = %v, want nil", err)
+			}
+			if !tt.ok && !errors.Is(err, interceptor.ErrUnknownMethod) {
+				t.Errorf("CheckExempt() = %v, want ErrUnknownMethod", err)
+			}
+		})
+	}
+}
+
+func TestRevokedDevice(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemory()
+	userID, err := repo.InsertUser(ctx, "alice", nil)
+	if err != nil {
+		t.Fatalf("add user: %v", err)
+	}
+	deviceID, err := repo.InsertDevice(ctx, userID, "laptop")
+	if err != nil {
+		t.Fatalf("add device: %v", err)
+	}
+	tokens := auth.Tokens{Secret: "secret"}
+	token, err := auth.NewToken(tokens, userID, deviceID, 0)
+	if err != nil {
+		t.Fatalf("new token: %v", err)
+	}
+	devices := service.NewDeviceService(repo)
+	a := interceptor.NewAuth(slog.New(slog.DiscardHandler), tokens, repo.GetAccountAuth, devices.CheckDevice, nil)
+	handler := func(ctx context.Context, _ any) (any, error) {
+		if id, _ := auth.DeviceID(ctx); id != deviceID {
+			t.Errorf("DeviceID() = %v, want %v", id, deviceID)
+		}
+		return nil, nil
+	}
+	call := func() error {
+		md := metadata.Pairs("authorization", "Bearer "+token)
+		info := &grpc.UnaryServerInfo{FullMethod: "/v1.vault.VaultService/ListVaults"}
+		_, err := a.Unary()(metadata.NewIncomingContext(ctx, md), nil, info, handler)
+		return err
+	}
+
+	err = call()
+	if err != nil {
+		t.Fatalf("call with the device's token: %v", err)
+	}
+	err = devices.RevokeDevice(ctx, userID, deviceID)
+	if err != nil {
+		t.Fatalf("revoke device: %v", err)
+	}
+	err = call()
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("call after revoking the device = %v, want Unauthenticated", err)
+	}
+}
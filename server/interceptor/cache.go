@@ -0,0 +1,121 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+)
+
+// Cache is the policy name of the response cache.
+const Cache = "cache"
+
+// cachedMethods are the methods whose responses are cached, with their response types. They carry no secrets,
+// as the cache keeps plaintext, and only change with writes to the vault, which bump its revision.
+var cachedMethods = map[string]func() proto.Message{
+	vault.VaultService_ListItemSummaries_FullMethodName: func() proto.Message { return new(vault.ListItemSummariesResponse) },
+	vault.VaultService_GetVaultStats_FullMethodName:     func() proto.Message { return new(vault.GetVaultStatsResponse) },
+}
+
+// CacheStore keeps cached responses.
+type CacheStore interface {
+	// Get returns nil if the key is missing.
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// RevisionFunc returns the current revision of the user's vault.
+type RevisionFunc func(ctx context.Context, userID uuid.UUID) (int64, error)
+
+// ResponseCache serves the cached methods from the store.
+// Keys include the vault revision, which every write bumps, so writes invalidate the cached responses.
+// Items shared by other users don't bump the revision, so they may be stale for up to the TTL.
+type ResponseCache struct {
+	log      *slog.Logger
+	store    CacheStore
+	revision RevisionFunc
+	ttl      time.Duration
+
+	hits   atomic.Int64
+	misses atomic.Int64
+}
+
+func NewResponseCache(log *slog.Logger, store CacheStore, revision RevisionFunc, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{log: log, store: store, revision: revision, ttl: ttl}
+}
+
+// Stats returns the hits and misses since the server started.
+func (c *ResponseCache) Stats() (int64, int64) {
+	return c.hits.Load(), c.misses.Load()
+}
+
+// Unary returns the unary cache interceptor. Store failures are logged and the call goes to the handler.
+func (c *ResponseCache) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		userID, ok := auth.UserID(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+		newResponse, ok := cachedMethods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+		key, err := c.key(ctx, userID, info.FullMethod, req)
+		if err != nil {
+			c.log.WarnContext(ctx, "response cache unavailable", "error", err)
+			return handler(ctx, req)
+		}
+
+		b, err := c.store.Get(ctx, key)
+		if err != nil {
+			c.log.WarnContext(ctx, "response cache unavailable", "error", err)
+		}
+		if b != nil {
+			resp := newResponse()
+			if proto.Unmarshal(b, resp) == nil {
+				c.hits.Add(1)
+				return resp, nil
+			}
+		}
+
+		c.misses.Add(1)
+		resp, err := handler(ctx, req)
+		if m, ok := resp.(proto.Message); ok && err == nil {
+			c.set(ctx, key, m)
+		}
+		return resp, err
+	}
+}
+
+// key identifies the response of the method to the request at the user's current vault revision.
+func (c *ResponseCache) key(ctx context.Context, userID uuid.UUID, method string, req any) (string, error) {
+	revision, err := c.revision(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	request, err := requestHash(req)
+	if err != nil {
+		return "", err
+	}
+	return "gophkeeper:response:" + userID.String() + ":" + strconv.FormatInt(revision, 10) + ":" +
+		method + ":" + hex.EncodeToString(request[:]), nil
+}
+
+func (c *ResponseCache) set(ctx context.Context, key string, m proto.Message) {
+	b, err := proto.Marshal(m)
+	if err == nil {
+		err = c.store.Set(ctx, key, b, c.ttl)
+	}
+	if err != nil {
+		c.log.WarnContext(ctx, "failed to cache response", "error", err)
+	}
+}
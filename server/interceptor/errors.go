@@ -0,0 +1,71 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+)
+
+// StatusErrorsUnary converts the errors of unary handlers to API status errors, logging internal ones.
+func StatusErrorsUnary(log *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		return resp, convertError(ctx, log, info.FullMethod, err)
+	}
+}
+
+// StatusErrorsStream converts the errors of stream handlers to API status errors, logging internal ones.
+func StatusErrorsStream(log *slog.Logger) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return convertError(ss.Context(), log, info.FullMethod, handler(srv, ss))
+	}
+}
+
+func convertError(ctx context.Context, log *slog.Logger, method string, err error) error {
+	_, isStatus := status.FromError(err)
+	converted := apierror.FromError(err)
+	if !isStatus && status.Code(converted) == codes.Internal {
+		log.ErrorContext(ctx, "internal error", "method", method, "error", err)
+	}
+	return converted
+}
+
+// LocalizeUnary localizes the errors of unary calls to the client's Accept-Language,
+// it goes first in the chain to see the errors of the other interceptors.
+func LocalizeUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			err = apierror.Localize(err, acceptLanguage(ctx))
+		}
+		return resp, err
+	}
+}
+
+// LocalizeStream localizes the errors of streams to the client's Accept-Language.
+func LocalizeStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			err = apierror.Localize(err, acceptLanguage(ss.Context()))
+		}
+		return err
+	}
+}
+
+// acceptLanguage returns the client's Accept-Language, also when forwarded by the HTTP gateway.
+func acceptLanguage(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{"accept-language", "grpcgateway-accept-language"} {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
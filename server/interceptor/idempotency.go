@@ -0,0 +1,133 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+)
+
+// IdempotencyKeyHeader is the metadata key of the client chosen idempotency key.
+const IdempotencyKeyHeader = "idempotency-key"
+
+const (
+	maxIdempotencyKeyLength = 128
+	// savePrefix starts the names of the methods made idempotent.
+	savePrefix = "Save"
+)
+
+// Idempotency replays the result of Save calls retried with the same idempotency key
+// within the window, so a client retrying after a timeout doesn't create duplicates.
+type Idempotency struct {
+	window time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*idempotentCall
+	swept   time.Time
+}
+
+type idempotentCall struct {
+	request [sha256.Size]byte
+	done    chan struct{}
+	resp    any
+	err     error
+	expires time.Time
+}
+
+func NewIdempotency(window time.Duration) *Idempotency {
+	return &Idempotency{window: window, entries: make(map[string]*idempotentCall)}
+}
+
+// Unary returns the unary idempotency interceptor.
+func (i *Idempotency) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		keys := md.Get(IdempotencyKeyHeader)
+		if len(keys) == 0 || !strings.HasPrefix(path.Base(info.FullMethod), savePrefix) {
+			return handler(ctx, req)
+		}
+		if len(keys[0]) > maxIdempotencyKeyLength {
+			return nil, apierror.InvalidField(IdempotencyKeyHeader, "idempotency key is too long")
+		}
+		userID, _ := auth.UserID(ctx)
+		key := userID.String() + info.FullMethod + "\x00" + keys[0]
+		request, err := requestHash(req)
+		if err != nil {
+			return nil, err
+		}
+
+		call, first := i.start(key, request)
+		if call == nil {
+			return nil, apierror.New(
+				codes.FailedPrecondition,
+				apierror.ReasonIdempotencyKeyReused,
+				"idempotency key was used for a different request",
+			)
+		}
+		if !first {
+			select {
+			case <-call.done:
+				return call.resp, call.err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		call.resp, call.err = handler(ctx, req)
+		if call.err != nil {
+			// Failed calls can be retried with the same key.
+			i.mu.Lock()
+			delete(i.entries, key)
+			i.mu.Unlock()
+		}
+		close(call.done)
+		return call.resp, call.err
+	}
+}
+
+// start returns the call of the key and whether it is new, or nil if the key was used for another request.
+func (i *Idempotency) start(key string, request [sha256.Size]byte) (*idempotentCall, bool) {
+	now := time.Now()
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if now.Sub(i.swept) > i.window {
+		for k, c := range i.entries {
+			if now.After(c.expires) {
+				delete(i.entries, k)
+			}
+		}
+		i.swept = now
+	}
+	call, ok := i.entries[key]
+	if ok && now.Before(call.expires) {
+		if call.request != request {
+			return nil, false
+		}
+		return call, false
+	}
+	call = &idempotentCall{request: request, done: make(chan struct{}), expires: now.Add(i.window)}
+	i.entries[key] = call
+	return call, true
+}
+
+func requestHash(req any) ([sha256.Size]byte, error) {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return [sha256.Size]byte{}, nil
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(b), nil
+}
@@ -0,0 +1,43 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+	"github.com/cmrd-a/GophKeeper/server/etag"
+)
+
+// IfMatchHeader is the metadata key the gateway forwards the If-Match header to.
+const IfMatchHeader = "if-match"
+
+// expectedRevisionField is the request field If-Match fills in.
+const expectedRevisionField = "expected_revision"
+
+// IfMatchUnary sets the expected_revision of requests from an If-Match entity tag,
+// so REST clients get the optimistic locking of the gRPC field. An explicit field wins.
+func IfMatchUnary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		values := md.Get(IfMatchHeader)
+		m, ok := req.(proto.Message)
+		if len(values) == 0 || values[0] == "*" || !ok {
+			return handler(ctx, req)
+		}
+		msg := m.ProtoReflect()
+		fd := msg.Descriptor().Fields().ByName(expectedRevisionField)
+		if fd == nil || fd.Kind() != protoreflect.Int64Kind || msg.Has(fd) {
+			return handler(ctx, req)
+		}
+		revision, err := etag.Parse(values[0])
+		if err != nil {
+			return nil, apierror.InvalidField("If-Match", "If-Match must be a single entity tag from an ETag")
+		}
+		msg.Set(fd, protoreflect.ValueOfInt64(revision))
+		return handler(ctx, req)
+	}
+}
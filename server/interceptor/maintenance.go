@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+)
+
+// maintenanceExempt is the service still served in maintenance, so clients can learn about it.
+const maintenanceExempt = "/v1.info.InfoService/"
+
+// Maintenance rejects calls while the server is in maintenance.
+type Maintenance struct {
+	// message is nil when not in maintenance.
+	message atomic.Pointer[string]
+}
+
+// Set enters maintenance with the message shown to clients, or leaves it.
+func (m *Maintenance) Set(enabled bool, message string) {
+	if !enabled {
+		m.message.Store(nil)
+		return
+	}
+	if message == "" {
+		message = "server is under maintenance"
+	}
+	m.message.Store(&message)
+}
+
+// Message returns the maintenance message, false when not in maintenance.
+func (m *Maintenance) Message() (string, bool) {
+	message := m.message.Load()
+	if message == nil {
+		return "", false
+	}
+	return *message, true
+}
+
+func (m *Maintenance) check(method string) error {
+	message, ok := m.Message()
+	if !ok || strings.HasPrefix(method, maintenanceExempt) {
+		return nil
+	}
+	return apierror.New(codes.Unavailable, apierror.ReasonMaintenance, message)
+}
+
+// Unary returns the unary maintenance interceptor.
+func (m *Maintenance) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		err := m.check(info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream maintenance interceptor, streams already open are not closed.
+func (m *Maintenance) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := m.check(info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,132 @@
+package interceptor
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// PayloadLog is the policy name of the payload logging interceptors.
+const PayloadLog = "payload_log"
+
+// PayloadLogger logs gRPC payloads at debug level, masked by the redactor.
+// To keep logging off the hot path it logs only every sample-th call and calls
+// slower than slow; a zero sample logs only slow calls and a zero slow disables it.
+type PayloadLogger struct {
+	log      *slog.Logger
+	settings atomic.Pointer[payloadSettings]
+	calls    atomic.Uint64
+}
+
+// payloadSettings are swapped as a whole by Set, so calls see them consistently.
+type payloadSettings struct {
+	enabled  bool
+	redactor *Redactor
+	sample   uint64
+	slow     time.Duration
+}
+
+func NewPayloadLogger(log *slog.Logger, r *Redactor, sample uint64, slow time.Duration) *PayloadLogger {
+	l := &PayloadLogger{log: log}
+	l.Set(true, r, sample, slow)
+	return l
+}
+
+// Set changes what is logged, nothing when disabled.
+func (l *PayloadLogger) Set(enabled bool, r *Redactor, sample uint64, slow time.Duration) {
+	l.settings.Store(&payloadSettings{enabled: enabled, redactor: r, sample: sample, slow: slow})
+}
+
+// enabled returns the settings, nil when nothing is logged.
+func (l *PayloadLogger) enabled(ctx context.Context) *payloadSettings {
+	settings := l.settings.Load()
+	if !settings.enabled || !l.log.Enabled(ctx, slog.LevelDebug) {
+		return nil
+	}
+	return settings
+}
+
+// sampled counts the call and reports whether it is sampled.
+func (l *PayloadLogger) sampled(settings *payloadSettings) bool {
+	n := l.calls.Add(1)
+	return settings.sample > 0 && n%settings.sample == 0
+}
+
+// payload formats a message only when the record is written.
+type payload struct {
+	redactor *Redactor
+	m        any
+}
+
+func (p payload) LogValue() slog.Value {
+	return slog.AnyValue(p.redactor.Redact(p.m))
+}
+
+// Unary logs the request and response of sampled and slow unary calls.
+func (l *PayloadLogger) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		settings := l.enabled(ctx)
+		if settings == nil {
+			return handler(ctx, req)
+		}
+		sampled := l.sampled(settings)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		elapsed := time.Since(start)
+		if !sampled && (settings.slow == 0 || elapsed < settings.slow) {
+			return resp, err
+		}
+		attrs := []any{
+			"method", info.FullMethod,
+			"duration", elapsed,
+			"code", status.Code(err),
+			"request", payload{settings.redactor, req},
+		}
+		if err != nil {
+			attrs = append(attrs, "error", err)
+		} else {
+			attrs = append(attrs, "response", payload{settings.redactor, resp})
+		}
+		l.log.DebugContext(ctx, "gRPC call", attrs...)
+		return resp, err
+	}
+}
+
+// Stream logs the messages of sampled streams, which are usually too long-lived to be slow.
+func (l *PayloadLogger) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		settings := l.enabled(ss.Context())
+		if settings == nil || !l.sampled(settings) {
+			return handler(srv, ss)
+		}
+		return handler(
+			srv,
+			&loggingStream{ServerStream: ss, log: l.log, redactor: settings.redactor, method: info.FullMethod},
+		)
+	}
+}
+
+type loggingStream struct {
+	grpc.ServerStream
+
+	log      *slog.Logger
+	redactor *Redactor
+	method   string
+}
+
+func (s *loggingStream) SendMsg(m any) error {
+	s.log.DebugContext(s.Context(), "gRPC stream send", "method", s.method, "payload", payload{s.redactor, m})
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *loggingStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.log.DebugContext(s.Context(), "gRPC stream receive", "method", s.method, "payload", payload{s.redactor, m})
+	}
+	return err
+}
@@ -0,0 +1,72 @@
+// Package interceptor holds the gRPC server interceptors and the policy enabling them per method.
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+var ErrBadPolicy = errors.New("bad interceptor policy")
+
+// rule enables or disables an interceptor for the methods starting with prefix.
+type rule struct {
+	prefix  string
+	enabled bool
+}
+
+// Policy decides which interceptors run for which methods. Interceptors are enabled
+// for every method unless a rule says otherwise; the last matching rule wins.
+type Policy map[string][]rule
+
+// ParsePolicy parses a comma separated list of rules like "payload_log:-/v1.user.UserService/",
+// where the prefix matches full method names and "+" enables or "-" disables the interceptor.
+func ParsePolicy(s string) (Policy, error) {
+	p := make(Policy)
+	for r := range strings.SplitSeq(s, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" {
+			continue
+		}
+		name, prefix, ok := strings.Cut(r, ":")
+		if !ok || name == "" || prefix == "" || (prefix[0] != '+' && prefix[0] != '-') {
+			return nil, fmt.Errorf("%w: %q", ErrBadPolicy, r)
+		}
+		p[name] = append(p[name], rule{prefix: prefix[1:], enabled: prefix[0] == '+'})
+	}
+	return p, nil
+}
+
+// Enabled reports whether the named interceptor runs for the full method name.
+func (p Policy) Enabled(name, method string) bool {
+	enabled := true
+	for _, r := range p[name] {
+		if strings.HasPrefix(method, r.prefix) {
+			enabled = r.enabled
+		}
+	}
+	return enabled
+}
+
+// Unary applies the policy to a unary interceptor.
+func (p Policy) Unary(name string, i grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !p.Enabled(name, info.FullMethod) {
+			return handler(ctx, req)
+		}
+		return i(ctx, req, info, handler)
+	}
+}
+
+// Stream applies the policy to a stream interceptor.
+func (p Policy) Stream(name string, i grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !p.Enabled(name, info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return i(srv, ss, info, handler)
+	}
+}
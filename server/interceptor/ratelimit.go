@@ -0,0 +1,162 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/cmrd-a/GophKeeper/server/apierror"
+)
+
+// RateLimit is the policy name of the rate limiting interceptors.
+const RateLimit = "rate_limit"
+
+// sweepEvery is how often the buckets that filled up again are dropped, a full bucket being the same as a new one.
+const sweepEvery = 10 * time.Minute
+
+// Limiter limits the calls of each client host with a token bucket. A zero rate allows every call.
+type Limiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter allows rate calls per second per client host, with bursts of up to burst calls.
+func NewLimiter(rate float64, burst int) *Limiter {
+	return &Limiter{rate: rate, burst: float64(max(burst, 1)), buckets: make(map[string]*bucket)}
+}
+
+// SetRate changes the limits, the buckets keep their tokens up to the new burst.
+func (l *Limiter) SetRate(rate float64, burst int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rate, l.burst = rate, float64(max(burst, 1))
+}
+
+// Allow takes a token from the bucket of the key.
+func (l *Limiter) Allow(key string) bool {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate <= 0 {
+		return true
+	}
+	if now.Sub(l.swept) > sweepEvery {
+		for k, b := range l.buckets {
+			if b.tokens+now.Sub(b.last).Seconds()*l.rate >= l.burst {
+				delete(l.buckets, k)
+			}
+		}
+		l.swept = now
+	}
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+	b.tokens = min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+func (l *Limiter) check(ctx context.Context) error {
+	return l.Check(ClientHost(ctx))
+}
+
+// Check takes a token from the bucket of the key, failing with RESOURCE_EXHAUSTED if there is none.
+func (l *Limiter) Check(key string) error {
+	if !l.Allow(key) {
+		l.mu.Lock()
+		delay := time.Duration(float64(time.Second) / l.rate)
+		l.mu.Unlock()
+		return apierror.New(
+			codes.ResourceExhausted,
+			apierror.ReasonRateLimited,
+			"rate limit exceeded",
+			&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)},
+		)
+	}
+	return nil
+}
+
+// Unary returns the unary rate limiting interceptor.
+func (l *Limiter) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		err := l.check(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the stream rate limiting interceptor, counting each stream as one call.
+func (l *Limiter) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := l.check(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// ClientHostKey is the metadata key the HTTP gateway forwards the host of its own client in.
+const ClientHostKey = "x-gophkeeper-client-host"
+
+// gatewayKey is the metadata key of gatewaySecret, proving a call comes from the gateway of this process.
+const gatewayKey = "x-gophkeeper-gateway"
+
+// gatewaySecret is only known to this process, so other clients can't pass themselves off as its gateway.
+var gatewaySecret = rand.Text()
+
+// GatewayMetadata returns the metadata the in-process HTTP gateway sends along with each call,
+// forwarding remoteAddr, the address of its client.
+func GatewayMetadata(remoteAddr string) metadata.MD {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	return metadata.Pairs(ClientHostKey, host, gatewayKey, gatewaySecret)
+}
+
+// ClientHost returns the host of the calling peer, all unix socket clients share one.
+// For calls from the in-process HTTP gateway, it is the host of the gateway's client instead.
+func ClientHost(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	hosts, secrets := md.Get(ClientHostKey), md.Get(gatewayKey)
+	if len(hosts) == 1 && len(secrets) == 1 &&
+		subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(gatewaySecret)) == 1 {
+		return hosts[0]
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
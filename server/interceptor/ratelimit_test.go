@@ -0,0 +1,40 @@
+package interceptor_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+)
+
+func TestClientHost(t *testing.T) {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 40000},
+	})
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want string
+	}{
+		{"peer", nil, "127.0.0.1"},
+		{"gateway", interceptor.GatewayMetadata("203.0.113.7:40000"), "203.0.113.7"},
+		{"forged", metadata.Pairs(interceptor.ClientHostKey, "203.0.113.7"), "127.0.0.1"},
+		{
+			"forged secret",
+			metadata.Pairs(interceptor.ClientHostKey, "203.0.113.7", "x-gophkeeper-gateway", "guess"),
+			"127.0.0.1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := interceptor.ClientHost(metadata.NewIncomingContext(ctx, tt.md))
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package interceptor
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// redacted replaces the values of sensitive fields.
+const redacted = "[REDACTED]"
+
+// Redactor masks the sensitive fields of logged messages.
+type Redactor struct {
+	fields map[protoreflect.Name]bool
+}
+
+// NewRedactor masks the proto fields with the names, at any depth.
+func NewRedactor(fields []string) *Redactor {
+	r := &Redactor{fields: make(map[protoreflect.Name]bool, len(fields))}
+	for _, f := range fields {
+		r.fields[protoreflect.Name(f)] = true
+	}
+	return r
+}
+
+// Redact returns a copy of the message with the sensitive fields masked,
+// along with fields marked debug_redact in the protos, whatever the configuration.
+// Values that are not proto messages are returned as is.
+func (r *Redactor) Redact(v any) any {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return v
+	}
+	m = proto.Clone(m)
+	r.mask(m.ProtoReflect())
+	return m
+}
+
+func (r *Redactor) mask(m protoreflect.Message) {
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+	for _, fd := range fields {
+		if r.fields[fd.Name()] || debugRedact(fd) {
+			maskField(m, fd)
+			continue
+		}
+		if !holdsMessages(fd) {
+			continue
+		}
+		v := m.Get(fd)
+		switch {
+		case fd.IsList():
+			for i := range v.List().Len() {
+				r.mask(v.List().Get(i).Message())
+			}
+		case fd.IsMap():
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				r.mask(mv.Message())
+				return true
+			})
+		default:
+			r.mask(v.Message())
+		}
+	}
+}
+
+// maskField replaces a string or bytes field with the redacted marker, and clears fields of other kinds.
+func maskField(m protoreflect.Message, fd protoreflect.FieldDescriptor) {
+	var v protoreflect.Value
+	switch fd.Kind() { //nolint:exhaustive // other kinds are cleared
+	case protoreflect.StringKind:
+		v = protoreflect.ValueOfString(redacted)
+	case protoreflect.BytesKind:
+		v = protoreflect.ValueOfBytes([]byte(redacted))
+	default:
+		m.Clear(fd)
+		return
+	}
+	if !fd.IsList() {
+		m.Set(fd, v)
+		return
+	}
+	list := m.Mutable(fd).List()
+	for i := range list.Len() {
+		list.Set(i, v)
+	}
+}
+
+func debugRedact(fd protoreflect.FieldDescriptor) bool {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	return ok && opts.GetDebugRedact()
+}
+
+// holdsMessages reports whether the field's values are, or map to, messages.
+func holdsMessages(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsMap() {
+		fd = fd.MapValue()
+	}
+	return fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+}
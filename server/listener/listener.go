@@ -0,0 +1,66 @@
+package listener
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+)
+
+// Unix listens on a unix domain socket at path.
+// A stale socket file left over from a previous run is removed first.
+func Unix(path string) (net.Listener, error) {
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+	}
+	lis, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on unix socket: %w", err)
+	}
+	return lis, nil
+}
+
+// PrivateUnix listens on a unix domain socket at path that only the server's user can connect to.
+// The socket is created in a new directory only the user can enter and moved to path once restricted,
+// so it is never reachable with looser permissions.
+func PrivateUnix(path string) (net.Listener, error) {
+	err := os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+	}
+	dir, err := os.MkdirTemp(filepath.Dir(path), ".socket-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create socket directory: %w", err)
+	}
+	defer os.Remove(dir)
+	tmp := filepath.Join(dir, "socket")
+	lis, err := net.Listen("unix", tmp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on unix socket: %w", err)
+	}
+	err = os.Chmod(tmp, 0o600)
+	if err == nil {
+		err = os.Rename(tmp, path)
+	}
+	if err != nil {
+		lis.Close()
+		return nil, fmt.Errorf("failed to restrict unix socket: %w", err)
+	}
+	return movedSocket{Listener: lis, path: path}, nil
+}
+
+// movedSocket removes the socket file at its new path when closed, as the listener only removes the original one.
+type movedSocket struct {
+	net.Listener
+
+	path string
+}
+
+func (l movedSocket) Close() error {
+	err := l.Listener.Close()
+	os.Remove(l.path)
+	return err
+}
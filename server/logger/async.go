@@ -0,0 +1,53 @@
+package logger
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// AsyncWriter writes log records in the background, so slow output doesn't delay callers.
+// Records are dropped while the buffer is full.
+type AsyncWriter struct {
+	w       io.Writer
+	records chan []byte
+	done    chan struct{}
+	once    sync.Once
+	dropped atomic.Uint64
+}
+
+// NewAsyncWriter buffers up to size records for w.
+func NewAsyncWriter(w io.Writer, size int) *AsyncWriter {
+	a := &AsyncWriter{w: w, records: make(chan []byte, size), done: make(chan struct{})}
+	go a.run()
+	return a
+}
+
+func (a *AsyncWriter) run() {
+	defer close(a.done)
+	for p := range a.records {
+		_, _ = a.w.Write(p)
+	}
+}
+
+// Write queues a copy of p, since slog handlers reuse their buffers.
+func (a *AsyncWriter) Write(p []byte) (int, error) {
+	select {
+	case a.records <- append([]byte(nil), p...):
+	default:
+		a.dropped.Add(1)
+	}
+	return len(p), nil
+}
+
+// Dropped returns the number of records dropped so far.
+func (a *AsyncWriter) Dropped() uint64 {
+	return a.dropped.Load()
+}
+
+// Close writes the queued records and stops the writer; later writes panic.
+func (a *AsyncWriter) Close() error {
+	a.once.Do(func() { close(a.records) })
+	<-a.done
+	return nil
+}
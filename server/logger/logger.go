@@ -1,11 +1,19 @@
 package logger
 
 import (
+	"errors"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 )
 
+// asyncBuffer is how many log records can wait to be written by an async logger.
+const asyncBuffer = 4096
+
+var ErrBadFormat = errors.New("log format must be text or json")
+
 func GetLogLevelFromEnv(level string) slog.Level {
 	switch strings.ToUpper(level) {
 	case "DEBUG":
@@ -24,9 +32,75 @@ func GetLogLevelFromEnv(level string) slog.Level {
 func NewLogger() (*slog.Logger, *slog.LevelVar) {
 	lvl := new(slog.LevelVar)
 	lvl.Set(slog.LevelInfo)
+	return NewLoggerTo(os.Stdout, lvl), lvl
+}
 
-	slg := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+// NewLoggerTo returns a logger writing to w at the level.
+func NewLoggerTo(w io.Writer, lvl *slog.LevelVar) *slog.Logger {
+	return slog.New(slog.NewTextHandler(w, &slog.HandlerOptions{
 		Level: lvl,
 	}))
-	return slg, lvl
+}
+
+// Options configure where and how a logger writes.
+type Options struct {
+	// Format is text or json.
+	Format string
+	// File is the log file, stdout when empty.
+	File string
+	// MaxSize and RotateEvery rotate the file when set, keeping Keep rotated files.
+	MaxSize     int64
+	RotateEvery time.Duration
+	Keep        int
+	// Async writes in the background, dropping records when the output can't keep up.
+	Async bool
+}
+
+// New returns a logger with the options and a closer flushing and closing its output.
+func New(o Options, lvl *slog.LevelVar) (*slog.Logger, io.Closer, error) {
+	var w io.WriteCloser = nopCloser{os.Stdout}
+	if o.File != "" {
+		f, err := NewRotatingFile(o.File, o.MaxSize, o.RotateEvery, o.Keep)
+		if err != nil {
+			return nil, nil, err
+		}
+		w = f
+	}
+	var out io.Writer = w
+	closer := io.Closer(w)
+	if o.Async {
+		a := NewAsyncWriter(w, asyncBuffer)
+		out = a
+		closer = closers{a, w}
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+	switch strings.ToLower(o.Format) {
+	case "", "text":
+		return slog.New(slog.NewTextHandler(out, opts)), closer, nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(out, opts)), closer, nil
+	default:
+		closer.Close()
+		return nil, nil, ErrBadFormat
+	}
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// closers closes in order.
+type closers []io.Closer
+
+func (c closers) Close() error {
+	var errs []error
+	for _, cl := range c {
+		errs = append(errs, cl.Close())
+	}
+	return errors.Join(errs...)
 }
@@ -0,0 +1,105 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+)
+
+// rotatedFormat is appended to the names of rotated log files, it sorts chronologically.
+const rotatedFormat = "20060102T150405.000000000"
+
+// RotatingFile is a log file rotated when it grows past maxSize or gets older than every,
+// keeping the newest keep rotated files.
+type RotatingFile struct {
+	path    string
+	maxSize int64
+	every   time.Duration
+	keep    int
+
+	mu      sync.Mutex
+	f       *os.File
+	size    int64
+	opened  time.Time
+	rotated []string
+}
+
+// NewRotatingFile opens the log file at path, appending to it; zero maxSize or every disables that rotation.
+func NewRotatingFile(path string, maxSize int64, every time.Duration, keep int) (*RotatingFile, error) {
+	r := &RotatingFile{path: path, maxSize: maxSize, every: every, keep: keep}
+	old, err := filepath.Glob(path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(old)
+	r.rotated = old
+	err = r.open()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *RotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	r.f, r.size, r.opened = f, info.Size(), time.Now()
+	return nil
+}
+
+func (r *RotatingFile) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	due := r.every > 0 && time.Since(r.opened) > r.every
+	if r.size > 0 && (due || (r.maxSize > 0 && r.size+int64(len(p)) > r.maxSize)) {
+		err := r.rotate()
+		if err != nil {
+			return 0, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+// rotate renames the current file aside and deletes the rotated files beyond keep.
+func (r *RotatingFile) rotate() error {
+	err := r.f.Close()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s.%s", r.path, time.Now().UTC().Format(rotatedFormat))
+	if len(r.rotated) > 0 && name <= r.rotated[len(r.rotated)-1] {
+		// The clock went backwards or is too coarse, keep the names sorted.
+		name = r.rotated[len(r.rotated)-1] + "1"
+	}
+	err = os.Rename(r.path, name)
+	if err != nil {
+		return err
+	}
+	r.rotated = append(r.rotated, name)
+	for len(r.rotated) > max(r.keep, 0) {
+		err = os.Remove(r.rotated[0])
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		r.rotated = r.rotated[1:]
+	}
+	return r.open()
+}
+
+func (r *RotatingFile) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.f.Close()
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+)
+
+// MetricsPath is where the expvar metrics, like those of backups, are served on the metrics address.
+const MetricsPath = "/debug/vars"
+
+// serveMetrics serves the expvar metrics on the metrics address, if set.
+func (s *Server) serveMetrics() error {
+	if s.cfg.MetricsAddr == "" {
+		return nil
+	}
+	lis, err := net.Listen("tcp", s.cfg.MetricsAddr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, expvar.Handler())
+	s.metrics = &http.Server{Handler: mux}
+	s.serve("metrics", lis, s.metrics.Serve)
+	return nil
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"net/netip"
+	"time"
+
 	"github.com/google/uuid"
 )
 
@@ -12,8 +15,355 @@ type Meta struct {
 }
 
 type LoginPassword struct {
+	ID        *uuid.UUID
+	UserID    uuid.UUID
+	VaultID   uuid.UUID
+	Login     string
+	Password  string
+	URLs      []LoginURL
+	UpdatedAt time.Time
+	Revision  int64
+	// LastUsedAt is when the item was last viewed or copied, if ever.
+	LastUsedAt *time.Time
+	// RotationDays is how often the password should be changed, 0 to follow the vault's policy.
+	RotationDays int
+	// ExpectedRevision, if set, makes an update fail unless the item is still at this revision.
+	ExpectedRevision *int64
+}
+
+// WifiSecurity is the authentication of a WiFi network.
+type WifiSecurity string
+
+const (
+	WifiSecurityNone WifiSecurity = "none"
+	WifiSecurityWEP  WifiSecurity = "wep"
+	// WifiSecurityWPA is WPA or WPA2 personal.
+	WifiSecurityWPA  WifiSecurity = "wpa"
+	WifiSecurityWPA3 WifiSecurity = "wpa3"
+)
+
+type WifiCredential struct {
 	ID       *uuid.UUID
 	UserID   uuid.UUID
-	Login    string
+	VaultID  uuid.UUID
+	SSID     string
+	Security WifiSecurity
 	Password string
+	// Hidden networks don't broadcast their SSID.
+	Hidden    bool
+	UpdatedAt time.Time
+	Revision  int64
+	// LastUsedAt is when the item was last viewed or copied, if ever.
+	LastUsedAt *time.Time
+	// ExpectedRevision, if set, makes an update fail unless the item is still at this revision.
+	ExpectedRevision *int64
+}
+
+// SeedPhrase is the recovery phrase of a crypto wallet.
+type SeedPhrase struct {
+	ID      *uuid.UUID
+	UserID  uuid.UUID
+	VaultID uuid.UUID
+	// Name tells wallets apart, the words are the secret.
+	Name      string
+	Words     []string
+	UpdatedAt time.Time
+	Revision  int64
+	// LastUsedAt is when the item was last viewed or copied, if ever.
+	LastUsedAt *time.Time
+	// ExpectedRevision, if set, makes an update fail unless the item is still at this revision.
+	ExpectedRevision *int64
+}
+
+// URLMatch is how a login URL is compared with the URL of a page.
+type URLMatch string
+
+const (
+	// URLMatchDomain matches pages on the host of the URL and its subdomains.
+	URLMatchDomain URLMatch = "domain"
+	URLMatchExact  URLMatch = "exact"
+	URLMatchPrefix URLMatch = "prefix"
+)
+
+type LoginURL struct {
+	URL   string
+	Match URLMatch
+	// Host is the lowercased host of URL, used to find candidate items.
+	Host string
+}
+
+type Vault struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	// RotationDays is how often the passwords of the vault's login items should be changed, 0 for never.
+	RotationDays int
+}
+
+type ItemType string
+
+const (
+	ItemTypeLoginPassword  ItemType = "login_password"
+	ItemTypeWifiCredential ItemType = "wifi_credential"
+	ItemTypeSeedPhrase     ItemType = "seed_phrase"
+)
+
+type Operation string
+
+const (
+	OperationCreated Operation = "created"
+	OperationUpdated Operation = "updated"
+	OperationDeleted Operation = "deleted"
+)
+
+type ChangeEvent struct {
+	ItemID    uuid.UUID
+	ItemType  ItemType
+	Operation Operation
+	Revision  int64
+}
+
+type Tombstone struct {
+	ItemID    uuid.UUID
+	ItemType  ItemType
+	DeletedAt time.Time
+	Revision  int64
+}
+
+type Changes struct {
+	LoginPasswords  []LoginPassword
+	WifiCredentials []WifiCredential
+	SeedPhrases     []SeedPhrase
+	Deleted         []Tombstone
+	SyncedAt        time.Time
+	Revision        int64
+}
+
+type Device struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Name       string
+	CreatedAt  time.Time
+	LastSyncAt *time.Time
+	RevokedAt  *time.Time
+}
+
+type SecretLink struct {
+	ID         uuid.UUID
+	UserID     uuid.UUID
+	Ciphertext []byte
+	MaxViews   int32
+	Views      int32
+	ExpiresAt  time.Time
+}
+
+type EmergencyStatus string
+
+const (
+	EmergencyStatusIdle      EmergencyStatus = "idle"
+	EmergencyStatusRequested EmergencyStatus = "requested"
+	EmergencyStatusGranted   EmergencyStatus = "granted"
+)
+
+type EmergencyContact struct {
+	ID           uuid.UUID
+	OwnerID      uuid.UUID
+	OwnerLogin   string
+	GranteeID    uuid.UUID
+	GranteeLogin string
+	Wait         time.Duration
+	Status       EmergencyStatus
+	RequestedAt  *time.Time
+}
+
+type Share struct {
+	ID           uuid.UUID
+	ItemID       uuid.UUID
+	ItemType     ItemType
+	OwnerID      uuid.UUID
+	GranteeID    uuid.UUID
+	GranteeLogin string
+	ReadOnly     bool
+	ExpiresAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// PasswordFinding is a problem found with the password of a login password item.
+type PasswordFinding struct {
+	ItemID uuid.UUID
+	Login  string
+	// BreachCount is how many times the password appears in known breaches.
+	BreachCount int64
+	// Strength is a zxcvbn score from 0 to 4.
+	Strength  int
+	UpdatedAt time.Time
+	// RotationDueAt is when the password was due for a change by its rotation policy.
+	RotationDueAt time.Time
+}
+
+// ReuseGroup is a set of login password items sharing the same password.
+type ReuseGroup struct {
+	Items []PasswordFinding
+}
+
+type HealthReport struct {
+	Weak     []PasswordFinding
+	Reused   []ReuseGroup
+	Breached []PasswordFinding
+	Old      []PasswordFinding
+	// RotationDue are the passwords overdue for a change, most overdue first.
+	RotationDue []PasswordFinding
+}
+
+// TableDump is the CSV content of one database table.
+type TableDump struct {
+	Name string
+	Data []byte
+}
+
+type Backup struct {
+	Name          string
+	Size          int
+	SchemaVersion int64
+	CreatedAt     time.Time
+}
+
+type RestoredTable struct {
+	Name string
+	Rows int64
+}
+
+// AccountAuth is the state of an account checked on every authenticated call.
+type AccountAuth struct {
+	// TokenGeneration revokes the access tokens of older generations.
+	TokenGeneration int64
+	// AllowedNetworks are the only networks the account can be used from, any network if empty.
+	AllowedNetworks []netip.Prefix
+}
+
+// Account is the record of a user, without the password hash and recovery secrets.
+type Account struct {
+	ID              uuid.UUID
+	Login           string
+	TenantID        *uuid.UUID
+	DisabledAt      *time.Time
+	AllowedNetworks []netip.Prefix
+	// HasRecoveryKit is set once the user created a recovery kit.
+	HasRecoveryKit bool
+}
+
+// UserSummary is an account as seen by operators, without any secrets.
+type UserSummary struct {
+	ID    uuid.UUID
+	Login string
+	// TenantID is nil for users outside any tenant.
+	TenantID   *uuid.UUID
+	DisabledAt *time.Time
+	Items      int64
+	// StorageBytes is the size of the user's stored secrets.
+	StorageBytes int64
+}
+
+// Tenant is an organization hosted on the server. Its users only see each other,
+// users outside any tenant only see the others outside any tenant.
+// Isolation is per user only: items carry no tenant and belong to a tenant through their owner,
+// and no encryption keys are kept per tenant.
+type Tenant struct {
+	ID   uuid.UUID
+	Name string
+	// StorageQuotaBytes bounds the size of the secrets of the tenant's users, unlimited if 0.
+	StorageQuotaBytes int64
+	CreatedAt         time.Time
+	Users             int64
+	StorageBytes      int64
+}
+
+// StorageQuota is the quota a user's new secrets count against.
+type StorageQuota struct {
+	// QuotaBytes is unlimited if 0.
+	QuotaBytes int64
+	// UsedBytes is the size of the secrets of every user sharing the quota.
+	UsedBytes int64
+}
+
+type AccountUsage struct {
+	Items  map[ItemType]int64
+	Vaults int64
+	// Devices counts devices that are not revoked.
+	Devices      int64
+	StorageBytes int64
+	LastSyncAt   *time.Time
+}
+
+// UsageCounts are server-wide counts, without anything about single users.
+type UsageCounts struct {
+	Users int64
+	Items map[ItemType]int64
+}
+
+type StorageUsage struct {
+	DatabaseBytes int64
+	Tables        []TableSize
+}
+
+type TableSize struct {
+	Name  string
+	Bytes int64
+}
+
+// Favicon is a cached site icon. Empty Data records a failed fetch.
+type Favicon struct {
+	Host        string
+	ContentType string
+	Data        []byte
+	FetchedAt   time.Time
+}
+
+// RecoveryKit lets a user who lost their password log in with Code
+// and get back the key material they wrapped with it.
+type RecoveryKit struct {
+	UserID    uuid.UUID
+	Code      string
+	CreatedAt time.Time
+}
+
+// VaultStats describes how a user's vault grew and what it holds.
+type VaultStats struct {
+	// AddedPerMonth counts the items added in each month with additions, oldest first.
+	AddedPerMonth []MonthCount
+	StorageBytes  map[ItemType]int64
+	// OldestPasswords are the login passwords left unchanged the longest, oldest first.
+	OldestPasswords []LoginPassword
+}
+
+type MonthCount struct {
+	// Month is the first instant of the month, in UTC.
+	Month time.Time
+	Count int64
+}
+
+// ItemSummary describes a vault item without its secrets.
+type ItemSummary struct {
+	ID       uuid.UUID
+	VaultID  uuid.UUID
+	ItemType ItemType
+	// Title is the login, SSID or name of the item.
+	Title     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Size is the size of the secrets of the item in bytes.
+	Size   int64
+	Pinned bool
+	// SortIndex is the place of the item in the user's order from 1, 0 if the user did not order it.
+	SortIndex int
+	// Archived items are hidden from listings and autofill unless asked for, but otherwise kept.
+	Archived bool
+}
+
+// VaultItem is an item of any type, with only the field of its type set.
+type VaultItem struct {
+	LoginPassword  *LoginPassword
+	WifiCredential *WifiCredential
+	SeedPhrase     *SeedPhrase
 }
@@ -0,0 +1,127 @@
+// Package pow issues proof-of-work challenges, so that every registration costs the client some CPU time.
+// Challenges are signed rather than stored, so any server sharing the key can verify them.
+package pow
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	nonceSize = 16
+	// tokenSize is the expiry, the difficulty, the nonce and the MAC.
+	tokenSize = 8 + 1 + nonceSize + sha256.Size
+)
+
+var (
+	ErrBadChallenge = errors.New("invalid or expired challenge")
+	ErrBadSolution  = errors.New("challenge solution does not have enough zero bits")
+)
+
+// Challenge asks for a solution such that SHA-256 of Token followed by the solution starts with Bits zero bits.
+type Challenge struct {
+	Token     string
+	Bits      int
+	ExpiresAt time.Time
+}
+
+// Issuer issues and verifies challenges. Each challenge is accepted once.
+type Issuer struct {
+	key  []byte
+	bits int
+	ttl  time.Duration
+
+	mu sync.Mutex
+	// spent holds the solved challenges until they expire.
+	spent map[string]time.Time
+}
+
+// NewIssuer returns an issuer of challenges of the given difficulty, signed with the key and valid for ttl.
+func NewIssuer(key []byte, bits int, ttl time.Duration) *Issuer {
+	return &Issuer{key: key, bits: bits, ttl: ttl, spent: make(map[string]time.Time)}
+}
+
+// Challenge returns a new challenge.
+func (i *Issuer) Challenge() (Challenge, error) {
+	expiresAt := time.Now().Add(i.ttl).Truncate(time.Second)
+	b := make([]byte, tokenSize)
+	binary.BigEndian.PutUint64(b, uint64(expiresAt.Unix()))
+	b[8] = byte(i.bits)
+	_, err := rand.Read(b[9 : 9+nonceSize])
+	if err != nil {
+		return Challenge{}, err
+	}
+	copy(b[9+nonceSize:], i.mac(b[:9+nonceSize]))
+	return Challenge{Token: base64.RawURLEncoding.EncodeToString(b), Bits: i.bits, ExpiresAt: expiresAt}, nil
+}
+
+// Verify checks that the challenge was issued by i, has not expired or been used and that the solution solves it.
+func (i *Issuer) Verify(token, solution string) error {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) != tokenSize || !hmac.Equal(b[9+nonceSize:], i.mac(b[:9+nonceSize])) {
+		return ErrBadChallenge
+	}
+	now := time.Now()
+	expiresAt := time.Unix(int64(binary.BigEndian.Uint64(b)), 0) //nolint:gosec // Signed by the issuer.
+	if now.After(expiresAt) {
+		return ErrBadChallenge
+	}
+	if !Solves(token, solution, int(b[8])) {
+		return ErrBadSolution
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for t, exp := range i.spent {
+		if now.After(exp) {
+			delete(i.spent, t)
+		}
+	}
+	if _, ok := i.spent[token]; ok {
+		return ErrBadChallenge
+	}
+	i.spent[token] = expiresAt
+	return nil
+}
+
+func (i *Issuer) mac(b []byte) []byte {
+	h := hmac.New(sha256.New, i.key)
+	h.Write(b)
+	return h.Sum(nil)
+}
+
+// Solves reports whether SHA-256 of the token followed by the solution starts with n zero bits.
+func Solves(token, solution string, n int) bool {
+	sum := sha256.Sum256([]byte(token + solution))
+	zeros := 0
+	for _, b := range sum {
+		if b != 0 {
+			zeros += bits.LeadingZeros8(b)
+			break
+		}
+		zeros += 8
+	}
+	return zeros >= n
+}
+
+// Solve finds a solution of the challenge by trying decimal counters, for clients and tests.
+func Solve(ctx context.Context, c Challenge) (string, error) {
+	for n := 0; ; n++ {
+		if n%(1<<16) == 0 && ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		solution := strconv.Itoa(n)
+		if Solves(c.Token, solution, c.Bits) {
+			return solution, nil
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/logger"
+)
+
+// Reload applies the settings of cfg that can change while serving and logs what changed,
+// warning about the changes that need a restart. Settings changed through the admin API
+// are kept unless cfg changes them too.
+func (s *Server) Reload(ctx context.Context, cfg *config.Config) {
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	changes := config.Diff(s.loaded, cfg)
+	s.loaded = cfg
+	if len(changes) == 0 {
+		s.log.InfoContext(ctx, "Configuration reloaded, nothing changed")
+		return
+	}
+	changed := make(map[string]bool, len(changes))
+	for _, c := range changes {
+		attrs := []any{"variable", c.Name, "old", c.Old, "new", c.New}
+		if !c.Reloadable {
+			s.log.WarnContext(ctx, "Configuration change needs a restart", attrs...)
+			continue
+		}
+		s.log.InfoContext(ctx, "Configuration changed", attrs...)
+		changed[c.Name] = true
+	}
+
+	if changed["LOG_LEVEL"] {
+		s.level.Set(logger.GetLogLevelFromEnv(cfg.LogLevel))
+	}
+	if changed["MAINTENANCE"] || changed["MAINTENANCE_MESSAGE"] {
+		s.maintenance.Set(cfg.Maintenance, cfg.MaintenanceMessage)
+	}
+	if changed["RATE_LIMIT"] || changed["RATE_BURST"] {
+		s.limiter.SetRate(cfg.RateLimit, cfg.RateBurst)
+	}
+	if changed["LOG_GRPC_PAYLOADS"] || changed["LOG_PAYLOAD_SAMPLE"] || changed["LOG_SLOW_CALLS"] ||
+		changed["LOG_REDACT_FIELDS"] {
+		setPayloadLog(s.payloads, cfg)
+	}
+}
+
+// setPayloadLog configures the payload logger as cfg says.
+func setPayloadLog(l *interceptor.PayloadLogger, cfg *config.Config) {
+	l.Set(cfg.LogGRPCPayloads, interceptor.NewRedactor(cfg.LogRedactFields), cfg.LogPayloadSample, cfg.LogSlowCalls)
+}
@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// storageBytesSQL sums the size of the secrets stored by the user u.
+var storageBytesSQL = `(SELECT COALESCE(sum(size), 0) FROM ` + allItemsSQL("user_id") + ` WHERE item.user_id=u.id)
+	+ (SELECT COALESCE(sum(octet_length(sl.ciphertext)), 0) FROM secret_link sl WHERE sl.user_id=u.id)`
+
+// ListUsers returns every account, or only those of the tenant if tenantID is set.
+func (r Repository) ListUsers(ctx context.Context, tenantID *uuid.UUID) ([]models.UserSummary, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT u.id, u.login, u.tenant_id, u.disabled_at,
+			(SELECT count(*) FROM `+allItemsSQL("user_id")+` WHERE item.user_id=u.id), `+storageBytesSQL+`
+		FROM "user" u WHERE $1::uuid IS NULL OR u.tenant_id=$1 ORDER BY u.login`,
+		tenantID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.UserSummary, error) {
+		var u models.UserSummary
+		err := row.Scan(&u.ID, &u.Login, &u.TenantID, &u.DisabledAt, &u.Items, &u.StorageBytes)
+		return u, err
+	})
+}
+
+// GetAccountUsage returns the usage of the user's account.
+func (r Repository) GetAccountUsage(ctx context.Context, userID uuid.UUID) (models.AccountUsage, error) {
+	var usage models.AccountUsage
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT
+			(SELECT count(*) FROM vault WHERE user_id=u.id),
+			(SELECT count(*) FROM device WHERE user_id=u.id AND revoked_at IS NULL),
+			(SELECT max(last_sync_at) FROM device WHERE user_id=u.id), `+storageBytesSQL+`
+		FROM "user" u WHERE u.id=$1`,
+		userID,
+	).Scan(&usage.Vaults, &usage.Devices, &usage.LastSyncAt, &usage.StorageBytes)
+	if err != nil {
+		return models.AccountUsage{}, err
+	}
+
+	usage.Items = make(map[models.ItemType]int64, len(itemTables))
+	for _, t := range itemTables {
+		usage.Items[t.itemType] = 0
+	}
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT item_type, count(*) FROM "+allItemsSQL("user_id")+" WHERE user_id=$1 GROUP BY item_type",
+		userID,
+	)
+	if err != nil {
+		return models.AccountUsage{}, err
+	}
+	var (
+		itemType models.ItemType
+		n        int64
+	)
+	_, err = pgx.ForEachRow(rows, []any{&itemType, &n}, func() error {
+		usage.Items[itemType] = n
+		return nil
+	})
+	if err != nil {
+		return models.AccountUsage{}, err
+	}
+	return usage, nil
+}
+
+// SetUserDisabled disables or enables the account with the login. Disabling revokes its tokens.
+func (r Repository) SetUserDisabled(ctx context.Context, login string, disabled bool) error {
+	if disabled {
+		return r.execOne(
+			ctx,
+			`UPDATE "user" SET disabled_at=COALESCE(disabled_at, now()), token_generation=token_generation+1
+			WHERE login=$1`,
+			login,
+		)
+	}
+	return r.execOne(ctx, `UPDATE "user" SET disabled_at=NULL WHERE login=$1`, login)
+}
+
+// GetUsageCounts counts the users and the items of each type of the whole server.
+func (r Repository) GetUsageCounts(ctx context.Context) (models.UsageCounts, error) {
+	counts := models.UsageCounts{Items: make(map[models.ItemType]int64, len(itemTables))}
+	err := r.pool.QueryRow(ctx, `SELECT count(*) FROM "user"`).Scan(&counts.Users)
+	if err != nil {
+		return models.UsageCounts{}, err
+	}
+	for _, t := range itemTables {
+		counts.Items[t.itemType] = 0
+	}
+	rows, err := r.pool.Query(ctx, "SELECT item_type, count(*) FROM "+allItemsSQL("id")+" GROUP BY item_type")
+	if err != nil {
+		return models.UsageCounts{}, err
+	}
+	var (
+		itemType models.ItemType
+		n        int64
+	)
+	_, err = pgx.ForEachRow(rows, []any{&itemType, &n}, func() error {
+		counts.Items[itemType] = n
+		return nil
+	})
+	if err != nil {
+		return models.UsageCounts{}, err
+	}
+	return counts, nil
+}
+
+// GetStorageUsage returns the on-disk size of the database and of each vault table, including indexes.
+func (r Repository) GetStorageUsage(ctx context.Context) (models.StorageUsage, error) {
+	if r.dialect == DialectCockroachDB {
+		return r.getRangeUsage(ctx)
+	}
+	var usage models.StorageUsage
+	err := r.pool.QueryRow(ctx, "SELECT pg_database_size(current_database())").Scan(&usage.DatabaseBytes)
+	if err != nil {
+		return models.StorageUsage{}, err
+	}
+	for _, t := range backupTables {
+		size := models.TableSize{Name: t.name}
+		err = r.pool.QueryRow(ctx, "SELECT pg_total_relation_size($1::regclass)", pgx.Identifier{t.name}.Sanitize()).
+			Scan(&size.Bytes)
+		if err != nil {
+			return models.StorageUsage{}, err
+		}
+		usage.Tables = append(usage.Tables, size)
+	}
+	return usage, nil
+}
+
+// getRangeUsage is GetStorageUsage on CockroachDB, which has no size functions. The sizes are those
+// of the ranges holding the data, and a range may hold several small tables.
+func (r Repository) getRangeUsage(ctx context.Context) (models.StorageUsage, error) {
+	const rangeSizeSQL = "SELECT COALESCE(sum(range_size), 0)::INT8 FROM [SHOW RANGES FROM %s WITH DETAILS]"
+	var usage models.StorageUsage
+	err := r.pool.QueryRow(ctx, fmt.Sprintf(rangeSizeSQL, "CURRENT_CATALOG")).Scan(&usage.DatabaseBytes)
+	if err != nil {
+		return models.StorageUsage{}, err
+	}
+	for _, t := range backupTables {
+		size := models.TableSize{Name: t.name}
+		err = r.pool.QueryRow(ctx, fmt.Sprintf(rangeSizeSQL, "TABLE "+pgx.Identifier{t.name}.Sanitize())).
+			Scan(&size.Bytes)
+		if err != nil {
+			return models.StorageUsage{}, err
+		}
+		usage.Tables = append(usage.Tables, size)
+	}
+	return usage, nil
+}
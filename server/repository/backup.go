@@ -0,0 +1,288 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+const (
+	userTable    = "user"
+	userIDColumn = "user_id"
+)
+
+type backupTable struct {
+	name string
+	// owner references the user owning a row, empty for tables not owned by users.
+	owner string
+	// access marks the tables of what can reach the account: devices, secret links and grants.
+	// Restoring one user leaves them as they are, so nothing revoked since the backup works again.
+	access bool
+}
+
+// backupTables lists the tables included in backups, referenced tables first.
+var backupTables = []backupTable{
+	{name: "tenant"},
+	{name: userTable, owner: "id"},
+	{name: "vault", owner: userIDColumn},
+	{name: "login_password", owner: userIDColumn},
+	{name: "login_url", owner: userIDColumn},
+	{name: "wifi_credential", owner: userIDColumn},
+	{name: "seed_phrase", owner: userIDColumn},
+	{name: "tombstone", owner: userIDColumn},
+	{name: "device", owner: userIDColumn, access: true},
+	{name: "secret_link", owner: userIDColumn, access: true},
+	{name: "emergency_contact", owner: "owner_id", access: true},
+	{name: "share", owner: "owner_id", access: true},
+}
+
+// Dump copies every backup table as CSV with a header row.
+// All tables are read from one snapshot, so the dump is consistent.
+func (r Repository) Dump(ctx context.Context) (int64, []models.TableDump, error) {
+	if r.dialect == DialectCockroachDB {
+		return 0, nil, errNoBackups
+	}
+	var (
+		version int64
+		dumps   []models.TableDump
+	)
+	opts := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := r.inTx(ctx, opts, func(tx pgx.Tx) error {
+		var err error
+		version, err = schemaVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		dumps = make([]models.TableDump, 0, len(backupTables))
+		for _, t := range backupTables {
+			var buf bytes.Buffer
+			sql := "COPY " + pgx.Identifier{t.name}.Sanitize() + " TO STDOUT WITH (FORMAT csv, HEADER)"
+			_, err = tx.Conn().PgConn().CopyTo(ctx, &buf, sql)
+			if err != nil {
+				return fmt.Errorf("failed to dump %s: %w", t.name, err)
+			}
+			dumps = append(dumps, models.TableDump{Name: t.name, Data: buf.Bytes()})
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, nil, err
+	}
+	return version, dumps, nil
+}
+
+// Restore loads table dumps made by Dump at schema version.
+// If userID is set only the vaults and items of that user are replaced and marked as changed for syncing clients,
+// keeping their devices, secret links and grants as they are now,
+// otherwise the whole database is replaced and clients have to sync from scratch.
+// It returns pgx.ErrNoRows if the user is not in the dumps. With dryRun nothing is committed.
+func (r Repository) Restore(
+	ctx context.Context,
+	version int64,
+	tables []models.TableDump,
+	userID *uuid.UUID,
+	dryRun bool,
+) ([]models.RestoredTable, error) {
+	if r.dialect == DialectCockroachDB {
+		return nil, errNoBackups
+	}
+	dumps := make(map[string][]byte, len(tables))
+	for _, t := range tables {
+		dumps[t.Name] = t.Data
+	}
+	for _, t := range backupTables {
+		if _, ok := dumps[t.name]; !ok {
+			return nil, fmt.Errorf("table %s is missing from backup", t.name)
+		}
+	}
+
+	var restored []models.RestoredTable
+	err := r.inTx(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		current, err := schemaVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if current != version {
+			return fmt.Errorf("backup is at migration %d but database is at %d", version, current)
+		}
+		if userID == nil {
+			restored, err = restoreAll(ctx, tx, dumps)
+		} else {
+			restored, err = restoreUser(ctx, tx, dumps, *userID)
+		}
+		if err == nil && dryRun {
+			return errDryRun
+		}
+		return err
+	})
+	if errors.Is(err, errDryRun) {
+		return restored, nil
+	}
+	return restored, err
+}
+
+var (
+	// errDryRun rolls back the transaction of a dry run.
+	errDryRun = errors.New("dry run")
+	// errNoBackups is returned on CockroachDB, which can't truncate and copy tables in one transaction.
+	errNoBackups = fmt.Errorf("backups on CockroachDB: %w, use its BACKUP statement", errors.ErrUnsupported)
+)
+
+func restoreAll(ctx context.Context, tx pgx.Tx, dumps map[string][]byte) ([]models.RestoredTable, error) {
+	names := make([]string, 0, len(backupTables))
+	for _, t := range backupTables {
+		names = append(names, pgx.Identifier{t.name}.Sanitize())
+	}
+	_, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE")
+	if err != nil {
+		return nil, err
+	}
+
+	restored := make([]models.RestoredTable, 0, len(backupTables))
+	for _, t := range backupTables {
+		tag, err := copyFrom(ctx, tx, pgx.Identifier{t.name}, dumps[t.name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", t.name, err)
+		}
+		restored = append(restored, models.RestoredTable{Name: t.name, Rows: tag.RowsAffected()})
+	}
+	return restored, nil
+}
+
+func restoreUser(
+	ctx context.Context,
+	tx pgx.Tx,
+	dumps map[string][]byte,
+	userID uuid.UUID,
+) ([]models.RestoredTable, error) {
+	// Tenants are not owned by the user and are kept as they are, like the access tables.
+	owned := slices.DeleteFunc(slices.Clone(backupTables), func(t backupTable) bool {
+		return t.owner == "" || t.access
+	})
+	// Load the dumps into temporary copies of the tables to pick the user's rows from.
+	for _, t := range owned {
+		staged := pgx.Identifier{"restore_" + t.name}
+		_, err := tx.Exec(
+			ctx,
+			"CREATE TEMP TABLE "+staged.Sanitize()+" (LIKE "+pgx.Identifier{t.name}.Sanitize()+") ON COMMIT DROP",
+		)
+		if err != nil {
+			return nil, err
+		}
+		_, err = copyFrom(ctx, tx, staged, dumps[t.name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to load %s: %w", t.name, err)
+		}
+	}
+	var exists bool
+	err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM restore_user WHERE id=$1)", userID).Scan(&exists)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, pgx.ErrNoRows
+	}
+
+	_, err = tx.Exec(
+		ctx,
+		"CREATE TEMP TABLE restore_removed ON COMMIT DROP AS SELECT id, item_type FROM "+
+			allItemsSQL("id, user_id")+" WHERE user_id=$1",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range slices.Backward(owned) {
+		if t.name == userTable {
+			continue
+		}
+		_, err = tx.Exec(
+			ctx,
+			"DELETE FROM "+pgx.Identifier{t.name}.Sanitize()+" WHERE "+t.owner+"=$1",
+			userID,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	restored := make([]models.RestoredTable, 0, len(owned))
+	for _, t := range owned {
+		// The staged table has the same columns in the same order, so whole rows are copied.
+		sql := "INSERT INTO " + pgx.Identifier{t.name}.Sanitize() + //nolint:unqueryvet // see above
+			" SELECT * FROM " + pgx.Identifier{"restore_" + t.name}.Sanitize() +
+			" WHERE " + t.owner + "=$1"
+		// The account itself is only recreated if it was deleted, current credentials are kept.
+		if t.name == userTable {
+			sql += " ON CONFLICT DO NOTHING"
+		}
+		tag, err := tx.Exec(ctx, sql, userID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore %s: %w", t.name, err)
+		}
+		restored = append(restored, models.RestoredTable{Name: t.name, Rows: tag.RowsAffected()})
+	}
+	return restored, markRestored(ctx, tx, userID)
+}
+
+// markRestored bumps the user's revision so syncing clients fetch the restored items
+// and drop the items missing from the backup.
+func markRestored(ctx context.Context, tx pgx.Tx, userID uuid.UUID) error {
+	var revision int64
+	err := tx.QueryRow(
+		ctx,
+		`UPDATE "user" SET revision=revision+1 WHERE id=$1 RETURNING revision`,
+		userID,
+	).Scan(&revision)
+	if err != nil {
+		return err
+	}
+	for _, t := range itemTables {
+		_, err = tx.Exec(
+			ctx,
+			"UPDATE "+t.name+" SET updated_at=now(), revision=$2 WHERE user_id=$1",
+			userID,
+			revision,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	_, err = tx.Exec(
+		ctx,
+		`INSERT INTO tombstone (item_id, user_id, item_type, revision)
+		SELECT id, $1, item_type, $2 FROM restore_removed
+		WHERE id NOT IN (SELECT id FROM `+allItemsSQL("id")+`)
+		ON CONFLICT (item_id) DO UPDATE SET deleted_at=now(), revision=excluded.revision`,
+		userID,
+		revision,
+	)
+	return err
+}
+
+func copyFrom(ctx context.Context, tx pgx.Tx, table pgx.Identifier, data []byte) (pgconn.CommandTag, error) {
+	return tx.Conn().PgConn().CopyFrom(
+		ctx,
+		bytes.NewReader(data),
+		"COPY "+table.Sanitize()+" FROM STDIN WITH (FORMAT csv, HEADER)",
+	)
+}
+
+func schemaVersion(ctx context.Context, tx pgx.Tx) (int64, error) {
+	var version int64
+	err := tx.QueryRow(ctx, "SELECT COALESCE(MAX(version_id), 0) FROM goose_db_version WHERE is_applied").
+		Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migration version: %w", err)
+	}
+	return version, nil
+}
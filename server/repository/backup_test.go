@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+// TestRestoreUserKeepsRevocations restores a user from a backup made before their device was revoked.
+// The device, and so the tokens issued to it, must stay revoked.
+// It needs a Postgres database, given by TEST_DATABASE_URI.
+func TestRestoreUserKeepsRevocations(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URI")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URI is not set")
+	}
+	ctx := context.Background()
+	repo, err := repository.NewRepository(ctx, dsn, repository.PoolOptions{})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	t.Cleanup(repo.Close)
+	_, err = repo.Bootstrap(ctx)
+	if err != nil {
+		t.Fatalf("bootstrap: %v", err)
+	}
+
+	userID, err := repo.InsertUser(ctx, "restore-"+uuid.NewString(), nil)
+	if err != nil {
+		t.Fatalf("add user: %v", err)
+	}
+	deviceID, err := repo.InsertDevice(ctx, userID, "laptop")
+	if err != nil {
+		t.Fatalf("add device: %v", err)
+	}
+	version, tables, err := repo.Dump(ctx)
+	if err != nil {
+		t.Fatalf("dump: %v", err)
+	}
+	err = repo.RevokeDevice(ctx, userID, deviceID)
+	if err != nil {
+		t.Fatalf("revoke device: %v", err)
+	}
+
+	_, err = repo.Restore(ctx, version, tables, &userID, false)
+	if err != nil {
+		t.Fatalf("restore: %v", err)
+	}
+	device, err := repo.GetDevice(ctx, userID, deviceID)
+	if err != nil {
+		t.Fatalf("get device: %v", err)
+	}
+	if device.RevokedAt == nil {
+		t.Error("device revoked after the backup is active again after restoring the user")
+	}
+}
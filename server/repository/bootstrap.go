@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+
+	"github.com/cmrd-a/GophKeeper/migrations"
+)
+
+// Bootstrap creates the schema by applying every migration when the database has no tables at all,
+// reporting whether it did. Databases with tables are left alone for CheckMigrations to judge,
+// so the server never migrates a database it didn't create.
+func (r Repository) Bootstrap(ctx context.Context) (bool, error) {
+	var tables int
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema()",
+	).Scan(&tables)
+	if err != nil {
+		return false, fmt.Errorf("failed to list tables: %w", err)
+	}
+	if tables > 0 {
+		return false, nil
+	}
+
+	var opts []goose.ProviderOption
+	// The lock keeps replicas started together on the same empty database from migrating it twice.
+	// CockroachDB has no advisory locks to take it with.
+	if r.dialect != DialectCockroachDB {
+		locker, err := lock.NewPostgresSessionLocker()
+		if err != nil {
+			return false, err
+		}
+		opts = append(opts, goose.WithSessionLocker(locker))
+	}
+	db := stdlib.OpenDBFromPool(r.pool)
+	defer db.Close()
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS, opts...)
+	if err != nil {
+		return false, err
+	}
+	_, err = provider.Up(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to create the schema: %w", err)
+	}
+	return true, nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+func (r Repository) InsertDevice(ctx context.Context, userID uuid.UUID, name string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		"INSERT INTO device (user_id, name) VALUES ($1, $2) RETURNING id",
+		userID,
+		name,
+	).Scan(&id)
+	return id, err
+}
+
+func (r Repository) GetDevice(ctx context.Context, userID, id uuid.UUID) (models.Device, error) {
+	var d models.Device
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT id, user_id, name, created_at, last_sync_at, revoked_at FROM device WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	).Scan(&d.ID, &d.UserID, &d.Name, &d.CreatedAt, &d.LastSyncAt, &d.RevokedAt)
+	return d, err
+}
+
+func (r Repository) ListDevices(ctx context.Context, userID uuid.UUID) ([]models.Device, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT id, user_id, name, created_at, last_sync_at, revoked_at FROM device WHERE user_id=$1 ORDER BY created_at",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Device, error) {
+		var d models.Device
+		err := row.Scan(&d.ID, &d.UserID, &d.Name, &d.CreatedAt, &d.LastSyncAt, &d.RevokedAt)
+		return d, err
+	})
+}
+
+func (r Repository) RenameDevice(ctx context.Context, userID, id uuid.UUID, name string) error {
+	return r.execOne(ctx, "UPDATE device SET name=$1 WHERE id=$2 AND user_id=$3", name, id, userID)
+}
+
+func (r Repository) RevokeDevice(ctx context.Context, userID, id uuid.UUID) error {
+	return r.execOne(
+		ctx,
+		"UPDATE device SET revoked_at=COALESCE(revoked_at, now()) WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	)
+}
+
+func (r Repository) TouchDevice(ctx context.Context, userID, id uuid.UUID) error {
+	return r.execOne(
+		ctx,
+		"UPDATE device SET last_sync_at=now() WHERE id=$1 AND user_id=$2 AND revoked_at IS NULL",
+		id,
+		userID,
+	)
+}
+
+// execOne runs a statement expected to affect exactly one row and returns pgx.ErrNoRows otherwise.
+func (r Repository) execOne(ctx context.Context, sql string, args ...any) error {
+	tag, err := r.pool.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
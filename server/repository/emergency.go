@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+const emergencyContactColumns = "id, owner_id, grantee_id, wait_seconds, status, requested_at"
+
+func scanEmergencyContact(row pgx.Row) (models.EmergencyContact, error) {
+	var c models.EmergencyContact
+	var waitSeconds int64
+	err := row.Scan(&c.ID, &c.OwnerID, &c.GranteeID, &waitSeconds, &c.Status, &c.RequestedAt)
+	c.Wait = time.Duration(waitSeconds) * time.Second
+	return c, err
+}
+
+func (r Repository) InsertEmergencyContact(
+	ctx context.Context,
+	ownerID, granteeID uuid.UUID,
+	wait time.Duration,
+) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		"INSERT INTO emergency_contact (owner_id, grantee_id, wait_seconds) VALUES ($1, $2, $3) RETURNING id",
+		ownerID,
+		granteeID,
+		int64(wait/time.Second),
+	).Scan(&id)
+	return id, err
+}
+
+func (r Repository) DeleteEmergencyContact(ctx context.Context, ownerID, id uuid.UUID) error {
+	return r.execOne(ctx, "DELETE FROM emergency_contact WHERE id=$1 AND owner_id=$2", id, ownerID)
+}
+
+// ListEmergencyContacts returns contacts where the user is either the owner or the grantee.
+func (r Repository) ListEmergencyContacts(ctx context.Context, userID uuid.UUID) ([]models.EmergencyContact, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT c.id, c.owner_id, o.login, c.grantee_id, g.login, c.wait_seconds, c.status, c.requested_at
+		FROM emergency_contact c
+		JOIN "user" o ON o.id = c.owner_id
+		JOIN "user" g ON g.id = c.grantee_id
+		WHERE c.owner_id=$1 OR c.grantee_id=$1
+		ORDER BY c.created_at`,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.EmergencyContact, error) {
+		var c models.EmergencyContact
+		var waitSeconds int64
+		err := row.Scan(
+			&c.ID, &c.OwnerID, &c.OwnerLogin, &c.GranteeID, &c.GranteeLogin, &waitSeconds, &c.Status, &c.RequestedAt,
+		)
+		c.Wait = time.Duration(waitSeconds) * time.Second
+		return c, err
+	})
+}
+
+// RequestEmergencyAccess starts the waiting period of an idle contact on behalf of its grantee.
+func (r Repository) RequestEmergencyAccess(
+	ctx context.Context,
+	granteeID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return scanEmergencyContact(r.pool.QueryRow(
+		ctx,
+		`UPDATE emergency_contact SET status=$1, requested_at=now()
+		WHERE id=$2 AND grantee_id=$3 AND status=$4
+		RETURNING `+emergencyContactColumns,
+		models.EmergencyStatusRequested,
+		id,
+		granteeID,
+		models.EmergencyStatusIdle,
+	))
+}
+
+// ApproveEmergencyAccess grants a pending request before its waiting period ends.
+func (r Repository) ApproveEmergencyAccess(
+	ctx context.Context,
+	ownerID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return scanEmergencyContact(r.pool.QueryRow(
+		ctx,
+		`UPDATE emergency_contact SET status=$1
+		WHERE id=$2 AND owner_id=$3 AND status=$4
+		RETURNING `+emergencyContactColumns,
+		models.EmergencyStatusGranted,
+		id,
+		ownerID,
+		models.EmergencyStatusRequested,
+	))
+}
+
+// RejectEmergencyAccess rejects a pending request or takes back granted access.
+func (r Repository) RejectEmergencyAccess(
+	ctx context.Context,
+	ownerID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return scanEmergencyContact(r.pool.QueryRow(
+		ctx,
+		`UPDATE emergency_contact SET status=$1, requested_at=NULL
+		WHERE id=$2 AND owner_id=$3 AND status<>$1
+		RETURNING `+emergencyContactColumns,
+		models.EmergencyStatusIdle,
+		id,
+		ownerID,
+	))
+}
+
+// GrantDueEmergencyRequests grants every request whose waiting period has passed.
+func (r Repository) GrantDueEmergencyRequests(ctx context.Context) ([]models.EmergencyContact, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`UPDATE emergency_contact SET status=$1
+		WHERE status=$2 AND requested_at + wait_seconds * interval '1 second' <= now()
+		RETURNING `+emergencyContactColumns,
+		models.EmergencyStatusGranted,
+		models.EmergencyStatusRequested,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.EmergencyContact, error) {
+		return scanEmergencyContact(row)
+	})
+}
+
+func (r Repository) HasEmergencyAccess(ctx context.Context, granteeID, ownerID uuid.UUID) (bool, error) {
+	var ok bool
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM emergency_contact WHERE grantee_id=$1 AND owner_id=$2 AND status=$3)",
+		granteeID,
+		ownerID,
+		models.EmergencyStatusGranted,
+	).Scan(&ok)
+	return ok, err
+}
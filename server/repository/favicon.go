@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+func (r Repository) GetFavicon(ctx context.Context, host string) (models.Favicon, error) {
+	var f models.Favicon
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT host, content_type, data, fetched_at FROM favicon WHERE host=$1",
+		host,
+	).Scan(&f.Host, &f.ContentType, &f.Data, &f.FetchedAt)
+	return f, err
+}
+
+func (r Repository) UpsertFavicon(ctx context.Context, f models.Favicon) error {
+	_, err := r.pool.Exec(
+		ctx,
+		`INSERT INTO favicon (host, content_type, data, fetched_at) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (host) DO UPDATE SET content_type=excluded.content_type, data=excluded.data,
+			fetched_at=excluded.fetched_at`,
+		f.Host,
+		f.ContentType,
+		f.Data,
+		f.FetchedAt,
+	)
+	return err
+}
+
+// HasLoginURLHost reports whether one of the user's login items has a URL on host.
+func (r Repository) HasLoginURLHost(ctx context.Context, userID uuid.UUID, host string) (bool, error) {
+	var ok bool
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM login_url WHERE user_id=$1 AND host=$2)",
+		userID,
+		host,
+	).Scan(&ok)
+	return ok, err
+}
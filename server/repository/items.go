@@ -0,0 +1,295 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// itemTables lists the tables of vault items.
+var itemTables = []struct {
+	name     string
+	itemType models.ItemType
+	// size is the size of the secrets of an item in bytes.
+	size string
+	// title is the column shown in listings.
+	title string
+}{
+	{"login_password", models.ItemTypeLoginPassword, "octet_length(login)+octet_length(password)", "login"},
+	{"wifi_credential", models.ItemTypeWifiCredential, "octet_length(ssid)+octet_length(password)", "ssid"},
+	{"seed_phrase", models.ItemTypeSeedPhrase, "octet_length(words)", "name"},
+}
+
+// allItemsSQL is a subquery of the columns of items of every type, along with their item_type, size and title.
+func allItemsSQL(columns string) string {
+	selects := make([]string, 0, len(itemTables))
+	for _, t := range itemTables {
+		selects = append(selects, "SELECT "+columns+", '"+string(t.itemType)+"'::text AS item_type, "+
+			t.size+" AS size, "+t.title+" AS title FROM "+t.name)
+	}
+	return "(" + strings.Join(selects, " UNION ALL ") + ") item"
+}
+
+// updateAllItemsSQL is a statement updating items of every type and returning the id and item_type of each.
+// set and where refer to the table as item, from is an optional FROM clause starting with a space.
+func updateAllItemsSQL(from, set, where string) string {
+	updates := make([]string, 0, len(itemTables))
+	selects := make([]string, 0, len(itemTables))
+	for i, t := range itemTables {
+		name := "u" + strconv.Itoa(i)
+		updates = append(
+			updates,
+			name+" AS (UPDATE "+t.name+" item SET "+set+from+" WHERE "+where+" RETURNING item.id)",
+		)
+		selects = append(selects, "SELECT id, '"+string(t.itemType)+"'::text FROM "+name)
+	}
+	return "WITH " + strings.Join(updates, ", ") + " " + strings.Join(selects, " UNION ALL ")
+}
+
+// updateItems runs a statement of updateAllItemsSQL with the bumped vault revision as its last argument
+// and returns the change events of the updated items. It returns pgx.ErrNoRows, changing nothing,
+// unless it updated want items.
+func (r Repository) updateItems(
+	ctx context.Context,
+	userID uuid.UUID,
+	want int,
+	sql string,
+	args ...any,
+) ([]models.ChangeEvent, error) {
+	var events []models.ChangeEvent
+	_, err := r.withRevision(ctx, userID, func(tx pgx.Tx, revision int64) error {
+		rows, err := tx.Query(ctx, sql, append(slices.Clip(args), revision)...)
+		if err != nil {
+			return err
+		}
+		events, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.ChangeEvent, error) {
+			ev := models.ChangeEvent{Operation: models.OperationUpdated, Revision: revision}
+			err := row.Scan(&ev.ItemID, &ev.ItemType)
+			return ev, err
+		})
+		if err != nil {
+			return err
+		}
+		if len(events) != want {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+	return events, err
+}
+
+// ListItemSummaries returns the user's items of every type without their secrets, pinned first,
+// then in the user's order, then newest first. If vaultID is set, only items of that vault are returned.
+// Archived items are only returned if includeArchived is set.
+func (r Repository) ListItemSummaries(
+	ctx context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	includeArchived bool,
+) ([]models.ItemSummary, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id, vault_id, item_type, title, created_at, updated_at, size, pinned, sort_index, archived
+		FROM `+allItemsSQL("id, user_id, vault_id, created_at, updated_at, pinned, sort_index, archived")+`
+		WHERE user_id=$1 AND ($2::uuid IS NULL OR vault_id=$2) AND (NOT archived OR $3)
+		ORDER BY pinned DESC, sort_index=0, sort_index, updated_at DESC, id`,
+		userID,
+		vaultID,
+		includeArchived,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[models.ItemSummary])
+}
+
+// ListItemsPage returns up to limit items of every type with ids after the given one, ordered by id.
+// If vaultID is set only items of that vault are returned. Items deleted while the page is read are skipped.
+func (r Repository) ListItemsPage(
+	ctx context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	after uuid.UUID,
+	limit int,
+) ([]models.VaultItem, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT id, item_type FROM `+allItemsSQL("id, user_id, vault_id")+`
+		WHERE user_id=$1 AND ($2::uuid IS NULL OR vault_id=$2) AND id>$3 ORDER BY id LIMIT $4`,
+		userID,
+		vaultID,
+		after,
+		limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ids      []uuid.UUID
+		byType   = make(map[models.ItemType][]uuid.UUID)
+		id       uuid.UUID
+		itemType models.ItemType
+	)
+	_, err = pgx.ForEachRow(rows, []any{&id, &itemType}, func() error {
+		ids = append(ids, id)
+		byType[itemType] = append(byType[itemType], id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[uuid.UUID]models.VaultItem, len(ids))
+	lps, err := listItemsByID(
+		ctx,
+		r,
+		"login_password",
+		loginPasswordColumns,
+		userID,
+		byType[models.ItemTypeLoginPassword],
+		scanLoginPassword,
+	)
+	if err == nil {
+		err = r.loadURLs(ctx, lps)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range lps {
+		items[*lps[i].ID] = models.VaultItem{LoginPassword: &lps[i]}
+	}
+	ws, err := listItemsByID(
+		ctx,
+		r,
+		"wifi_credential",
+		wifiCredentialColumns,
+		userID,
+		byType[models.ItemTypeWifiCredential],
+		scanWifiCredential,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ws {
+		items[*ws[i].ID] = models.VaultItem{WifiCredential: &ws[i]}
+	}
+	sps, err := listItemsByID(
+		ctx,
+		r,
+		"seed_phrase",
+		seedPhraseColumns,
+		userID,
+		byType[models.ItemTypeSeedPhrase],
+		scanSeedPhrase,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for i := range sps {
+		items[*sps[i].ID] = models.VaultItem{SeedPhrase: &sps[i]}
+	}
+
+	page := make([]models.VaultItem, 0, len(ids))
+	for _, id := range ids {
+		if it, ok := items[id]; ok {
+			page = append(page, it)
+		}
+	}
+	return page, nil
+}
+
+// listItemsByID returns the user's items with the ids from the table, read with columns and scan.
+func listItemsByID[T any](
+	ctx context.Context,
+	r Repository,
+	table, columns string,
+	userID uuid.UUID,
+	ids []uuid.UUID,
+	scan pgx.RowToFunc[T],
+) ([]T, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := r.pool.Query(ctx, "SELECT "+columns+" FROM "+table+" WHERE user_id=$1 AND id=ANY($2)", userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, scan)
+}
+
+// SetItemPinned pins or unpins the user's item of any type, bumping the vault revision.
+func (r Repository) SetItemPinned(ctx context.Context, userID, id uuid.UUID, pinned bool) (models.ChangeEvent, error) {
+	events, err := r.updateItems(
+		ctx,
+		userID,
+		1,
+		updateAllItemsSQL("", "pinned=$3, revision=$4", "id=$1 AND user_id=$2"),
+		id,
+		userID,
+		pinned,
+	)
+	if err != nil {
+		return models.ChangeEvent{}, err
+	}
+	return events[0], nil
+}
+
+// TouchItem records that the user's item of any type was used, bumping the vault revision.
+func (r Repository) TouchItem(ctx context.Context, userID, id uuid.UUID) (models.ChangeEvent, error) {
+	events, err := r.updateItems(
+		ctx,
+		userID,
+		1,
+		updateAllItemsSQL("", "last_used_at=now(), revision=$3", "id=$1 AND user_id=$2"),
+		id,
+		userID,
+	)
+	if err != nil {
+		return models.ChangeEvent{}, err
+	}
+	return events[0], nil
+}
+
+// SetItemArchived archives or restores the user's item of any type, bumping the vault revision.
+func (r Repository) SetItemArchived(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	archived bool,
+) (models.ChangeEvent, error) {
+	events, err := r.updateItems(
+		ctx,
+		userID,
+		1,
+		updateAllItemsSQL("", "archived=$3, revision=$4", "id=$1 AND user_id=$2"),
+		id,
+		userID,
+		archived,
+	)
+	if err != nil {
+		return models.ChangeEvent{}, err
+	}
+	return events[0], nil
+}
+
+// SetItemOrder numbers the user's items from 1 in the order of ids, other items keep their place.
+// It returns pgx.ErrNoRows, changing nothing, unless every id is an item of the user.
+func (r Repository) SetItemOrder(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]models.ChangeEvent, error) {
+	return r.updateItems(
+		ctx,
+		userID,
+		len(ids),
+		updateAllItemsSQL(
+			" FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, n)",
+			"sort_index=o.n, revision=$3",
+			"item.id=o.id AND item.user_id=$1",
+		),
+		userID,
+		ids,
+	)
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// saveURLs replaces the URLs of a login password item.
+func saveURLs(ctx context.Context, tx pgx.Tx, lp models.LoginPassword, id uuid.UUID) error {
+	_, err := tx.Exec(ctx, "DELETE FROM login_url WHERE item_id=$1", id)
+	if err != nil {
+		return err
+	}
+	for i, u := range lp.URLs {
+		_, err = tx.Exec(
+			ctx,
+			"INSERT INTO login_url (item_id, user_id, position, url, match, host) VALUES ($1, $2, $3, $4, $5, $6)",
+			id,
+			lp.UserID,
+			i,
+			u.URL,
+			u.Match,
+			u.Host,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadURLs fills in the URLs of the login password items.
+func (r Repository) loadURLs(ctx context.Context, lps []models.LoginPassword) error {
+	if len(lps) == 0 {
+		return nil
+	}
+	byID := make(map[uuid.UUID]*models.LoginPassword, len(lps))
+	ids := make([]uuid.UUID, 0, len(lps))
+	for i := range lps {
+		byID[*lps[i].ID] = &lps[i]
+		ids = append(ids, *lps[i].ID)
+	}
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT item_id, url, match, host FROM login_url WHERE item_id=ANY($1) ORDER BY item_id, position",
+		ids,
+	)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id uuid.UUID
+		var u models.LoginURL
+		err = rows.Scan(&id, &u.URL, &u.Match, &u.Host)
+		if err != nil {
+			return err
+		}
+		byID[id].URLs = append(byID[id].URLs, u)
+	}
+	return rows.Err()
+}
+
+// FindLoginPasswordsByHost returns the user's login passwords with a URL on host or on a parent domain of host,
+// and archived ones only if includeArchived is set.
+func (r Repository) FindLoginPasswordsByHost(
+	ctx context.Context,
+	userID uuid.UUID,
+	host string,
+	includeArchived bool,
+) ([]models.LoginPassword, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT "+loginPasswordColumns+` FROM login_password
+		WHERE user_id=$1 AND (NOT archived OR $3) AND id IN (
+			SELECT item_id FROM login_url WHERE user_id=$1 AND ($2=host OR right($2, length(host)+1)='.'||host)
+		)`,
+		userID,
+		host,
+		includeArchived,
+	)
+	if err != nil {
+		return nil, err
+	}
+	lps, err := pgx.CollectRows(rows, scanLoginPassword)
+	if err != nil {
+		return nil, err
+	}
+	return lps, r.loadURLs(ctx, lps)
+}
@@ -0,0 +1,374 @@
+package repository
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"net/netip"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+const (
+	foreignKeyViolationCode = "23503"
+	uniqueViolationCode     = "23505"
+)
+
+// Memory is a Storage keeping everything in maps, for tests and demos without Postgres.
+// It fails like the database on the unique indexes and foreign keys of the migrated schema,
+// while NOT NULL and column types are left to the Go types. It is safe for concurrent use.
+type Memory struct {
+	mu sync.RWMutex
+
+	tenants           map[uuid.UUID]models.Tenant
+	users             map[uuid.UUID]*memUser
+	vaults            map[uuid.UUID]models.Vault
+	loginPasswords    *memTable[models.LoginPassword]
+	wifiCredentials   *memTable[models.WifiCredential]
+	seedPhrases       *memTable[models.SeedPhrase]
+	tombstones        map[uuid.UUID]memTombstone
+	devices           map[uuid.UUID]models.Device
+	secretLinks       map[uuid.UUID]models.SecretLink
+	emergencyContacts map[uuid.UUID]memContact
+	shares            map[uuid.UUID]models.Share
+	favicons          map[string]models.Favicon
+}
+
+func NewMemory() *Memory {
+	return &Memory{
+		tenants:           make(map[uuid.UUID]models.Tenant),
+		users:             make(map[uuid.UUID]*memUser),
+		vaults:            make(map[uuid.UUID]models.Vault),
+		loginPasswords:    newLoginPasswordTable(),
+		wifiCredentials:   newWifiCredentialTable(),
+		seedPhrases:       newSeedPhraseTable(),
+		tombstones:        make(map[uuid.UUID]memTombstone),
+		devices:           make(map[uuid.UUID]models.Device),
+		secretLinks:       make(map[uuid.UUID]models.SecretLink),
+		emergencyContacts: make(map[uuid.UUID]memContact),
+		shares:            make(map[uuid.UUID]models.Share),
+		favicons:          make(map[string]models.Favicon),
+	}
+}
+
+// InsertUser creates an account with the login and password hash and returns its ID.
+func (m *Memory) InsertUser(_ context.Context, login string, passwordHash []byte) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.userByLogin(login); ok {
+		return uuid.Nil, uniqueViolation("user_login_uindex")
+	}
+	u := &memUser{id: uuid.New(), login: login, passwordHash: slices.Clone(passwordHash)}
+	m.users[u.id] = u
+	_, err := m.insertVault(u.id, DefaultVaultName)
+	return u.id, err
+}
+
+// GetPasswordHash returns the ID and password hash of the enabled user with the login.
+func (m *Memory) GetPasswordHash(_ context.Context, login string) (uuid.UUID, []byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.userByLogin(login)
+	if !ok || u.disabledAt != nil {
+		return uuid.Nil, nil, pgx.ErrNoRows
+	}
+	return u.id, slices.Clone(u.passwordHash), nil
+}
+
+func (m *Memory) GetRevision(_ context.Context, userID uuid.UUID) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return u.revision, nil
+}
+
+func (m *Memory) GetUserIDByLogin(_ context.Context, login string) (uuid.UUID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.userByLogin(login)
+	if !ok {
+		return uuid.Nil, pgx.ErrNoRows
+	}
+	return u.id, nil
+}
+
+func (m *Memory) GetTombstonesSince(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.Tombstone, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var ts []models.Tombstone
+	for _, t := range m.tombstones {
+		if t.userID == userID && changedSince(t.DeletedAt, t.Revision, since, sinceRevision) {
+			ts = append(ts, t.Tombstone)
+		}
+	}
+	slices.SortFunc(ts, func(a, b models.Tombstone) int { return cmp.Compare(a.Revision, b.Revision) })
+	return ts, nil
+}
+
+func (m *Memory) userByLogin(login string) (*memUser, bool) {
+	for _, u := range m.users {
+		if u.login == login {
+			return u, true
+		}
+	}
+	return nil, false
+}
+
+// checkUsers fails like a foreign key if one of the users does not exist.
+func (m *Memory) checkUsers(ids ...uuid.UUID) error {
+	for _, id := range ids {
+		if _, ok := m.users[id]; !ok {
+			return foreignKeyViolation("user")
+		}
+	}
+	return nil
+}
+
+// bump increments the user's vault revision and returns it, like withRevision.
+func (m *Memory) bump(userID uuid.UUID) (int64, error) {
+	u, ok := m.users[userID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	u.revision++
+	return u.revision, nil
+}
+
+type memUser struct {
+	id               uuid.UUID
+	login            string
+	passwordHash     []byte
+	tenantID         *uuid.UUID
+	revision         int64
+	tokenGeneration  int64
+	allowedNetworks  []netip.Prefix
+	disabledAt       *time.Time
+	recoveryCodeHash []byte
+	recoveryKey      []byte
+}
+
+type memTombstone struct {
+	models.Tombstone
+
+	userID uuid.UUID
+}
+
+type memContact struct {
+	models.EmergencyContact
+
+	createdAt time.Time
+}
+
+// memItem is a vault item along with the columns the queries filter on.
+type memItem[T any] struct {
+	item      T
+	userID    uuid.UUID
+	vaultID   uuid.UUID
+	revision  int64
+	createdAt time.Time
+	updatedAt time.Time
+	pinned    bool
+	sortIndex int
+	archived  bool
+}
+
+// memTable holds the vault items of one type.
+type memTable[T any] struct {
+	items    map[uuid.UUID]*memItem[T]
+	itemType models.ItemType
+	// stamp sets the generated columns of a stored item, prev is nil on inserts.
+	stamp func(item *T, prev *T, id uuid.UUID, revision int64, now time.Time)
+	clone func(item T) T
+	// touch sets when the item was last used.
+	touch func(item *T, at time.Time)
+	title func(item T) string
+	size  func(item T) int64
+}
+
+func (t *memTable[T]) get(userID, id uuid.UUID) (T, bool) {
+	it, ok := t.items[id]
+	if !ok || it.userID != userID {
+		var zero T
+		return zero, false
+	}
+	return t.clone(it.item), true
+}
+
+// list returns the items of the user matching keep, by revision.
+func (t *memTable[T]) list(userID uuid.UUID, keep func(it *memItem[T]) bool) []T {
+	var its []*memItem[T]
+	for _, it := range t.items {
+		if it.userID == userID && keep(it) {
+			its = append(its, it)
+		}
+	}
+	slices.SortFunc(its, func(a, b *memItem[T]) int { return cmp.Compare(a.revision, b.revision) })
+	items := make([]T, 0, len(its))
+	for _, it := range its {
+		items = append(items, t.clone(it.item))
+	}
+	return items
+}
+
+// revise sets the revision of a stored item, keeping its other generated columns.
+func (t *memTable[T]) revise(it *memItem[T], id uuid.UUID, revision int64) {
+	prev := it.item
+	t.stamp(&it.item, &prev, id, revision, it.updatedAt)
+	it.revision = revision
+}
+
+func (t *memTable[T]) changedSince(userID uuid.UUID, since time.Time, sinceRevision int64) []T {
+	return t.list(userID, func(it *memItem[T]) bool {
+		return changedSince(it.updatedAt, it.revision, since, sinceRevision)
+	})
+}
+
+// changedSince reports whether a change is after sinceRevision, or after since if sinceRevision is zero.
+// The revision takes precedence as changes of item flags bump it without touching the update time.
+func changedSince(at time.Time, revision int64, since time.Time, sinceRevision int64) bool {
+	if sinceRevision > 0 {
+		return revision > sinceRevision
+	}
+	return at.After(since)
+}
+
+// summarize appends the summaries of the items matching keep.
+func (t *memTable[T]) summarize(out []memSummary, keep func(userID, vaultID uuid.UUID) bool) []memSummary {
+	for id, it := range t.items {
+		if !keep(it.userID, it.vaultID) {
+			continue
+		}
+		out = append(out, memSummary{
+			userID: it.userID,
+			ItemSummary: models.ItemSummary{
+				ID:        id,
+				VaultID:   it.vaultID,
+				ItemType:  t.itemType,
+				Title:     t.title(it.item),
+				CreatedAt: it.createdAt,
+				UpdatedAt: it.updatedAt,
+				Size:      t.size(it.item),
+				Pinned:    it.pinned,
+				SortIndex: it.sortIndex,
+				Archived:  it.archived,
+			},
+		})
+	}
+	return out
+}
+
+type memSummary struct {
+	models.ItemSummary
+
+	userID uuid.UUID
+}
+
+// insertItem stores a new item, bumping the user's revision.
+func insertItem[T any](m *Memory, t *memTable[T], userID, vaultID uuid.UUID, item T) (uuid.UUID, int64, error) {
+	if _, ok := m.users[userID]; !ok {
+		return uuid.Nil, 0, pgx.ErrNoRows
+	}
+	if _, ok := m.vaults[vaultID]; !ok {
+		return uuid.Nil, 0, foreignKeyViolation("vault")
+	}
+	revision, err := m.bump(userID)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	id, now := uuid.New(), time.Now()
+	t.stamp(&item, nil, id, revision, now)
+	t.items[id] = &memItem[T]{
+		item:      item,
+		userID:    userID,
+		vaultID:   vaultID,
+		revision:  revision,
+		createdAt: now,
+		updatedAt: now,
+	}
+	return id, revision, nil
+}
+
+// updateItem replaces the user's item, only if it is at expectedRevision when set.
+func updateItem[T any](
+	m *Memory,
+	t *memTable[T],
+	userID, vaultID, id uuid.UUID,
+	expectedRevision *int64,
+	item T,
+) (int64, error) {
+	it, ok := t.items[id]
+	found := ok && it.userID == userID && (expectedRevision == nil || it.revision == *expectedRevision)
+	if !found {
+		return 0, pgx.ErrNoRows
+	}
+	if _, vaultOK := m.vaults[vaultID]; found && !vaultOK {
+		return 0, foreignKeyViolation("vault")
+	}
+	revision, err := m.bump(userID)
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now()
+	t.stamp(&item, &it.item, id, revision, now)
+	it.item, it.vaultID, it.revision, it.updatedAt = item, vaultID, revision, now
+	return revision, nil
+}
+
+// deleteItem removes the user's item, only if it is at expectedRevision when set, recording a tombstone.
+func deleteItem[T any](m *Memory, t *memTable[T], userID, id uuid.UUID, expectedRevision *int64) (int64, error) {
+	it, ok := t.items[id]
+	found := ok && it.userID == userID && (expectedRevision == nil || it.revision == *expectedRevision)
+	if !found {
+		return 0, pgx.ErrNoRows
+	}
+	revision, err := m.bump(userID)
+	if err != nil {
+		return 0, err
+	}
+	delete(t.items, id)
+	m.tombstones[id] = memTombstone{
+		userID: userID,
+		Tombstone: models.Tombstone{
+			ItemID:    id,
+			ItemType:  t.itemType,
+			DeletedAt: time.Now(),
+			Revision:  revision,
+		},
+	}
+	return revision, nil
+}
+
+func uniqueViolation(constraint string) error {
+	return &pgconn.PgError{
+		Code:           uniqueViolationCode,
+		ConstraintName: constraint,
+		Message:        `duplicate key value violates unique constraint "` + constraint + `"`,
+	}
+}
+
+func foreignKeyViolation(table string) error {
+	return &pgconn.PgError{
+		Code:      foreignKeyViolationCode,
+		TableName: table,
+		Message:   "insert or update violates foreign key constraint",
+	}
+}
+
+func compareUUID(a, b uuid.UUID) int {
+	return bytes.Compare(a[:], b[:])
+}
@@ -0,0 +1,738 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+func (m *Memory) InsertDevice(_ context.Context, userID uuid.UUID, name string) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.checkUsers(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	d := models.Device{ID: uuid.New(), UserID: userID, Name: name, CreatedAt: time.Now()}
+	m.devices[d.ID] = d
+	return d.ID, nil
+}
+
+func (m *Memory) GetDevice(_ context.Context, userID, id uuid.UUID) (models.Device, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	d, ok := m.devices[id]
+	if !ok || d.UserID != userID {
+		return models.Device{}, pgx.ErrNoRows
+	}
+	return d, nil
+}
+
+func (m *Memory) ListDevices(_ context.Context, userID uuid.UUID) ([]models.Device, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var devices []models.Device
+	for _, d := range m.devices {
+		if d.UserID == userID {
+			devices = append(devices, d)
+		}
+	}
+	slices.SortFunc(devices, func(a, b models.Device) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return devices, nil
+}
+
+func (m *Memory) RenameDevice(_ context.Context, userID, id uuid.UUID, name string) error {
+	return m.updateDevice(userID, id, func(d *models.Device) bool {
+		d.Name = name
+		return true
+	})
+}
+
+func (m *Memory) RevokeDevice(_ context.Context, userID, id uuid.UUID) error {
+	return m.updateDevice(userID, id, func(d *models.Device) bool {
+		if d.RevokedAt == nil {
+			now := time.Now()
+			d.RevokedAt = &now
+		}
+		return true
+	})
+}
+
+func (m *Memory) TouchDevice(_ context.Context, userID, id uuid.UUID) error {
+	return m.updateDevice(userID, id, func(d *models.Device) bool {
+		if d.RevokedAt != nil {
+			return false
+		}
+		now := time.Now()
+		d.LastSyncAt = &now
+		return true
+	})
+}
+
+// updateDevice applies fn to the user's device, returning pgx.ErrNoRows if there is none or fn refuses it.
+func (m *Memory) updateDevice(userID, id uuid.UUID, fn func(d *models.Device) bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, ok := m.devices[id]
+	if !ok || d.UserID != userID || !fn(&d) {
+		return pgx.ErrNoRows
+	}
+	m.devices[id] = d
+	return nil
+}
+
+func (m *Memory) InsertSecretLink(_ context.Context, l models.SecretLink) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.checkUsers(l.UserID)
+	if err != nil {
+		return err
+	}
+	if _, ok := m.secretLinks[l.ID]; ok {
+		return uniqueViolation("secret_link_pkey")
+	}
+	l.Ciphertext, l.Views = slices.Clone(l.Ciphertext), 0
+	m.secretLinks[l.ID] = l
+	return nil
+}
+
+// ConsumeSecretLink counts a view of a live link and returns it, deleting the link on its last view.
+// It returns pgx.ErrNoRows if the link does not exist, expired or has no views left.
+func (m *Memory) ConsumeSecretLink(_ context.Context, id uuid.UUID) (models.SecretLink, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	l, ok := m.secretLinks[id]
+	if !ok || !l.ExpiresAt.After(time.Now()) || l.Views >= l.MaxViews {
+		return models.SecretLink{}, pgx.ErrNoRows
+	}
+	l.Views++
+	m.secretLinks[id] = l
+	if l.Views >= l.MaxViews {
+		delete(m.secretLinks, id)
+	}
+	l.Ciphertext = slices.Clone(l.Ciphertext)
+	return l, nil
+}
+
+func (m *Memory) DeleteExpiredSecretLinks(_ context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var n int64
+	now := time.Now()
+	for id, l := range m.secretLinks {
+		if !l.ExpiresAt.After(now) {
+			delete(m.secretLinks, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (m *Memory) InsertEmergencyContact(
+	_ context.Context,
+	ownerID, granteeID uuid.UUID,
+	wait time.Duration,
+) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.checkUsers(ownerID, granteeID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for _, c := range m.emergencyContacts {
+		if c.OwnerID == ownerID && c.GranteeID == granteeID {
+			return uuid.Nil, uniqueViolation("emergency_contact_owner_id_grantee_id_uindex")
+		}
+	}
+	c := memContact{
+		EmergencyContact: models.EmergencyContact{
+			ID:        uuid.New(),
+			OwnerID:   ownerID,
+			GranteeID: granteeID,
+			Wait:      wait.Truncate(time.Second),
+			Status:    models.EmergencyStatusIdle,
+		},
+		createdAt: time.Now(),
+	}
+	m.emergencyContacts[c.ID] = c
+	return c.ID, nil
+}
+
+func (m *Memory) DeleteEmergencyContact(_ context.Context, ownerID, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.emergencyContacts[id]
+	if !ok || c.OwnerID != ownerID {
+		return pgx.ErrNoRows
+	}
+	delete(m.emergencyContacts, id)
+	return nil
+}
+
+// ListEmergencyContacts returns contacts where the user is either the owner or the grantee.
+func (m *Memory) ListEmergencyContacts(_ context.Context, userID uuid.UUID) ([]models.EmergencyContact, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var cs []memContact
+	for _, c := range m.emergencyContacts {
+		if c.OwnerID == userID || c.GranteeID == userID {
+			cs = append(cs, c)
+		}
+	}
+	slices.SortFunc(cs, func(a, b memContact) int { return a.createdAt.Compare(b.createdAt) })
+	contacts := make([]models.EmergencyContact, 0, len(cs))
+	for _, c := range cs {
+		contact := copyContact(c.EmergencyContact)
+		contact.OwnerLogin = m.users[c.OwnerID].login
+		contact.GranteeLogin = m.users[c.GranteeID].login
+		contacts = append(contacts, contact)
+	}
+	return contacts, nil
+}
+
+// RequestEmergencyAccess starts the waiting period of an idle contact on behalf of its grantee.
+func (m *Memory) RequestEmergencyAccess(
+	_ context.Context,
+	granteeID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return m.updateContact(id, func(c *models.EmergencyContact) bool {
+		if c.GranteeID != granteeID || c.Status != models.EmergencyStatusIdle {
+			return false
+		}
+		now := time.Now()
+		c.Status, c.RequestedAt = models.EmergencyStatusRequested, &now
+		return true
+	})
+}
+
+// ApproveEmergencyAccess grants a pending request before its waiting period ends.
+func (m *Memory) ApproveEmergencyAccess(
+	_ context.Context,
+	ownerID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return m.updateContact(id, func(c *models.EmergencyContact) bool {
+		if c.OwnerID != ownerID || c.Status != models.EmergencyStatusRequested {
+			return false
+		}
+		c.Status = models.EmergencyStatusGranted
+		return true
+	})
+}
+
+// RejectEmergencyAccess rejects a pending request or takes back granted access.
+func (m *Memory) RejectEmergencyAccess(
+	_ context.Context,
+	ownerID, id uuid.UUID,
+) (models.EmergencyContact, error) {
+	return m.updateContact(id, func(c *models.EmergencyContact) bool {
+		if c.OwnerID != ownerID || c.Status == models.EmergencyStatusIdle {
+			return false
+		}
+		c.Status, c.RequestedAt = models.EmergencyStatusIdle, nil
+		return true
+	})
+}
+
+// GrantDueEmergencyRequests grants every request whose waiting period has passed.
+func (m *Memory) GrantDueEmergencyRequests(_ context.Context) ([]models.EmergencyContact, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var granted []models.EmergencyContact
+	now := time.Now()
+	for id, c := range m.emergencyContacts {
+		if c.Status != models.EmergencyStatusRequested || c.RequestedAt.Add(c.Wait).After(now) {
+			continue
+		}
+		c.Status = models.EmergencyStatusGranted
+		m.emergencyContacts[id] = c
+		granted = append(granted, copyContact(c.EmergencyContact))
+	}
+	return granted, nil
+}
+
+func (m *Memory) HasEmergencyAccess(_ context.Context, granteeID, ownerID uuid.UUID) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, c := range m.emergencyContacts {
+		if c.GranteeID == granteeID && c.OwnerID == ownerID && c.Status == models.EmergencyStatusGranted {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// updateContact applies fn to the contact and returns it, or pgx.ErrNoRows if there is none or fn refuses it.
+func (m *Memory) updateContact(
+	id uuid.UUID,
+	fn func(c *models.EmergencyContact) bool,
+) (models.EmergencyContact, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	c, ok := m.emergencyContacts[id]
+	if !ok || !fn(&c.EmergencyContact) {
+		return models.EmergencyContact{}, pgx.ErrNoRows
+	}
+	m.emergencyContacts[id] = c
+	return copyContact(c.EmergencyContact), nil
+}
+
+func copyContact(c models.EmergencyContact) models.EmergencyContact {
+	if c.RequestedAt != nil {
+		requestedAt := *c.RequestedAt
+		c.RequestedAt = &requestedAt
+	}
+	return c
+}
+
+// InsertShare grants the share, replacing an earlier grant of the same item to the same user.
+func (m *Memory) InsertShare(_ context.Context, sh models.Share) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	err := m.checkUsers(sh.OwnerID, sh.GranteeID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	for id, old := range m.shares {
+		if old.ItemID == sh.ItemID && old.GranteeID == sh.GranteeID {
+			old.ReadOnly, old.ExpiresAt = sh.ReadOnly, sh.ExpiresAt
+			m.shares[id] = old
+			return id, nil
+		}
+	}
+	sh.ID, sh.GranteeLogin, sh.CreatedAt = uuid.New(), "", time.Now()
+	m.shares[sh.ID] = sh
+	return sh.ID, nil
+}
+
+func (m *Memory) ListSharesByOwner(_ context.Context, ownerID uuid.UUID) ([]models.Share, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var shares []models.Share
+	for _, sh := range m.shares {
+		if sh.OwnerID == ownerID {
+			sh.GranteeLogin = m.users[sh.GranteeID].login
+			shares = append(shares, sh)
+		}
+	}
+	slices.SortFunc(shares, func(a, b models.Share) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return shares, nil
+}
+
+func (m *Memory) DeleteShare(_ context.Context, ownerID, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	sh, ok := m.shares[id]
+	if !ok || sh.OwnerID != ownerID {
+		return pgx.ErrNoRows
+	}
+	delete(m.shares, id)
+	return nil
+}
+
+// GetActiveShare returns the unexpired grant of the item to the user or pgx.ErrNoRows.
+func (m *Memory) GetActiveShare(_ context.Context, itemID, granteeID uuid.UUID) (models.Share, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	now := time.Now()
+	for _, sh := range m.shares {
+		if sh.ItemID == itemID && sh.GranteeID == granteeID && (sh.ExpiresAt == nil || sh.ExpiresAt.After(now)) {
+			return sh, nil
+		}
+	}
+	return models.Share{}, pgx.ErrNoRows
+}
+
+func (m *Memory) GetFavicon(_ context.Context, host string) (models.Favicon, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	f, ok := m.favicons[host]
+	if !ok {
+		return models.Favicon{}, pgx.ErrNoRows
+	}
+	f.Data = slices.Clone(f.Data)
+	return f, nil
+}
+
+func (m *Memory) UpsertFavicon(_ context.Context, f models.Favicon) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	f.Data = slices.Clone(f.Data)
+	m.favicons[f.Host] = f
+	return nil
+}
+
+// SetRecoveryCode stores the hash of the user's new recovery code, dropping the key wrapped with the old one.
+func (m *Memory) SetRecoveryCode(_ context.Context, userID uuid.UUID, codeHash []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	u.recoveryCodeHash, u.recoveryKey = slices.Clone(codeHash), nil
+	return nil
+}
+
+// SetRecoveryKey stores the key wrapped with the user's recovery code.
+// It returns pgx.ErrNoRows if the code hash is not the current one.
+func (m *Memory) SetRecoveryKey(_ context.Context, userID uuid.UUID, codeHash, wrappedKey []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.users[userID]
+	if !ok || u.recoveryCodeHash == nil || !bytes.Equal(u.recoveryCodeHash, codeHash) {
+		return pgx.ErrNoRows
+	}
+	u.recoveryKey = slices.Clone(wrappedKey)
+	return nil
+}
+
+// ConsumeRecovery clears the recovery of the enabled user with the login and code hash, sets their password
+// and revokes their tokens. It returns their ID, wrapped key and the generation of new tokens,
+// or pgx.ErrNoRows if there is no such recovery.
+func (m *Memory) ConsumeRecovery(
+	_ context.Context,
+	login string,
+	codeHash, passwordHash []byte,
+) (uuid.UUID, []byte, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.userByLogin(login)
+	if !ok || u.disabledAt != nil || u.recoveryCodeHash == nil || !bytes.Equal(u.recoveryCodeHash, codeHash) {
+		return uuid.Nil, nil, 0, pgx.ErrNoRows
+	}
+	wrappedKey := u.recoveryKey
+	u.recoveryCodeHash, u.recoveryKey = nil, nil
+	u.passwordHash = slices.Clone(passwordHash)
+	u.tokenGeneration++
+	return u.id, wrappedKey, u.tokenGeneration, nil
+}
+
+// GetTokenGeneration returns the generation of the user's access tokens, older tokens are revoked.
+func (m *Memory) GetTokenGeneration(_ context.Context, userID uuid.UUID) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	return u.tokenGeneration, nil
+}
+
+// RevokeTokens revokes every access token of the user, returning the generation of new ones.
+func (m *Memory) RevokeTokens(_ context.Context, userID uuid.UUID) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	u.tokenGeneration++
+	return u.tokenGeneration, nil
+}
+
+// SetPassword replaces the user's password hash and revokes their access tokens,
+// returning the generation of new ones.
+func (m *Memory) SetPassword(_ context.Context, userID uuid.UUID, passwordHash []byte) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return 0, pgx.ErrNoRows
+	}
+	u.passwordHash = slices.Clone(passwordHash)
+	u.tokenGeneration++
+	return u.tokenGeneration, nil
+}
+
+// GetAccountAuth returns the state of the account checked on every authenticated call.
+func (m *Memory) GetAccountAuth(_ context.Context, userID uuid.UUID) (models.AccountAuth, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return models.AccountAuth{}, pgx.ErrNoRows
+	}
+	return models.AccountAuth{
+		TokenGeneration: u.tokenGeneration,
+		AllowedNetworks: slices.Clone(u.allowedNetworks),
+	}, nil
+}
+
+// GetAccount returns the record of the user.
+func (m *Memory) GetAccount(_ context.Context, userID uuid.UUID) (models.Account, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return models.Account{}, pgx.ErrNoRows
+	}
+	return models.Account{
+		ID:              u.id,
+		Login:           u.login,
+		TenantID:        cloneID(u.tenantID),
+		DisabledAt:      u.disabledAt,
+		AllowedNetworks: slices.Clone(u.allowedNetworks),
+		HasRecoveryKit:  u.recoveryCodeHash != nil,
+	}, nil
+}
+
+// SetAllowedNetworks restricts the account to the networks, or lets it be used from anywhere if empty.
+func (m *Memory) SetAllowedNetworks(_ context.Context, userID uuid.UUID, networks []netip.Prefix) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	u.allowedNetworks = slices.Clone(networks)
+	return nil
+}
+
+// ListUsers returns every account, or only those of the tenant if tenantID is set.
+func (m *Memory) ListUsers(_ context.Context, tenantID *uuid.UUID) ([]models.UserSummary, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	users := make([]models.UserSummary, 0, len(m.users))
+	for _, u := range m.users {
+		if tenantID != nil && !sameTenant(u.tenantID, tenantID) {
+			continue
+		}
+		items := m.summaries(func(owner, _ uuid.UUID) bool { return owner == u.id })
+		users = append(users, models.UserSummary{
+			ID:           u.id,
+			Login:        u.login,
+			TenantID:     cloneID(u.tenantID),
+			DisabledAt:   u.disabledAt,
+			Items:        int64(len(items)),
+			StorageBytes: m.storageBytes(u.id),
+		})
+	}
+	slices.SortFunc(users, func(a, b models.UserSummary) int { return strings.Compare(a.Login, b.Login) })
+	return users, nil
+}
+
+// GetAccountUsage returns the usage of the user's account.
+func (m *Memory) GetAccountUsage(_ context.Context, userID uuid.UUID) (models.AccountUsage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if _, ok := m.users[userID]; !ok {
+		return models.AccountUsage{}, pgx.ErrNoRows
+	}
+	usage := models.AccountUsage{
+		Items:        make(map[models.ItemType]int64, len(itemTables)),
+		Vaults:       int64(len(m.userVaults(userID))),
+		StorageBytes: m.storageBytes(userID),
+	}
+	for _, t := range itemTables {
+		usage.Items[t.itemType] = 0
+	}
+	for _, it := range m.summaries(func(owner, _ uuid.UUID) bool { return owner == userID }) {
+		usage.Items[it.ItemType]++
+	}
+	for _, d := range m.devices {
+		if d.UserID != userID {
+			continue
+		}
+		if d.RevokedAt == nil {
+			usage.Devices++
+		}
+		if d.LastSyncAt != nil && (usage.LastSyncAt == nil || d.LastSyncAt.After(*usage.LastSyncAt)) {
+			usage.LastSyncAt = d.LastSyncAt
+		}
+	}
+	return usage, nil
+}
+
+// SetUserDisabled disables or enables the account with the login. Disabling revokes its tokens.
+func (m *Memory) SetUserDisabled(_ context.Context, login string, disabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.userByLogin(login)
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	switch {
+	case !disabled:
+		u.disabledAt = nil
+	case u.disabledAt == nil:
+		now := time.Now()
+		u.disabledAt = &now
+	}
+	if disabled {
+		u.tokenGeneration++
+	}
+	return nil
+}
+
+// GetStorageUsage reports the size of the stored secrets, as there are no files on disk.
+func (m *Memory) GetStorageUsage(_ context.Context) (models.StorageUsage, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	sizes := make(map[string]int64, len(backupTables))
+	for _, it := range m.summaries(func(uuid.UUID, uuid.UUID) bool { return true }) {
+		sizes[string(it.ItemType)] += it.Size
+	}
+	for _, l := range m.secretLinks {
+		sizes["secret_link"] += int64(len(l.Ciphertext))
+	}
+	var usage models.StorageUsage
+	for _, t := range backupTables {
+		usage.Tables = append(usage.Tables, models.TableSize{Name: t.name, Bytes: sizes[t.name]})
+		usage.DatabaseBytes += sizes[t.name]
+	}
+	return usage, nil
+}
+
+// GetUsageCounts counts the users and the items of each type of the whole server.
+func (m *Memory) GetUsageCounts(_ context.Context) (models.UsageCounts, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	counts := models.UsageCounts{Users: int64(len(m.users)), Items: make(map[models.ItemType]int64, len(itemTables))}
+	for _, t := range itemTables {
+		counts.Items[t.itemType] = 0
+	}
+	for _, it := range m.summaries(func(uuid.UUID, uuid.UUID) bool { return true }) {
+		counts.Items[it.ItemType]++
+	}
+	return counts, nil
+}
+
+// storageBytes sums the size of the secrets stored by the user.
+func (m *Memory) storageBytes(userID uuid.UUID) int64 {
+	var n int64
+	for _, it := range m.summaries(func(owner, _ uuid.UUID) bool { return owner == userID }) {
+		n += it.Size
+	}
+	for _, l := range m.secretLinks {
+		if l.UserID == userID {
+			n += int64(len(l.Ciphertext))
+		}
+	}
+	return n
+}
+
+func (m *Memory) InsertTenant(_ context.Context, name string, quotaBytes int64) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, t := range m.tenants {
+		if t.Name == name {
+			return uuid.Nil, uniqueViolation("tenant_name_uindex")
+		}
+	}
+	t := models.Tenant{ID: uuid.New(), Name: name, StorageQuotaBytes: quotaBytes, CreatedAt: time.Now()}
+	m.tenants[t.ID] = t
+	return t.ID, nil
+}
+
+func (m *Memory) ListTenants(_ context.Context) ([]models.Tenant, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	tenants := make([]models.Tenant, 0, len(m.tenants))
+	for _, t := range m.tenants {
+		for _, u := range m.users {
+			if sameTenant(u.tenantID, &t.ID) {
+				t.Users++
+			}
+		}
+		t.StorageBytes = m.tenantStorageBytes(t.ID)
+		tenants = append(tenants, t)
+	}
+	slices.SortFunc(tenants, func(a, b models.Tenant) int { return strings.Compare(a.Name, b.Name) })
+	return tenants, nil
+}
+
+func (m *Memory) SetTenantQuota(_ context.Context, id uuid.UUID, quotaBytes int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	t, ok := m.tenants[id]
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	t.StorageQuotaBytes = quotaBytes
+	m.tenants[id] = t
+	return nil
+}
+
+// SetUserTenant moves the account with the login to the tenant, or out of any tenant if tenantID is nil.
+func (m *Memory) SetUserTenant(_ context.Context, login string, tenantID *uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	u, ok := m.userByLogin(login)
+	if !ok {
+		return pgx.ErrNoRows
+	}
+	if tenantID != nil {
+		if _, ok = m.tenants[*tenantID]; !ok {
+			return foreignKeyViolation("tenant")
+		}
+	}
+	u.tenantID = cloneID(tenantID)
+	return nil
+}
+
+// GetTenantPeerID returns the ID of the account with the login if it is in the same tenant as the user.
+func (m *Memory) GetTenantPeerID(_ context.Context, userID uuid.UUID, login string) (uuid.UUID, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return uuid.Nil, pgx.ErrNoRows
+	}
+	p, ok := m.userByLogin(login)
+	if !ok || !sameTenant(p.tenantID, u.tenantID) {
+		return uuid.Nil, pgx.ErrNoRows
+	}
+	return p.id, nil
+}
+
+// GetStorageQuota returns the storage quota of the user's tenant, unlimited for users outside any tenant.
+func (m *Memory) GetStorageQuota(_ context.Context, userID uuid.UUID) (models.StorageQuota, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	u, ok := m.users[userID]
+	if !ok {
+		return models.StorageQuota{}, pgx.ErrNoRows
+	}
+	if u.tenantID == nil {
+		return models.StorageQuota{}, nil
+	}
+	return models.StorageQuota{
+		QuotaBytes: m.tenants[*u.tenantID].StorageQuotaBytes,
+		UsedBytes:  m.tenantStorageBytes(*u.tenantID),
+	}, nil
+}
+
+// tenantStorageBytes sums the size of the secrets stored by the users of the tenant.
+func (m *Memory) tenantStorageBytes(tenantID uuid.UUID) int64 {
+	var n int64
+	for _, u := range m.users {
+		if sameTenant(u.tenantID, &tenantID) {
+			n += m.storageBytes(u.id)
+		}
+	}
+	return n
+}
+
+// sameTenant compares tenant IDs like IS NOT DISTINCT FROM.
+func sameTenant(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func cloneID(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return nil
+	}
+	c := *id
+	return &c
+}
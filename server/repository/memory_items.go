@@ -0,0 +1,677 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+func newLoginPasswordTable() *memTable[models.LoginPassword] {
+	return &memTable[models.LoginPassword]{
+		items:    make(map[uuid.UUID]*memItem[models.LoginPassword]),
+		itemType: models.ItemTypeLoginPassword,
+		stamp: func(lp, prev *models.LoginPassword, id uuid.UUID, revision int64, now time.Time) {
+			lp.ID, lp.UpdatedAt, lp.Revision, lp.ExpectedRevision = &id, now, revision, nil
+			lp.URLs = slices.Clone(lp.URLs)
+			lp.LastUsedAt, lp.RotationDays = nil, 0
+			if prev != nil {
+				lp.LastUsedAt, lp.RotationDays = prev.LastUsedAt, prev.RotationDays
+			}
+		},
+		clone: func(lp models.LoginPassword) models.LoginPassword {
+			id := *lp.ID
+			lp.ID = &id
+			lp.URLs = slices.Clone(lp.URLs)
+			if lp.LastUsedAt != nil {
+				lastUsedAt := *lp.LastUsedAt
+				lp.LastUsedAt = &lastUsedAt
+			}
+			return lp
+		},
+		touch: func(lp *models.LoginPassword, at time.Time) { lp.LastUsedAt = &at },
+		title: func(lp models.LoginPassword) string { return lp.Login },
+		size:  func(lp models.LoginPassword) int64 { return int64(len(lp.Login) + len(lp.Password)) },
+	}
+}
+
+func newWifiCredentialTable() *memTable[models.WifiCredential] {
+	return &memTable[models.WifiCredential]{
+		items:    make(map[uuid.UUID]*memItem[models.WifiCredential]),
+		itemType: models.ItemTypeWifiCredential,
+		stamp: func(w, prev *models.WifiCredential, id uuid.UUID, revision int64, now time.Time) {
+			w.ID, w.UpdatedAt, w.Revision, w.ExpectedRevision = &id, now, revision, nil
+			w.LastUsedAt = nil
+			if prev != nil {
+				w.LastUsedAt = prev.LastUsedAt
+			}
+		},
+		clone: func(w models.WifiCredential) models.WifiCredential {
+			id := *w.ID
+			w.ID = &id
+			if w.LastUsedAt != nil {
+				lastUsedAt := *w.LastUsedAt
+				w.LastUsedAt = &lastUsedAt
+			}
+			return w
+		},
+		touch: func(w *models.WifiCredential, at time.Time) { w.LastUsedAt = &at },
+		title: func(w models.WifiCredential) string { return w.SSID },
+		size:  func(w models.WifiCredential) int64 { return int64(len(w.SSID) + len(w.Password)) },
+	}
+}
+
+func newSeedPhraseTable() *memTable[models.SeedPhrase] {
+	return &memTable[models.SeedPhrase]{
+		items:    make(map[uuid.UUID]*memItem[models.SeedPhrase]),
+		itemType: models.ItemTypeSeedPhrase,
+		stamp: func(sp, prev *models.SeedPhrase, id uuid.UUID, revision int64, now time.Time) {
+			sp.ID, sp.UpdatedAt, sp.Revision, sp.ExpectedRevision = &id, now, revision, nil
+			// Words are read back split on spaces, like they are from the database.
+			sp.Words = strings.Fields(strings.Join(sp.Words, " "))
+			sp.LastUsedAt = nil
+			if prev != nil {
+				sp.LastUsedAt = prev.LastUsedAt
+			}
+		},
+		clone: func(sp models.SeedPhrase) models.SeedPhrase {
+			id := *sp.ID
+			sp.ID = &id
+			sp.Words = slices.Clone(sp.Words)
+			if sp.LastUsedAt != nil {
+				lastUsedAt := *sp.LastUsedAt
+				sp.LastUsedAt = &lastUsedAt
+			}
+			return sp
+		},
+		touch: func(sp *models.SeedPhrase, at time.Time) { sp.LastUsedAt = &at },
+		title: func(sp models.SeedPhrase) string { return sp.Name },
+		size:  func(sp models.SeedPhrase) int64 { return int64(len(strings.Join(sp.Words, " "))) },
+	}
+}
+
+func (m *Memory) InsertLoginPassword(_ context.Context, lp models.LoginPassword) (uuid.UUID, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return insertItem(m, m.loginPasswords, lp.UserID, lp.VaultID, lp)
+}
+
+func (m *Memory) UpdateLoginPassword(_ context.Context, lp models.LoginPassword) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return updateItem(m, m.loginPasswords, lp.UserID, lp.VaultID, itemID(lp.ID), lp.ExpectedRevision, lp)
+}
+
+// DeleteLoginPassword deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (m *Memory) DeleteLoginPassword(
+	_ context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return deleteItem(m, m.loginPasswords, userID, id, expectedRevision)
+}
+
+func (m *Memory) GetLoginPassword(_ context.Context, userID, id uuid.UUID) (models.LoginPassword, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lp, ok := m.loginPasswords.get(userID, id)
+	if !ok {
+		return models.LoginPassword{}, pgx.ErrNoRows
+	}
+	return lp, nil
+}
+
+func (m *Memory) ListLoginPasswords(ctx context.Context, userID uuid.UUID) ([]models.LoginPassword, error) {
+	return m.GetLoginPasswordsChangedSince(ctx, userID, time.Time{}, 0)
+}
+
+// GetLoginPasswordsChangedSince returns login passwords changed after sinceRevision, or after since if sinceRevision is zero.
+func (m *Memory) GetLoginPasswordsChangedSince(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.LoginPassword, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.loginPasswords.changedSince(userID, since, sinceRevision), nil
+}
+
+// ListRecentlyUsedLoginPasswords returns up to limit login passwords used after since, most recently used first.
+func (m *Memory) ListRecentlyUsedLoginPasswords(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]models.LoginPassword, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lps := m.loginPasswords.list(userID, func(it *memItem[models.LoginPassword]) bool {
+		return it.item.LastUsedAt != nil && it.item.LastUsedAt.After(since)
+	})
+	slices.SortFunc(lps, func(a, b models.LoginPassword) int { return b.LastUsedAt.Compare(*a.LastUsedAt) })
+	return lps[:min(limit, len(lps))], nil
+}
+
+// SetLoginPasswordRotation sets the rotation policy of the item and returns the new vault revision.
+func (m *Memory) SetLoginPasswordRotation(_ context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.updateLoginPassword(userID, id, func(lp *models.LoginPassword) { lp.RotationDays = days })
+}
+
+// updateLoginPassword changes the user's item with fn and returns the new vault revision.
+func (m *Memory) updateLoginPassword(userID, id uuid.UUID, fn func(lp *models.LoginPassword)) (int64, error) {
+	it, ok := m.loginPasswords.items[id]
+	if !ok || it.userID != userID {
+		return 0, pgx.ErrNoRows
+	}
+	revision, err := m.bump(userID)
+	if err != nil {
+		return 0, err
+	}
+	fn(&it.item)
+	m.loginPasswords.revise(it, id, revision)
+	return revision, nil
+}
+
+// FindLoginPasswordsByHost returns the user's login passwords with a URL on host or on a parent domain of host,
+// and archived ones only if includeArchived is set.
+func (m *Memory) FindLoginPasswordsByHost(
+	_ context.Context,
+	userID uuid.UUID,
+	host string,
+	includeArchived bool,
+) ([]models.LoginPassword, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.loginPasswords.list(userID, func(it *memItem[models.LoginPassword]) bool {
+		return (!it.archived || includeArchived) && slices.ContainsFunc(it.item.URLs, func(u models.LoginURL) bool {
+			return host == u.Host || strings.HasSuffix(host, "."+u.Host)
+		})
+	}), nil
+}
+
+// HasLoginURLHost reports whether one of the user's login items has a URL on host.
+func (m *Memory) HasLoginURLHost(_ context.Context, userID uuid.UUID, host string) (bool, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	lps := m.loginPasswords.list(userID, func(it *memItem[models.LoginPassword]) bool {
+		return slices.ContainsFunc(it.item.URLs, func(u models.LoginURL) bool { return u.Host == host })
+	})
+	return len(lps) > 0, nil
+}
+
+func (m *Memory) InsertWifiCredential(_ context.Context, w models.WifiCredential) (uuid.UUID, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return insertItem(m, m.wifiCredentials, w.UserID, w.VaultID, w)
+}
+
+// UpdateWifiCredential updates the item, only if it is at w.ExpectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (m *Memory) UpdateWifiCredential(_ context.Context, w models.WifiCredential) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return updateItem(m, m.wifiCredentials, w.UserID, w.VaultID, itemID(w.ID), w.ExpectedRevision, w)
+}
+
+// DeleteWifiCredential deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (m *Memory) DeleteWifiCredential(
+	_ context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return deleteItem(m, m.wifiCredentials, userID, id, expectedRevision)
+}
+
+func (m *Memory) GetWifiCredential(_ context.Context, userID, id uuid.UUID) (models.WifiCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	w, ok := m.wifiCredentials.get(userID, id)
+	if !ok {
+		return models.WifiCredential{}, pgx.ErrNoRows
+	}
+	return w, nil
+}
+
+// GetWifiCredentialsChangedSince returns WiFi credentials changed after sinceRevision, or after since if sinceRevision is zero.
+func (m *Memory) GetWifiCredentialsChangedSince(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.WifiCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.wifiCredentials.changedSince(userID, since, sinceRevision), nil
+}
+
+func (m *Memory) InsertSeedPhrase(_ context.Context, sp models.SeedPhrase) (uuid.UUID, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return insertItem(m, m.seedPhrases, sp.UserID, sp.VaultID, sp)
+}
+
+// UpdateSeedPhrase updates the item, only if it is at sp.ExpectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (m *Memory) UpdateSeedPhrase(_ context.Context, sp models.SeedPhrase) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return updateItem(m, m.seedPhrases, sp.UserID, sp.VaultID, itemID(sp.ID), sp.ExpectedRevision, sp)
+}
+
+// DeleteSeedPhrase deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (m *Memory) DeleteSeedPhrase(
+	_ context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return deleteItem(m, m.seedPhrases, userID, id, expectedRevision)
+}
+
+func (m *Memory) GetSeedPhrase(_ context.Context, userID, id uuid.UUID) (models.SeedPhrase, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	sp, ok := m.seedPhrases.get(userID, id)
+	if !ok {
+		return models.SeedPhrase{}, pgx.ErrNoRows
+	}
+	return sp, nil
+}
+
+// GetSeedPhrasesChangedSince returns seed phrases changed after sinceRevision, or after since if sinceRevision is zero.
+func (m *Memory) GetSeedPhrasesChangedSince(
+	_ context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.SeedPhrase, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.seedPhrases.changedSince(userID, since, sinceRevision), nil
+}
+
+// ListItemSummaries returns the user's items of every type without their secrets, pinned first,
+// then in the user's order, then newest first. If vaultID is set, only items of that vault are returned.
+// Archived items are only returned if includeArchived is set.
+func (m *Memory) ListItemSummaries(
+	_ context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	includeArchived bool,
+) ([]models.ItemSummary, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	items := m.summaries(func(owner, vault uuid.UUID) bool {
+		return owner == userID && (vaultID == nil || vault == *vaultID)
+	})
+	summaries := make([]models.ItemSummary, 0, len(items))
+	for _, it := range items {
+		if !it.Archived || includeArchived {
+			summaries = append(summaries, it.ItemSummary)
+		}
+	}
+	slices.SortFunc(summaries, func(a, b models.ItemSummary) int {
+		return cmp.Or(
+			compareBool(b.Pinned, a.Pinned),
+			compareBool(a.SortIndex == 0, b.SortIndex == 0),
+			cmp.Compare(a.SortIndex, b.SortIndex),
+			b.UpdatedAt.Compare(a.UpdatedAt),
+			compareUUID(a.ID, b.ID),
+		)
+	})
+	return summaries, nil
+}
+
+// ListItemsPage returns up to limit items of every type with ids after the given one, ordered by id.
+// If vaultID is set only items of that vault are returned.
+func (m *Memory) ListItemsPage(
+	_ context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	after uuid.UUID,
+	limit int,
+) ([]models.VaultItem, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	var page []memPageItem
+	page = pageItems(page, m.loginPasswords, userID, vaultID, after, func(lp models.LoginPassword) models.VaultItem {
+		return models.VaultItem{LoginPassword: &lp}
+	})
+	page = pageItems(page, m.wifiCredentials, userID, vaultID, after, func(w models.WifiCredential) models.VaultItem {
+		return models.VaultItem{WifiCredential: &w}
+	})
+	page = pageItems(page, m.seedPhrases, userID, vaultID, after, func(sp models.SeedPhrase) models.VaultItem {
+		return models.VaultItem{SeedPhrase: &sp}
+	})
+	slices.SortFunc(page, func(a, b memPageItem) int { return compareUUID(a.id, b.id) })
+	page = page[:min(limit, len(page))]
+	items := make([]models.VaultItem, 0, len(page))
+	for _, it := range page {
+		items = append(items, it.item)
+	}
+	return items, nil
+}
+
+type memPageItem struct {
+	id   uuid.UUID
+	item models.VaultItem
+}
+
+// pageItems appends the user's items of t with ids after the given one, only of vaultID if set.
+func pageItems[T any](
+	out []memPageItem,
+	t *memTable[T],
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	after uuid.UUID,
+	wrap func(item T) models.VaultItem,
+) []memPageItem {
+	for id, it := range t.items {
+		if it.userID == userID && (vaultID == nil || it.vaultID == *vaultID) && compareUUID(id, after) > 0 {
+			out = append(out, memPageItem{id: id, item: wrap(t.clone(it.item))})
+		}
+	}
+	return out
+}
+
+// SetItemPinned pins or unpins the user's item of any type, bumping the vault revision.
+func (m *Memory) SetItemPinned(_ context.Context, userID, id uuid.UUID, pinned bool) (models.ChangeEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.updateListing(userID, id, func(it memListing) { *it.pinned = pinned })
+}
+
+// SetItemArchived archives or restores the user's item of any type, bumping the vault revision.
+func (m *Memory) SetItemArchived(
+	_ context.Context,
+	userID, id uuid.UUID,
+	archived bool,
+) (models.ChangeEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.updateListing(userID, id, func(it memListing) { *it.archived = archived })
+}
+
+// TouchItem records that the user's item of any type was used, bumping the vault revision.
+func (m *Memory) TouchItem(_ context.Context, userID, id uuid.UUID) (models.ChangeEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	return m.updateListing(userID, id, func(it memListing) { it.touch(now) })
+}
+
+// updateListing changes how the user's item is listed with fn, bumping the vault revision.
+func (m *Memory) updateListing(userID, id uuid.UUID, fn func(it memListing)) (models.ChangeEvent, error) {
+	for _, it := range m.itemListings(userID) {
+		if it.id != id {
+			continue
+		}
+		revision, err := m.bump(userID)
+		if err != nil {
+			return models.ChangeEvent{}, err
+		}
+		fn(it)
+		it.revise(revision)
+		return models.ChangeEvent{
+			ItemID:    id,
+			ItemType:  it.itemType,
+			Operation: models.OperationUpdated,
+			Revision:  revision,
+		}, nil
+	}
+	return models.ChangeEvent{}, pgx.ErrNoRows
+}
+
+// SetItemOrder numbers the user's items from 1 in the order of ids, other items keep their place.
+// It returns pgx.ErrNoRows, changing nothing, unless every id is an item of the user.
+func (m *Memory) SetItemOrder(_ context.Context, userID uuid.UUID, ids []uuid.UUID) ([]models.ChangeEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	byID := make(map[uuid.UUID]memListing)
+	for _, it := range m.itemListings(userID) {
+		byID[it.id] = it
+	}
+	for _, id := range ids {
+		if _, ok := byID[id]; !ok {
+			return nil, pgx.ErrNoRows
+		}
+	}
+	revision, err := m.bump(userID)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]models.ChangeEvent, 0, len(ids))
+	for i, id := range ids {
+		it := byID[id]
+		*it.sortIndex = i + 1
+		it.revise(revision)
+		events = append(events, models.ChangeEvent{
+			ItemID:    id,
+			ItemType:  it.itemType,
+			Operation: models.OperationUpdated,
+			Revision:  revision,
+		})
+	}
+	return events, nil
+}
+
+// memListing points to how an item is listed to the user.
+type memListing struct {
+	id        uuid.UUID
+	itemType  models.ItemType
+	pinned    *bool
+	sortIndex *int
+	archived  *bool
+	// touch sets when the item was last used.
+	touch func(at time.Time)
+	// revise sets the revision of the item.
+	revise func(revision int64)
+}
+
+// itemListings returns how the user's items of every type are listed.
+func (m *Memory) itemListings(userID uuid.UUID) []memListing {
+	var out []memListing
+	out = appendListings(out, m.loginPasswords, userID)
+	out = appendListings(out, m.wifiCredentials, userID)
+	return appendListings(out, m.seedPhrases, userID)
+}
+
+func appendListings[T any](out []memListing, t *memTable[T], userID uuid.UUID) []memListing {
+	for id, it := range t.items {
+		if it.userID == userID {
+			out = append(out, memListing{
+				id:        id,
+				itemType:  t.itemType,
+				pinned:    &it.pinned,
+				sortIndex: &it.sortIndex,
+				archived:  &it.archived,
+				touch:     func(at time.Time) { t.touch(&it.item, at) },
+				revise:    func(revision int64) { t.revise(it, id, revision) },
+			})
+		}
+	}
+	return out
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	default:
+		return -1
+	}
+}
+
+// GetVaultStats returns the statistics of the user's vault, with up to oldest unchanged passwords.
+func (m *Memory) GetVaultStats(_ context.Context, userID uuid.UUID, oldest int) (models.VaultStats, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	stats := models.VaultStats{StorageBytes: make(map[models.ItemType]int64, len(itemTables))}
+	for _, t := range itemTables {
+		stats.StorageBytes[t.itemType] = 0
+	}
+	perMonth := make(map[time.Time]int64)
+	for _, it := range m.summaries(func(owner, _ uuid.UUID) bool { return owner == userID }) {
+		created := it.CreatedAt.UTC()
+		perMonth[time.Date(created.Year(), created.Month(), 1, 0, 0, 0, 0, time.UTC)]++
+		stats.StorageBytes[it.ItemType] += it.Size
+	}
+	for month, n := range perMonth {
+		stats.AddedPerMonth = append(stats.AddedPerMonth, models.MonthCount{Month: month, Count: n})
+	}
+	slices.SortFunc(stats.AddedPerMonth, func(a, b models.MonthCount) int { return a.Month.Compare(b.Month) })
+
+	lps := m.loginPasswords.list(userID, func(*memItem[models.LoginPassword]) bool { return true })
+	slices.SortFunc(lps, func(a, b models.LoginPassword) int {
+		return cmp.Or(a.UpdatedAt.Compare(b.UpdatedAt), compareUUID(*a.ID, *b.ID))
+	})
+	stats.OldestPasswords = lps[:min(oldest, len(lps))]
+	return stats, nil
+}
+
+func (m *Memory) InsertVault(_ context.Context, userID uuid.UUID, name string) (uuid.UUID, int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	id, err := m.insertVault(userID, name)
+	if err != nil {
+		return uuid.Nil, 0, err
+	}
+	revision, err := m.bump(userID)
+	return id, revision, err
+}
+
+func (m *Memory) GetVault(_ context.Context, userID, id uuid.UUID) (models.Vault, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.vaults[id]
+	if !ok || v.UserID != userID {
+		return models.Vault{}, pgx.ErrNoRows
+	}
+	return v, nil
+}
+
+func (m *Memory) ListVaults(_ context.Context, userID uuid.UUID) ([]models.Vault, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.userVaults(userID), nil
+}
+
+// DefaultVault returns the user's oldest vault, creating one if the user has none.
+func (m *Memory) DefaultVault(_ context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	vaults := m.userVaults(userID)
+	if len(vaults) > 0 {
+		return vaults[0].ID, nil
+	}
+	return m.insertVault(userID, DefaultVaultName)
+}
+
+func (m *Memory) RenameVault(_ context.Context, userID, id uuid.UUID, name string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.vaults[id]
+	if !ok || v.UserID != userID {
+		return 0, pgx.ErrNoRows
+	}
+	if slices.ContainsFunc(m.userVaults(userID), func(o models.Vault) bool { return o.ID != id && o.Name == name }) {
+		return 0, uniqueViolation("vault_user_id_name_uindex")
+	}
+	v.Name = name
+	m.vaults[id] = v
+	return m.bump(userID)
+}
+
+func (m *Memory) SetVaultRotation(_ context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.vaults[id]
+	if !ok || v.UserID != userID {
+		return 0, pgx.ErrNoRows
+	}
+	v.RotationDays = days
+	m.vaults[id] = v
+	return m.bump(userID)
+}
+
+// DeleteVault deletes an empty vault and returns the new vault revision.
+// It returns pgx.ErrNoRows if the vault does not exist or has items.
+func (m *Memory) DeleteVault(_ context.Context, userID, id uuid.UUID) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	v, ok := m.vaults[id]
+	if !ok || v.UserID != userID || m.countVaultItems(id) > 0 {
+		return 0, pgx.ErrNoRows
+	}
+	delete(m.vaults, id)
+	return m.bump(userID)
+}
+
+func (m *Memory) CountVaultItems(_ context.Context, id uuid.UUID) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.countVaultItems(id), nil
+}
+
+func (m *Memory) insertVault(userID uuid.UUID, name string) (uuid.UUID, error) {
+	err := m.checkUsers(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if slices.ContainsFunc(m.userVaults(userID), func(v models.Vault) bool { return v.Name == name }) {
+		return uuid.Nil, uniqueViolation("vault_user_id_name_uindex")
+	}
+	v := models.Vault{ID: uuid.New(), UserID: userID, Name: name, CreatedAt: time.Now()}
+	m.vaults[v.ID] = v
+	return v.ID, nil
+}
+
+// userVaults returns the user's vaults, oldest first.
+func (m *Memory) userVaults(userID uuid.UUID) []models.Vault {
+	var vaults []models.Vault
+	for _, v := range m.vaults {
+		if v.UserID == userID {
+			vaults = append(vaults, v)
+		}
+	}
+	slices.SortFunc(vaults, func(a, b models.Vault) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return vaults
+}
+
+func (m *Memory) countVaultItems(id uuid.UUID) int64 {
+	return int64(len(m.summaries(func(_, vaultID uuid.UUID) bool { return vaultID == id })))
+}
+
+// summaries returns the items of every type matching keep.
+func (m *Memory) summaries(keep func(userID, vaultID uuid.UUID) bool) []memSummary {
+	items := m.loginPasswords.summarize(nil, keep)
+	items = m.wifiCredentials.summarize(items, keep)
+	return m.seedPhrases.summarize(items, keep)
+}
+
+// itemID dereferences the ID of an item to update, which never matches when unset.
+func itemID(id *uuid.UUID) uuid.UUID {
+	if id == nil {
+		return uuid.Nil
+	}
+	return *id
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/cmrd-a/GophKeeper/migrations"
+)
+
+const (
+	readyInitialBackoff = 100 * time.Millisecond
+	readyMaxBackoff     = 5 * time.Second
+	undefinedTableCode  = "42P01"
+)
+
+// WaitReady pings the database with exponential backoff until it answers or ctx is done.
+func (r Repository) WaitReady(ctx context.Context) error {
+	backoff := readyInitialBackoff
+	for {
+		err := r.pool.Ping(ctx)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("database is not reachable, check DATABASE_URI and that postgres is running: %w", err)
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, readyMaxBackoff)
+	}
+}
+
+// CheckMigrations verifies that every migration shipped with the server is applied,
+// including those older than the latest applied one, as goose can apply migrations out of order.
+func (r Repository) CheckMigrations(ctx context.Context) error {
+	expected, err := migrationVersions()
+	if err != nil {
+		return err
+	}
+
+	// The latest row of each version tells whether it is applied or was rolled back.
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT version_id FROM (
+			SELECT DISTINCT ON (version_id) version_id, is_applied FROM goose_db_version ORDER BY version_id, id DESC
+		) v WHERE is_applied`,
+	)
+	var applied []int64
+	if err == nil {
+		applied, err = pgx.CollectRows(rows, pgx.RowTo[int64])
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == undefinedTableCode {
+		return errors.New("database has no migrations applied, run `go tool goose up` or set DB_BOOTSTRAP")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	var missing []string
+	for _, version := range expected {
+		if !slices.Contains(applied, version) {
+			missing = append(missing, strconv.FormatInt(version, 10))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf(
+			"database is missing migrations %s the server expects, run `go tool goose up`",
+			strings.Join(missing, ", "),
+		)
+	}
+	return nil
+}
+
+// migrationVersions returns the versions of the migrations shipped with the server, in order.
+func migrationVersions() ([]int64, error) {
+	names, err := fs.Glob(migrations.FS, "*.sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	versions := make([]int64, 0, len(names))
+	for _, name := range names {
+		prefix, _, _ := strings.Cut(name, "_")
+		version, err := strconv.ParseInt(prefix, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad migration file name %q: %w", name, err)
+		}
+		versions = append(versions, version)
+	}
+	slices.Sort(versions)
+	return versions, nil
+}
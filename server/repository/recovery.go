@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// SetRecoveryCode stores the hash of the user's new recovery code, dropping the key wrapped with the old one.
+func (r Repository) SetRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) error {
+	return r.execOne(ctx, `UPDATE "user" SET recovery_code_hash=$2, recovery_key=NULL WHERE id=$1`, userID, codeHash)
+}
+
+// SetRecoveryKey stores the key wrapped with the user's recovery code.
+// It returns pgx.ErrNoRows if the code hash is not the current one.
+func (r Repository) SetRecoveryKey(ctx context.Context, userID uuid.UUID, codeHash, wrappedKey []byte) error {
+	return r.execOne(ctx, `UPDATE "user" SET recovery_key=$3 WHERE id=$1 AND recovery_code_hash=$2`,
+		userID, codeHash, wrappedKey)
+}
+
+// ConsumeRecovery clears the recovery of the enabled user with the login and code hash, sets their password
+// and revokes their tokens. It returns their ID, wrapped key and the generation of new tokens,
+// or pgx.ErrNoRows if there is no such recovery.
+func (r Repository) ConsumeRecovery(
+	ctx context.Context,
+	login string,
+	codeHash, passwordHash []byte,
+) (uuid.UUID, []byte, int64, error) {
+	var (
+		userID     uuid.UUID
+		wrappedKey []byte
+		generation int64
+	)
+	err := r.pool.QueryRow(
+		ctx,
+		`UPDATE "user" u SET recovery_code_hash=NULL, recovery_key=NULL, password=$3,
+		token_generation=u.token_generation+1
+		FROM "user" old
+		WHERE u.id=old.id AND u.login=$1 AND u.recovery_code_hash=$2 AND u.disabled_at IS NULL
+		RETURNING u.id, old.recovery_key, u.token_generation`,
+		login,
+		codeHash,
+		passwordHash,
+	).Scan(&userID, &wrappedKey, &generation)
+	return userID, wrappedKey, generation, err
+}
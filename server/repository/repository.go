@@ -2,9 +2,9 @@ package repository
 
 import (
 	"context"
-	"fmt"
-	"os"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 
@@ -12,55 +12,359 @@ import (
 )
 
 type Repository struct {
-	pool *pgxpool.Pool
+	pool    *pgxpool.Pool
+	dialect Dialect
 }
 
-func NewRepository(ctx context.Context, dsn string) (*Repository, error) {
-	pool, err := pgxpool.New(ctx, dsn)
+// PoolOptions tune the connection pool. Zero values keep the pgx defaults.
+type PoolOptions struct {
+	// Dialect is DialectPostgres when empty.
+	Dialect           Dialect
+	MaxConns          int32
+	MinConns          int32
+	MaxConnLifetime   time.Duration
+	MaxConnIdleTime   time.Duration
+	HealthCheckPeriod time.Duration
+}
+
+func NewRepository(ctx context.Context, dsn string, opts PoolOptions) (*Repository, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, err
 	}
-	r := &Repository{pool: pool}
+	if opts.MaxConns > 0 {
+		cfg.MaxConns = opts.MaxConns
+	}
+	if opts.MinConns > 0 {
+		cfg.MinConns = opts.MinConns
+	}
+	if opts.MaxConnLifetime > 0 {
+		cfg.MaxConnLifetime = opts.MaxConnLifetime
+	}
+	if opts.MaxConnIdleTime > 0 {
+		cfg.MaxConnIdleTime = opts.MaxConnIdleTime
+	}
+	if opts.HealthCheckPeriod > 0 {
+		cfg.HealthCheckPeriod = opts.HealthCheckPeriod
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	r := &Repository{pool: pool, dialect: opts.Dialect}
+	if r.dialect == "" {
+		r.dialect = DialectPostgres
+	}
 	return r, nil
 }
 
-func (r Repository) InsertUser(login string) error {
-	conn, err := pgx.Connect(context.Background(), os.Getenv("DATABASE_URL"))
+func (r Repository) Close() {
+	r.pool.Close()
+}
+
+// InsertUser creates an account with the login and password hash and returns its ID.
+func (r Repository) InsertUser(ctx context.Context, login string, passwordHash []byte) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		`WITH u AS (INSERT INTO "user" (login, password) VALUES ($1, $2) RETURNING id),
+		v AS (INSERT INTO vault (user_id, name) SELECT id, $3 FROM u)
+		SELECT id FROM u`,
+		login,
+		passwordHash,
+		DefaultVaultName,
+	).Scan(&id)
+	return id, err
+}
+
+// GetPasswordHash returns the ID and password hash of the enabled user with the login.
+func (r Repository) GetPasswordHash(ctx context.Context, login string) (uuid.UUID, []byte, error) {
+	var (
+		id   uuid.UUID
+		hash []byte
+	)
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT id, password FROM "user" WHERE login=$1 AND disabled_at IS NULL`,
+		login,
+	).Scan(&id, &hash)
+	return id, hash, err
+}
+
+// withRevision runs fn in a transaction after bumping the user's vault revision
+// and returns the new revision. fn may run again, see inTx.
+func (r Repository) withRevision(
+	ctx context.Context,
+	userID uuid.UUID,
+	fn func(tx pgx.Tx, revision int64) error,
+) (int64, error) {
+	var revision int64
+	err := r.inTx(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		err := tx.QueryRow(
+			ctx,
+			`UPDATE "user" SET revision=revision+1 WHERE id=$1 RETURNING revision`,
+			userID,
+		).Scan(&revision)
+		if err != nil {
+			return err
+		}
+		return fn(tx, revision)
+	})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Unable to connect to database: %v\n", err)
-		os.Exit(1)
+		return 0, err
 	}
-	defer conn.Close(context.Background())
+	return revision, nil
+}
+
+func (r Repository) GetRevision(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var revision int64
+	err := r.pool.QueryRow(ctx, `SELECT revision FROM "user" WHERE id=$1`, userID).Scan(&revision)
+	return revision, err
+}
+
+func (r Repository) GetUserIDByLogin(ctx context.Context, login string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, `SELECT id FROM "user" WHERE login=$1`, login).Scan(&id)
+	return id, err
+}
+
+func (r Repository) InsertLoginPassword(ctx context.Context, lp models.LoginPassword) (uuid.UUID, int64, error) {
+	var id uuid.UUID
+	revision, err := r.withRevision(ctx, lp.UserID, func(tx pgx.Tx, revision int64) error {
+		err := tx.QueryRow(
+			ctx,
+			`INSERT INTO login_password (login, password, user_id, vault_id, revision)
+			VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+			lp.Login,
+			lp.Password,
+			lp.UserID,
+			lp.VaultID,
+			revision,
+		).Scan(&id)
+		if err != nil {
+			return err
+		}
+		return saveURLs(ctx, tx, lp, id)
+	})
+	return id, revision, err
+}
+
+func (r Repository) UpdateLoginPassword(ctx context.Context, lp models.LoginPassword) (int64, error) {
+	return r.withRevision(ctx, lp.UserID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE login_password SET login=$1, password=$2, vault_id=$3, updated_at=now(), revision=$4
+			WHERE id=$5 AND user_id=$6 AND ($7::bigint IS NULL OR revision=$7)`,
+			lp.Login,
+			lp.Password,
+			lp.VaultID,
+			revision,
+			lp.ID,
+			lp.UserID,
+			lp.ExpectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return saveURLs(ctx, tx, lp, *lp.ID)
+	})
+}
+
+// DeleteLoginPassword deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (r Repository) DeleteLoginPassword(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	return r.withRevision(ctx, userID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			"DELETE FROM login_password WHERE id=$1 AND user_id=$2 AND ($3::bigint IS NULL OR revision=$3)",
+			id,
+			userID,
+			expectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return insertTombstone(ctx, tx, userID, id, models.ItemTypeLoginPassword, revision)
+	})
+}
+
+// insertTombstone records the deletion of an item for syncing clients.
+func insertTombstone(
+	ctx context.Context,
+	tx pgx.Tx,
+	userID, id uuid.UUID,
+	itemType models.ItemType,
+	revision int64,
+) error {
+	_, err := tx.Exec(
+		ctx,
+		`INSERT INTO tombstone (item_id, user_id, item_type, revision) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (item_id) DO UPDATE SET deleted_at=now(), revision=excluded.revision`,
+		id,
+		userID,
+		itemType,
+		revision,
+	)
+	return err
+}
 
-	var id string
-	err = conn.QueryRow(context.Background(), "SELECT login FROM \"user\" WHERE login=$1", login).Scan(&id)
+func (r Repository) GetLoginPassword(ctx context.Context, userID, id uuid.UUID) (models.LoginPassword, error) {
+	var lp models.LoginPassword
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT "+loginPasswordColumns+" FROM login_password WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	).Scan(
+		&lp.ID,
+		&lp.UserID,
+		&lp.VaultID,
+		&lp.Login,
+		&lp.Password,
+		&lp.UpdatedAt,
+		&lp.Revision,
+		&lp.LastUsedAt,
+		&lp.RotationDays,
+	)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "QueryRow failed: %v\n", err)
-		os.Exit(1)
+		return models.LoginPassword{}, err
 	}
+	lps := []models.LoginPassword{lp}
+	err = r.loadURLs(ctx, lps)
+	return lps[0], err
+}
 
-	fmt.Println(id)
-	return nil
+func (r Repository) ListLoginPasswords(ctx context.Context, userID uuid.UUID) ([]models.LoginPassword, error) {
+	return r.GetLoginPasswordsChangedSince(ctx, userID, time.Time{}, 0)
 }
 
-func (r Repository) InsertLoginPassword(ctx context.Context, lp models.LoginPassword) error {
-	_, err := r.pool.Exec(
+// GetLoginPasswordsChangedSince returns login passwords changed after sinceRevision, or after since if sinceRevision is zero.
+func (r Repository) GetLoginPasswordsChangedSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.LoginPassword, error) {
+	rows, err := r.pool.Query(
 		ctx,
-		"INSERT INTO login_password (login, password, user_id) VALUES ($1, $2, $3)",
-		lp.Login,
-		lp.Password,
-		lp.UserID,
+		"SELECT "+loginPasswordColumns+" FROM login_password WHERE user_id=$1 AND (revision>$3 AND $3>0 OR $3=0 AND updated_at>$2)",
+		userID,
+		since,
+		sinceRevision,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	lps, err := pgx.CollectRows(rows, scanLoginPassword)
+	if err != nil {
+		return nil, err
+	}
+	return lps, r.loadURLs(ctx, lps)
 }
 
-func (r Repository) UpdateLoginPassword(ctx context.Context, lp models.LoginPassword) error {
-	_, err := r.pool.Exec(
+// ListRecentlyUsedLoginPasswords returns up to limit login passwords used after since, most recently used first.
+func (r Repository) ListRecentlyUsedLoginPasswords(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]models.LoginPassword, error) {
+	rows, err := r.pool.Query(
 		ctx,
-		"UPDATE login_password SET login=$1, password=$2 WHERE id=$3",
-		lp.Login,
-		lp.Password,
-		lp.ID,
+		"SELECT "+loginPasswordColumns+` FROM login_password
+		WHERE user_id=$1 AND last_used_at>$2 ORDER BY last_used_at DESC LIMIT $3`,
+		userID,
+		since,
+		limit,
 	)
-	return err
+	if err != nil {
+		return nil, err
+	}
+	lps, err := pgx.CollectRows(rows, scanLoginPassword)
+	if err != nil {
+		return nil, err
+	}
+	return lps, r.loadURLs(ctx, lps)
+}
+
+// SetLoginPasswordRotation sets the rotation policy of the item and returns the new vault revision.
+func (r Repository) SetLoginPasswordRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	return r.updateLoginPassword(ctx, userID, id, "rotation_days=$4", days)
+}
+
+// updateLoginPassword sets columns of the user's item, its revision being $3, and returns the new vault revision.
+func (r Repository) updateLoginPassword(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	set string,
+	args ...any,
+) (int64, error) {
+	return r.withRevision(ctx, userID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			"UPDATE login_password SET "+set+", revision=$3 WHERE id=$1 AND user_id=$2",
+			append([]any{id, userID, revision}, args...)...,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+}
+
+// loginPasswordColumns are the columns read by scanLoginPassword.
+const loginPasswordColumns = "id, user_id, vault_id, login, password, updated_at, revision, last_used_at, rotation_days"
+
+func scanLoginPassword(row pgx.CollectableRow) (models.LoginPassword, error) {
+	var lp models.LoginPassword
+	err := row.Scan(
+		&lp.ID,
+		&lp.UserID,
+		&lp.VaultID,
+		&lp.Login,
+		&lp.Password,
+		&lp.UpdatedAt,
+		&lp.Revision,
+		&lp.LastUsedAt,
+		&lp.RotationDays,
+	)
+	return lp, err
+}
+
+// GetTombstonesSince returns items deleted after sinceRevision, or after since if sinceRevision is zero.
+func (r Repository) GetTombstonesSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.Tombstone, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT item_id, item_type, deleted_at, revision FROM tombstone
+		WHERE user_id=$1 AND (revision>$3 AND $3>0 OR $3=0 AND deleted_at>$2)`,
+		userID,
+		since,
+		sinceRevision,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Tombstone, error) {
+		var t models.Tombstone
+		err := row.Scan(&t.ItemID, &t.ItemType, &t.DeletedAt, &t.Revision)
+		return t, err
+	})
 }
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+func (r Repository) InsertSecretLink(ctx context.Context, l models.SecretLink) error {
+	_, err := r.pool.Exec(
+		ctx,
+		"INSERT INTO secret_link (id, user_id, ciphertext, max_views, expires_at) VALUES ($1, $2, $3, $4, $5)",
+		l.ID,
+		l.UserID,
+		l.Ciphertext,
+		l.MaxViews,
+		l.ExpiresAt,
+	)
+	return err
+}
+
+// ConsumeSecretLink counts a view of a live link and returns it, deleting the link on its last view.
+// It returns pgx.ErrNoRows if the link does not exist, expired or has no views left.
+func (r Repository) ConsumeSecretLink(ctx context.Context, id uuid.UUID) (models.SecretLink, error) {
+	var l models.SecretLink
+	err := r.inTx(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		err := tx.QueryRow(
+			ctx,
+			`UPDATE secret_link SET views=views+1
+			WHERE id=$1 AND expires_at>now() AND views<max_views
+			RETURNING id, user_id, ciphertext, max_views, views, expires_at`,
+			id,
+		).Scan(&l.ID, &l.UserID, &l.Ciphertext, &l.MaxViews, &l.Views, &l.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		if l.Views < l.MaxViews {
+			return nil
+		}
+		_, err = tx.Exec(ctx, "DELETE FROM secret_link WHERE id=$1", id)
+		return err
+	})
+	if err != nil {
+		return models.SecretLink{}, err
+	}
+	return l, nil
+}
+
+func (r Repository) DeleteExpiredSecretLinks(ctx context.Context) (int64, error) {
+	tag, err := r.pool.Exec(ctx, "DELETE FROM secret_link WHERE expires_at<=now()")
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
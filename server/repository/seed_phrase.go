@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// seedPhraseColumns are the columns read by scanSeedPhrase.
+const seedPhraseColumns = "id, user_id, vault_id, name, words, updated_at, revision, last_used_at"
+
+// InsertSeedPhrase stores the words separated by spaces, as wallets show them.
+func (r Repository) InsertSeedPhrase(ctx context.Context, sp models.SeedPhrase) (uuid.UUID, int64, error) {
+	var id uuid.UUID
+	revision, err := r.withRevision(ctx, sp.UserID, func(tx pgx.Tx, revision int64) error {
+		return tx.QueryRow(
+			ctx,
+			`INSERT INTO seed_phrase (user_id, vault_id, name, words, revision)
+			VALUES ($1, $2, $3, $4, $5) RETURNING id`,
+			sp.UserID,
+			sp.VaultID,
+			sp.Name,
+			[]byte(strings.Join(sp.Words, " ")),
+			revision,
+		).Scan(&id)
+	})
+	return id, revision, err
+}
+
+// UpdateSeedPhrase updates the item, only if it is at sp.ExpectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (r Repository) UpdateSeedPhrase(ctx context.Context, sp models.SeedPhrase) (int64, error) {
+	return r.withRevision(ctx, sp.UserID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE seed_phrase SET vault_id=$1, name=$2, words=$3, updated_at=now(), revision=$4
+			WHERE id=$5 AND user_id=$6 AND ($7::bigint IS NULL OR revision=$7)`,
+			sp.VaultID,
+			sp.Name,
+			[]byte(strings.Join(sp.Words, " ")),
+			revision,
+			sp.ID,
+			sp.UserID,
+			sp.ExpectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+}
+
+// DeleteSeedPhrase deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (r Repository) DeleteSeedPhrase(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	return r.withRevision(ctx, userID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			"DELETE FROM seed_phrase WHERE id=$1 AND user_id=$2 AND ($3::bigint IS NULL OR revision=$3)",
+			id,
+			userID,
+			expectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return insertTombstone(ctx, tx, userID, id, models.ItemTypeSeedPhrase, revision)
+	})
+}
+
+func (r Repository) GetSeedPhrase(ctx context.Context, userID, id uuid.UUID) (models.SeedPhrase, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT "+seedPhraseColumns+" FROM seed_phrase WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	)
+	if err != nil {
+		return models.SeedPhrase{}, err
+	}
+	return pgx.CollectExactlyOneRow(rows, scanSeedPhrase)
+}
+
+// GetSeedPhrasesChangedSince returns seed phrases changed after sinceRevision, or after since if sinceRevision is zero.
+func (r Repository) GetSeedPhrasesChangedSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.SeedPhrase, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT "+seedPhraseColumns+" FROM seed_phrase WHERE user_id=$1 AND (revision>$3 AND $3>0 OR $3=0 AND updated_at>$2)",
+		userID,
+		since,
+		sinceRevision,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, scanSeedPhrase)
+}
+
+func scanSeedPhrase(row pgx.CollectableRow) (models.SeedPhrase, error) {
+	var (
+		sp    models.SeedPhrase
+		words []byte
+	)
+	err := row.Scan(&sp.ID, &sp.UserID, &sp.VaultID, &sp.Name, &words, &sp.UpdatedAt, &sp.Revision, &sp.LastUsedAt)
+	sp.Words = strings.Fields(string(words))
+	return sp, err
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// InsertShare grants the share, replacing an earlier grant of the same item to the same user.
+func (r Repository) InsertShare(ctx context.Context, sh models.Share) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		`INSERT INTO share (item_id, item_type, owner_id, grantee_id, read_only, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (item_id, grantee_id) DO UPDATE SET read_only=excluded.read_only, expires_at=excluded.expires_at
+		RETURNING id`,
+		sh.ItemID,
+		sh.ItemType,
+		sh.OwnerID,
+		sh.GranteeID,
+		sh.ReadOnly,
+		sh.ExpiresAt,
+	).Scan(&id)
+	return id, err
+}
+
+func (r Repository) ListSharesByOwner(ctx context.Context, ownerID uuid.UUID) ([]models.Share, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT s.id, s.item_id, s.item_type, s.owner_id, s.grantee_id, u.login, s.read_only, s.expires_at, s.created_at
+		FROM share s
+		JOIN "user" u ON u.id = s.grantee_id
+		WHERE s.owner_id=$1
+		ORDER BY s.created_at`,
+		ownerID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Share, error) {
+		var sh models.Share
+		err := row.Scan(
+			&sh.ID,
+			&sh.ItemID,
+			&sh.ItemType,
+			&sh.OwnerID,
+			&sh.GranteeID,
+			&sh.GranteeLogin,
+			&sh.ReadOnly,
+			&sh.ExpiresAt,
+			&sh.CreatedAt,
+		)
+		return sh, err
+	})
+}
+
+func (r Repository) DeleteShare(ctx context.Context, ownerID, id uuid.UUID) error {
+	return r.execOne(ctx, "DELETE FROM share WHERE id=$1 AND owner_id=$2", id, ownerID)
+}
+
+// GetActiveShare returns the unexpired grant of the item to the user or pgx.ErrNoRows.
+func (r Repository) GetActiveShare(ctx context.Context, itemID, granteeID uuid.UUID) (models.Share, error) {
+	var sh models.Share
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT id, item_id, item_type, owner_id, grantee_id, read_only, expires_at, created_at FROM share
+		WHERE item_id=$1 AND grantee_id=$2 AND (expires_at IS NULL OR expires_at>now())`,
+		itemID,
+		granteeID,
+	).Scan(
+		&sh.ID,
+		&sh.ItemID,
+		&sh.ItemType,
+		&sh.OwnerID,
+		&sh.GranteeID,
+		&sh.ReadOnly,
+		&sh.ExpiresAt,
+		&sh.CreatedAt,
+	)
+	return sh, err
+}
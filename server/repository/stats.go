@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// GetVaultStats returns the statistics of the user's vault, with up to oldest unchanged passwords.
+func (r Repository) GetVaultStats(ctx context.Context, userID uuid.UUID, oldest int) (models.VaultStats, error) {
+	stats := models.VaultStats{StorageBytes: make(map[models.ItemType]int64, len(itemTables))}
+	for _, t := range itemTables {
+		stats.StorageBytes[t.itemType] = 0
+	}
+
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT date_trunc('month', created_at, 'UTC'), count(*) FROM `+allItemsSQL("user_id, created_at")+`
+		WHERE user_id=$1 GROUP BY 1 ORDER BY 1`,
+		userID,
+	)
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+	stats.AddedPerMonth, err = pgx.CollectRows(rows, pgx.RowToStructByPos[models.MonthCount])
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+
+	rows, err = r.pool.Query(
+		ctx,
+		"SELECT item_type, sum(size) FROM "+allItemsSQL("user_id")+" WHERE user_id=$1 GROUP BY item_type",
+		userID,
+	)
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+	var (
+		itemType models.ItemType
+		size     int64
+	)
+	_, err = pgx.ForEachRow(rows, []any{&itemType, &size}, func() error {
+		stats.StorageBytes[itemType] = size
+		return nil
+	})
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+
+	rows, err = r.pool.Query(
+		ctx,
+		"SELECT "+loginPasswordColumns+" FROM login_password WHERE user_id=$1 ORDER BY updated_at, id LIMIT $2",
+		userID,
+		oldest,
+	)
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+	stats.OldestPasswords, err = pgx.CollectRows(rows, scanLoginPassword)
+	if err != nil {
+		return models.VaultStats{}, err
+	}
+	return stats, nil
+}
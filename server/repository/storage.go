@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// Storage is the data access used by the services. Repository stores data in Postgres and Memory in maps,
+// both returning pgx.ErrNoRows for missing rows and *pgconn.PgError for constraint violations.
+// Backups dump Postgres tables, so they need a Repository.
+type Storage interface {
+	InsertUser(ctx context.Context, login string, passwordHash []byte) (uuid.UUID, error)
+	GetPasswordHash(ctx context.Context, login string) (uuid.UUID, []byte, error)
+	GetRevision(ctx context.Context, userID uuid.UUID) (int64, error)
+	GetUserIDByLogin(ctx context.Context, login string) (uuid.UUID, error)
+
+	InsertLoginPassword(ctx context.Context, lp models.LoginPassword) (uuid.UUID, int64, error)
+	UpdateLoginPassword(ctx context.Context, lp models.LoginPassword) (int64, error)
+	DeleteLoginPassword(ctx context.Context, userID, id uuid.UUID, expectedRevision *int64) (int64, error)
+	GetLoginPassword(ctx context.Context, userID, id uuid.UUID) (models.LoginPassword, error)
+	ListLoginPasswords(ctx context.Context, userID uuid.UUID) ([]models.LoginPassword, error)
+	GetLoginPasswordsChangedSince(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+		sinceRevision int64,
+	) ([]models.LoginPassword, error)
+	ListRecentlyUsedLoginPasswords(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+		limit int,
+	) ([]models.LoginPassword, error)
+	SetLoginPasswordRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error)
+	FindLoginPasswordsByHost(
+		ctx context.Context,
+		userID uuid.UUID,
+		host string,
+		includeArchived bool,
+	) ([]models.LoginPassword, error)
+	HasLoginURLHost(ctx context.Context, userID uuid.UUID, host string) (bool, error)
+	GetTombstonesSince(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+		sinceRevision int64,
+	) ([]models.Tombstone, error)
+
+	InsertWifiCredential(ctx context.Context, w models.WifiCredential) (uuid.UUID, int64, error)
+	UpdateWifiCredential(ctx context.Context, w models.WifiCredential) (int64, error)
+	DeleteWifiCredential(ctx context.Context, userID, id uuid.UUID, expectedRevision *int64) (int64, error)
+	GetWifiCredential(ctx context.Context, userID, id uuid.UUID) (models.WifiCredential, error)
+	GetWifiCredentialsChangedSince(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+		sinceRevision int64,
+	) ([]models.WifiCredential, error)
+
+	InsertSeedPhrase(ctx context.Context, sp models.SeedPhrase) (uuid.UUID, int64, error)
+	UpdateSeedPhrase(ctx context.Context, sp models.SeedPhrase) (int64, error)
+	DeleteSeedPhrase(ctx context.Context, userID, id uuid.UUID, expectedRevision *int64) (int64, error)
+	GetSeedPhrase(ctx context.Context, userID, id uuid.UUID) (models.SeedPhrase, error)
+	GetSeedPhrasesChangedSince(
+		ctx context.Context,
+		userID uuid.UUID,
+		since time.Time,
+		sinceRevision int64,
+	) ([]models.SeedPhrase, error)
+
+	ListItemSummaries(
+		ctx context.Context,
+		userID uuid.UUID,
+		vaultID *uuid.UUID,
+		includeArchived bool,
+	) ([]models.ItemSummary, error)
+	ListItemsPage(
+		ctx context.Context,
+		userID uuid.UUID,
+		vaultID *uuid.UUID,
+		after uuid.UUID,
+		limit int,
+	) ([]models.VaultItem, error)
+	TouchItem(ctx context.Context, userID, id uuid.UUID) (models.ChangeEvent, error)
+	SetItemPinned(ctx context.Context, userID, id uuid.UUID, pinned bool) (models.ChangeEvent, error)
+	SetItemArchived(ctx context.Context, userID, id uuid.UUID, archived bool) (models.ChangeEvent, error)
+	SetItemOrder(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) ([]models.ChangeEvent, error)
+	GetVaultStats(ctx context.Context, userID uuid.UUID, oldest int) (models.VaultStats, error)
+
+	InsertVault(ctx context.Context, userID uuid.UUID, name string) (uuid.UUID, int64, error)
+	GetVault(ctx context.Context, userID, id uuid.UUID) (models.Vault, error)
+	ListVaults(ctx context.Context, userID uuid.UUID) ([]models.Vault, error)
+	DefaultVault(ctx context.Context, userID uuid.UUID) (uuid.UUID, error)
+	RenameVault(ctx context.Context, userID, id uuid.UUID, name string) (int64, error)
+	SetVaultRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error)
+	DeleteVault(ctx context.Context, userID, id uuid.UUID) (int64, error)
+	CountVaultItems(ctx context.Context, id uuid.UUID) (int64, error)
+
+	InsertDevice(ctx context.Context, userID uuid.UUID, name string) (uuid.UUID, error)
+	GetDevice(ctx context.Context, userID, id uuid.UUID) (models.Device, error)
+	ListDevices(ctx context.Context, userID uuid.UUID) ([]models.Device, error)
+	RenameDevice(ctx context.Context, userID, id uuid.UUID, name string) error
+	RevokeDevice(ctx context.Context, userID, id uuid.UUID) error
+	TouchDevice(ctx context.Context, userID, id uuid.UUID) error
+
+	InsertSecretLink(ctx context.Context, l models.SecretLink) error
+	ConsumeSecretLink(ctx context.Context, id uuid.UUID) (models.SecretLink, error)
+	DeleteExpiredSecretLinks(ctx context.Context) (int64, error)
+
+	InsertEmergencyContact(ctx context.Context, ownerID, granteeID uuid.UUID, wait time.Duration) (uuid.UUID, error)
+	DeleteEmergencyContact(ctx context.Context, ownerID, id uuid.UUID) error
+	ListEmergencyContacts(ctx context.Context, userID uuid.UUID) ([]models.EmergencyContact, error)
+	RequestEmergencyAccess(ctx context.Context, granteeID, id uuid.UUID) (models.EmergencyContact, error)
+	ApproveEmergencyAccess(ctx context.Context, ownerID, id uuid.UUID) (models.EmergencyContact, error)
+	RejectEmergencyAccess(ctx context.Context, ownerID, id uuid.UUID) (models.EmergencyContact, error)
+	GrantDueEmergencyRequests(ctx context.Context) ([]models.EmergencyContact, error)
+	HasEmergencyAccess(ctx context.Context, granteeID, ownerID uuid.UUID) (bool, error)
+
+	InsertShare(ctx context.Context, sh models.Share) (uuid.UUID, error)
+	ListSharesByOwner(ctx context.Context, ownerID uuid.UUID) ([]models.Share, error)
+	DeleteShare(ctx context.Context, ownerID, id uuid.UUID) error
+	GetActiveShare(ctx context.Context, itemID, granteeID uuid.UUID) (models.Share, error)
+
+	GetFavicon(ctx context.Context, host string) (models.Favicon, error)
+	UpsertFavicon(ctx context.Context, f models.Favicon) error
+
+	SetRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash []byte) error
+	SetRecoveryKey(ctx context.Context, userID uuid.UUID, codeHash, wrappedKey []byte) error
+	ConsumeRecovery(ctx context.Context, login string, codeHash, passwordHash []byte) (uuid.UUID, []byte, int64, error)
+
+	GetTokenGeneration(ctx context.Context, userID uuid.UUID) (int64, error)
+	RevokeTokens(ctx context.Context, userID uuid.UUID) (int64, error)
+	SetPassword(ctx context.Context, userID uuid.UUID, passwordHash []byte) (int64, error)
+	GetAccountAuth(ctx context.Context, userID uuid.UUID) (models.AccountAuth, error)
+	GetAccount(ctx context.Context, userID uuid.UUID) (models.Account, error)
+	SetAllowedNetworks(ctx context.Context, userID uuid.UUID, networks []netip.Prefix) error
+
+	ListUsers(ctx context.Context, tenantID *uuid.UUID) ([]models.UserSummary, error)
+	GetAccountUsage(ctx context.Context, userID uuid.UUID) (models.AccountUsage, error)
+	SetUserDisabled(ctx context.Context, login string, disabled bool) error
+	GetStorageUsage(ctx context.Context) (models.StorageUsage, error)
+	GetUsageCounts(ctx context.Context) (models.UsageCounts, error)
+
+	InsertTenant(ctx context.Context, name string, quotaBytes int64) (uuid.UUID, error)
+	ListTenants(ctx context.Context) ([]models.Tenant, error)
+	SetTenantQuota(ctx context.Context, id uuid.UUID, quotaBytes int64) error
+	SetUserTenant(ctx context.Context, login string, tenantID *uuid.UUID) error
+	GetTenantPeerID(ctx context.Context, userID uuid.UUID, login string) (uuid.UUID, error)
+	GetStorageQuota(ctx context.Context, userID uuid.UUID) (models.StorageQuota, error)
+}
+
+var (
+	_ Storage = Repository{}
+	_ Storage = (*Memory)(nil)
+)
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// tenantStorageBytesSQL sums the size of the secrets stored by the users of the tenant t.
+var tenantStorageBytesSQL = `(SELECT COALESCE(sum(size), 0) FROM ` + allItemsSQL("user_id") + `
+		JOIN "user" tu ON tu.id=item.user_id WHERE tu.tenant_id=t.id)
+	+ (SELECT COALESCE(sum(octet_length(sl.ciphertext)), 0) FROM secret_link sl
+		JOIN "user" tu ON tu.id=sl.user_id WHERE tu.tenant_id=t.id)`
+
+func (r Repository) InsertTenant(ctx context.Context, name string, quotaBytes int64) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		"INSERT INTO tenant (name, storage_quota_bytes) VALUES ($1, $2) RETURNING id",
+		name,
+		quotaBytes,
+	).Scan(&id)
+	return id, err
+}
+
+func (r Repository) ListTenants(ctx context.Context) ([]models.Tenant, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		`SELECT t.id, t.name, t.storage_quota_bytes, t.created_at,
+			(SELECT count(*) FROM "user" WHERE tenant_id=t.id), `+tenantStorageBytesSQL+`
+		FROM tenant t ORDER BY t.name`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Tenant, error) {
+		var t models.Tenant
+		err := row.Scan(&t.ID, &t.Name, &t.StorageQuotaBytes, &t.CreatedAt, &t.Users, &t.StorageBytes)
+		return t, err
+	})
+}
+
+func (r Repository) SetTenantQuota(ctx context.Context, id uuid.UUID, quotaBytes int64) error {
+	return r.execOne(ctx, "UPDATE tenant SET storage_quota_bytes=$2 WHERE id=$1", id, quotaBytes)
+}
+
+// SetUserTenant moves the account with the login to the tenant, or out of any tenant if tenantID is nil.
+func (r Repository) SetUserTenant(ctx context.Context, login string, tenantID *uuid.UUID) error {
+	return r.execOne(ctx, `UPDATE "user" SET tenant_id=$2 WHERE login=$1`, login, tenantID)
+}
+
+// GetTenantPeerID returns the ID of the account with the login if it is in the same tenant as the user.
+func (r Repository) GetTenantPeerID(ctx context.Context, userID uuid.UUID, login string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT p.id FROM "user" p JOIN "user" u ON p.tenant_id IS NOT DISTINCT FROM u.tenant_id
+		WHERE u.id=$1 AND p.login=$2`,
+		userID,
+		login,
+	).Scan(&id)
+	return id, err
+}
+
+// GetStorageQuota returns the storage quota of the user's tenant, unlimited for users outside any tenant.
+func (r Repository) GetStorageQuota(ctx context.Context, userID uuid.UUID) (models.StorageQuota, error) {
+	var q models.StorageQuota
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT COALESCE(t.storage_quota_bytes, 0), CASE WHEN t.id IS NULL THEN 0 ELSE `+tenantStorageBytesSQL+` END
+		FROM "user" u LEFT JOIN tenant t ON t.id=u.tenant_id WHERE u.id=$1`,
+		userID,
+	).Scan(&q.QuotaBytes, &q.UsedBytes)
+	return q, err
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// GetTokenGeneration returns the generation of the user's access tokens, older tokens are revoked.
+func (r Repository) GetTokenGeneration(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var generation int64
+	err := r.pool.QueryRow(ctx, `SELECT token_generation FROM "user" WHERE id=$1`, userID).Scan(&generation)
+	return generation, err
+}
+
+// querier runs queries on the pool or in a transaction.
+type querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// RevokeTokens revokes every access token of the user, returning the generation of new ones.
+func (r Repository) RevokeTokens(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return revokeTokens(ctx, r.pool, userID)
+}
+
+// SetPassword replaces the user's password hash and revokes their access tokens in the same transaction,
+// returning the generation of new ones.
+func (r Repository) SetPassword(ctx context.Context, userID uuid.UUID, passwordHash []byte) (int64, error) {
+	var generation int64
+	err := r.inTx(ctx, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		tag, err := tx.Exec(ctx, `UPDATE "user" SET password=$2 WHERE id=$1`, userID, passwordHash)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		generation, err = revokeTokens(ctx, tx, userID)
+		return err
+	})
+	return generation, err
+}
+
+func revokeTokens(ctx context.Context, q querier, userID uuid.UUID) (int64, error) {
+	var generation int64
+	err := q.QueryRow(
+		ctx,
+		`UPDATE "user" SET token_generation=token_generation+1 WHERE id=$1 RETURNING token_generation`,
+		userID,
+	).Scan(&generation)
+	return generation, err
+}
+
+// GetAccountAuth returns the state of the account checked on every authenticated call.
+func (r Repository) GetAccountAuth(ctx context.Context, userID uuid.UUID) (models.AccountAuth, error) {
+	var a models.AccountAuth
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT token_generation, allowed_networks FROM "user" WHERE id=$1`,
+		userID,
+	).Scan(&a.TokenGeneration, &a.AllowedNetworks)
+	return a, err
+}
+
+// GetAccount returns the record of the user.
+func (r Repository) GetAccount(ctx context.Context, userID uuid.UUID) (models.Account, error) {
+	var a models.Account
+	err := r.pool.QueryRow(
+		ctx,
+		`SELECT id, login, tenant_id, disabled_at, allowed_networks, recovery_code_hash IS NOT NULL
+		FROM "user" WHERE id=$1`,
+		userID,
+	).Scan(&a.ID, &a.Login, &a.TenantID, &a.DisabledAt, &a.AllowedNetworks, &a.HasRecoveryKit)
+	return a, err
+}
+
+// SetAllowedNetworks restricts the account to the networks, or lets it be used from anywhere if empty.
+func (r Repository) SetAllowedNetworks(ctx context.Context, userID uuid.UUID, networks []netip.Prefix) error {
+	if networks == nil {
+		networks = []netip.Prefix{}
+	}
+	return r.execOne(ctx, `UPDATE "user" SET allowed_networks=$2 WHERE id=$1`, userID, networks)
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Dialect is the SQL database behind the pool.
+type Dialect string
+
+const (
+	DialectPostgres    Dialect = "postgres"
+	DialectCockroachDB Dialect = "cockroachdb"
+)
+
+const (
+	maxTxAttempts      = 5
+	txRetryBackoff     = 20 * time.Millisecond
+	serializationCode  = "40001"
+	deadlockDetectCode = "40P01"
+)
+
+// inTx runs fn in a transaction and commits it if fn succeeds. When the database aborts the
+// transaction over a conflict with a concurrent one, fn runs again in a new transaction.
+// CockroachDB does that on any conflict, as every transaction there is serializable,
+// so fn must have no effects outside tx that a second run would repeat.
+func (r Repository) inTx(ctx context.Context, opts pgx.TxOptions, fn func(tx pgx.Tx) error) error {
+	for attempt := 1; ; attempt++ {
+		err := pgx.BeginTxFunc(ctx, r.pool, opts, fn)
+		if !retryable(err) || attempt == maxTxAttempts {
+			return err
+		}
+		backoff := time.Duration(attempt) * txRetryBackoff
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff + rand.N(backoff)):
+		}
+	}
+}
+
+func retryable(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && (pgErr.Code == serializationCode || pgErr.Code == deadlockDetectCode)
+}
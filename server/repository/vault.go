@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// DefaultVaultName is the name of the vault created with each user, and again on the first save
+// of users who deleted all of theirs.
+const DefaultVaultName = "Personal"
+
+// InsertVault creates a vault and returns its id along with the new vault revision.
+func (r Repository) InsertVault(ctx context.Context, userID uuid.UUID, name string) (uuid.UUID, int64, error) {
+	var id uuid.UUID
+	revision, err := r.withRevision(ctx, userID, func(tx pgx.Tx, _ int64) error {
+		return tx.QueryRow(
+			ctx,
+			"INSERT INTO vault (user_id, name) VALUES ($1, $2) RETURNING id",
+			userID,
+			name,
+		).Scan(&id)
+	})
+	return id, revision, err
+}
+
+func (r Repository) GetVault(ctx context.Context, userID, id uuid.UUID) (models.Vault, error) {
+	var v models.Vault
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT id, user_id, name, created_at, rotation_days FROM vault WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	).Scan(&v.ID, &v.UserID, &v.Name, &v.CreatedAt, &v.RotationDays)
+	return v, err
+}
+
+func (r Repository) ListVaults(ctx context.Context, userID uuid.UUID) ([]models.Vault, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT id, user_id, name, created_at, rotation_days FROM vault WHERE user_id=$1 ORDER BY created_at",
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (models.Vault, error) {
+		var v models.Vault
+		err := row.Scan(&v.ID, &v.UserID, &v.Name, &v.CreatedAt, &v.RotationDays)
+		return v, err
+	})
+}
+
+// DefaultVault returns the user's oldest vault, creating one if the user has none.
+func (r Repository) DefaultVault(ctx context.Context, userID uuid.UUID) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := r.pool.QueryRow(ctx, "SELECT id FROM vault WHERE user_id=$1 ORDER BY created_at LIMIT 1", userID).Scan(&id)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return id, err
+	}
+	err = r.pool.QueryRow(
+		ctx,
+		`INSERT INTO vault (user_id, name) VALUES ($1, $2)
+		ON CONFLICT (user_id, name) DO UPDATE SET name=excluded.name RETURNING id`,
+		userID,
+		DefaultVaultName,
+	).Scan(&id)
+	return id, err
+}
+
+// RenameVault renames the vault and returns the new vault revision.
+func (r Repository) RenameVault(ctx context.Context, userID, id uuid.UUID, name string) (int64, error) {
+	return r.updateVault(ctx, userID, "UPDATE vault SET name=$3 WHERE id=$1 AND user_id=$2", id, name)
+}
+
+// SetVaultRotation sets the rotation policy of the vault and returns the new vault revision.
+func (r Repository) SetVaultRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	return r.updateVault(ctx, userID, "UPDATE vault SET rotation_days=$3 WHERE id=$1 AND user_id=$2", id, days)
+}
+
+// DeleteVault deletes an empty vault and returns the new vault revision.
+// It returns pgx.ErrNoRows if the vault does not exist or has items.
+func (r Repository) DeleteVault(ctx context.Context, userID, id uuid.UUID) (int64, error) {
+	return r.updateVault(
+		ctx,
+		userID,
+		`DELETE FROM vault WHERE id=$1 AND user_id=$2
+		AND NOT EXISTS (SELECT 1 FROM `+allItemsSQL("vault_id")+` WHERE vault_id=$1)`,
+		id,
+	)
+}
+
+// updateVault runs sql, changing the vault $1 of the user $2, and returns the new vault revision.
+// It returns pgx.ErrNoRows if no vault was changed.
+func (r Repository) updateVault(
+	ctx context.Context,
+	userID uuid.UUID,
+	sql string,
+	id uuid.UUID,
+	args ...any,
+) (int64, error) {
+	return r.withRevision(ctx, userID, func(tx pgx.Tx, _ int64) error {
+		tag, err := tx.Exec(ctx, sql, append([]any{id, userID}, args...)...)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+}
+
+func (r Repository) CountVaultItems(ctx context.Context, id uuid.UUID) (int64, error) {
+	var n int64
+	err := r.pool.QueryRow(
+		ctx,
+		"SELECT count(*) FROM "+allItemsSQL("vault_id")+" WHERE vault_id=$1",
+		id,
+	).Scan(&n)
+	return n, err
+}
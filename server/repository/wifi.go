@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// wifiCredentialColumns are the columns read by scanWifiCredential.
+const wifiCredentialColumns = "id, user_id, vault_id, ssid, security, password, hidden, updated_at, revision, last_used_at"
+
+func (r Repository) InsertWifiCredential(ctx context.Context, w models.WifiCredential) (uuid.UUID, int64, error) {
+	var id uuid.UUID
+	revision, err := r.withRevision(ctx, w.UserID, func(tx pgx.Tx, revision int64) error {
+		return tx.QueryRow(
+			ctx,
+			`INSERT INTO wifi_credential (user_id, vault_id, ssid, security, password, hidden, revision)
+			VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`,
+			w.UserID,
+			w.VaultID,
+			w.SSID,
+			w.Security,
+			w.Password,
+			w.Hidden,
+			revision,
+		).Scan(&id)
+	})
+	return id, revision, err
+}
+
+// UpdateWifiCredential updates the item, only if it is at w.ExpectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (r Repository) UpdateWifiCredential(ctx context.Context, w models.WifiCredential) (int64, error) {
+	return r.withRevision(ctx, w.UserID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			`UPDATE wifi_credential SET vault_id=$1, ssid=$2, security=$3, password=$4, hidden=$5,
+				updated_at=now(), revision=$6
+			WHERE id=$7 AND user_id=$8 AND ($9::bigint IS NULL OR revision=$9)`,
+			w.VaultID,
+			w.SSID,
+			w.Security,
+			w.Password,
+			w.Hidden,
+			revision,
+			w.ID,
+			w.UserID,
+			w.ExpectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return nil
+	})
+}
+
+// DeleteWifiCredential deletes the item, only if it is at expectedRevision when set,
+// failing with pgx.ErrNoRows otherwise.
+func (r Repository) DeleteWifiCredential(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	return r.withRevision(ctx, userID, func(tx pgx.Tx, revision int64) error {
+		tag, err := tx.Exec(
+			ctx,
+			"DELETE FROM wifi_credential WHERE id=$1 AND user_id=$2 AND ($3::bigint IS NULL OR revision=$3)",
+			id,
+			userID,
+			expectedRevision,
+		)
+		if err != nil {
+			return err
+		}
+		if tag.RowsAffected() == 0 {
+			return pgx.ErrNoRows
+		}
+		return insertTombstone(ctx, tx, userID, id, models.ItemTypeWifiCredential, revision)
+	})
+}
+
+func (r Repository) GetWifiCredential(ctx context.Context, userID, id uuid.UUID) (models.WifiCredential, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT "+wifiCredentialColumns+" FROM wifi_credential WHERE id=$1 AND user_id=$2",
+		id,
+		userID,
+	)
+	if err != nil {
+		return models.WifiCredential{}, err
+	}
+	return pgx.CollectExactlyOneRow(rows, scanWifiCredential)
+}
+
+// GetWifiCredentialsChangedSince returns WiFi credentials changed after sinceRevision, or after since if sinceRevision is zero.
+func (r Repository) GetWifiCredentialsChangedSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) ([]models.WifiCredential, error) {
+	rows, err := r.pool.Query(
+		ctx,
+		"SELECT "+wifiCredentialColumns+" FROM wifi_credential WHERE user_id=$1 AND (revision>$3 AND $3>0 OR $3=0 AND updated_at>$2)",
+		userID,
+		since,
+		sinceRevision,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, scanWifiCredential)
+}
+
+func scanWifiCredential(row pgx.CollectableRow) (models.WifiCredential, error) {
+	var w models.WifiCredential
+	err := row.Scan(
+		&w.ID,
+		&w.UserID,
+		&w.VaultID,
+		&w.SSID,
+		&w.Security,
+		&w.Password,
+		&w.Hidden,
+		&w.UpdatedAt,
+		&w.Revision,
+		&w.LastUsedAt,
+	)
+	return w, err
+}
@@ -0,0 +1,360 @@
+// Package server runs the GophKeeper gRPC API, its HTTP gateway and the admin API,
+// so that they can be embedded in other binaries and integration tests.
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/info"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/send"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server/api"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/gateway"
+	"github.com/cmrd-a/GophKeeper/server/insecure"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/listener"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/service"
+)
+
+// shutdownTimeout bounds how long Run waits for calls in flight when its context is done.
+const shutdownTimeout = 10 * time.Second
+
+// exportsPerDay is how many times a day each user can export their personal data, exports being costly.
+const exportsPerDay = 3
+
+// Options are the dependencies of a Server that do not come from the configuration.
+type Options struct {
+	// Repo stores the data. When nil, the server opens the database of the configuration
+	// and schedules backups if they are enabled.
+	Repo repository.Storage
+	// AccessLog logs every call when set.
+	AccessLog *slog.Logger
+	// LogLevel is changed through the admin API, a new one if nil.
+	LogLevel *slog.LevelVar
+	// Reload delivers configurations that Run applies with Reload while serving.
+	Reload <-chan *config.Config
+}
+
+// Server serves the API on the ports and sockets of the configuration.
+type Server struct {
+	cfg       *config.Config
+	log       *slog.Logger
+	accessLog *slog.Logger
+	repo      repository.Storage
+	// db is the database opened by New, closed by Stop.
+	db      *repository.Repository
+	backups *service.BackupService
+
+	grpc         *grpc.Server
+	health       *health.Server
+	adminAPI     *api.AdminServer
+	adminServers []*grpc.Server
+	gateway      *http.Server
+	metrics      *http.Server
+	conn         *grpc.ClientConn
+	closers      []io.Closer
+	jobs         []job
+
+	grpcAddr, httpAddr net.Addr
+	stopJobs           context.CancelFunc
+	errs               chan error
+
+	// The settings changed by Reload, loaded being the configuration they were last compared with.
+	reloadMu    sync.Mutex
+	loaded      *config.Config
+	level       *slog.LevelVar
+	maintenance *interceptor.Maintenance
+	limiter     *interceptor.Limiter
+	payloads    *interceptor.PayloadLogger
+}
+
+// job is a background job run every interval while the server is started.
+type job struct {
+	name     string
+	interval time.Duration
+	run      func(ctx context.Context) error
+}
+
+// Run serves the API until ctx is done or serving fails, then stops the server gracefully.
+func Run(ctx context.Context, cfg *config.Config, log *slog.Logger, opts Options) error {
+	s, err := New(cfg, log, opts)
+	if err != nil {
+		return err
+	}
+	err = s.Start()
+	if err == nil {
+		err = s.wait(ctx, opts.Reload)
+	}
+	stopCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+	defer cancel()
+	return errors.Join(err, s.Stop(stopCtx))
+}
+
+// wait serves until ctx is done or serving fails, applying the configurations from reload meanwhile.
+func (s *Server) wait(ctx context.Context, reload <-chan *config.Config) error {
+	for {
+		select {
+		case <-ctx.Done():
+			s.log.InfoContext(ctx, "Shutting down")
+			return nil
+		case err := <-s.Err():
+			return err
+		case cfg := <-reload:
+			s.Reload(ctx, cfg)
+		}
+	}
+}
+
+// New prepares a server, opening the database unless opts.Repo is set. Nothing is served until Start.
+func New(cfg *config.Config, log *slog.Logger, opts Options) (*Server, error) {
+	s := &Server{
+		cfg:       cfg,
+		log:       log,
+		accessLog: opts.AccessLog,
+		repo:      opts.Repo,
+		stopJobs:  func() {},
+		errs:      make(chan error, 1),
+		loaded:    cfg,
+	}
+	if s.repo == nil {
+		db, err := OpenDatabase(log, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("database is not ready: %w", err)
+		}
+		s.db, s.repo = db, *db
+		err = s.scheduleBackups()
+		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+	lvl := opts.LogLevel
+	if lvl == nil {
+		lvl = new(slog.LevelVar)
+	}
+	err := s.init(lvl)
+	if err != nil {
+		s.close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// Start listens and serves in the background, failures while serving are reported by Err.
+// Stop releases what Start acquired, also when it fails.
+func (s *Server) Start() error {
+	// Sockets passed by systemd are matched by FileDescriptorName=grpc and FileDescriptorName=http.
+	activated, err := listener.Activated()
+	if err != nil {
+		return fmt.Errorf("failed to get activated sockets: %w", err)
+	}
+	grpcListeners, addr, err := listenGRPC(s.cfg, activated)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	s.grpcAddr = grpcListeners[0].Addr()
+	for _, lis := range grpcListeners {
+		s.serve("gRPC", lis, s.grpc.Serve)
+	}
+	httpListeners, err := listenHTTP(s.cfg, activated)
+	if err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	s.httpAddr = httpListeners[0].Addr()
+	s.gateway, s.conn, err = gateway.New(addr, grpcWeb(s.cfg, s.grpc))
+	if err != nil {
+		return err
+	}
+	for _, lis := range httpListeners {
+		s.serve("gRPC-Gateway and OpenAPI Documentation", lis, s.gateway.Serve)
+	}
+	err = s.serveAdmin()
+	if err != nil {
+		return fmt.Errorf("failed to serve admin API: %w", err)
+	}
+	err = s.serveMetrics()
+	if err != nil {
+		return fmt.Errorf("failed to serve metrics: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.stopJobs = cancel
+	for _, j := range s.jobs {
+		go every(ctx, s.log, j.interval, j.name, j.run)
+	}
+	return nil
+}
+
+// ServeGRPC serves only the gRPC API on lis, without the gateway, the admin API or the background jobs,
+// until Stop. It is meant for in-process listeners, like bufconn in tests.
+func (s *Server) ServeGRPC(lis net.Listener) error {
+	return s.grpc.Serve(lis)
+}
+
+// Stop stops serving, waiting for the calls in flight until ctx is done, and releases the server's resources.
+func (s *Server) Stop(ctx context.Context) error {
+	s.stopJobs()
+	var errs []error
+	if s.gateway != nil {
+		errs = append(errs, s.gateway.Shutdown(ctx))
+	}
+	if s.metrics != nil {
+		errs = append(errs, s.metrics.Shutdown(ctx))
+	}
+	// Clients balancing over several servers stop picking this one before it goes away.
+	s.health.Shutdown()
+	stopped := make(chan struct{})
+	go func() {
+		s.grpc.GracefulStop()
+		for _, a := range s.adminServers {
+			a.GracefulStop()
+		}
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.grpc.Stop()
+		for _, a := range s.adminServers {
+			a.Stop()
+		}
+	}
+	if s.conn != nil {
+		errs = append(errs, s.conn.Close())
+	}
+	errs = append(errs, s.close())
+	return errors.Join(errs...)
+}
+
+// Err reports the first failure of a listener while serving.
+func (s *Server) Err() <-chan error {
+	return s.errs
+}
+
+// GRPCAddr returns the address of the gRPC API, once started.
+func (s *Server) GRPCAddr() net.Addr {
+	return s.grpcAddr
+}
+
+// HTTPAddr returns the address of the HTTP gateway, once started.
+func (s *Server) HTTPAddr() net.Addr {
+	return s.httpAddr
+}
+
+// init creates the gRPC server with the services and the background jobs.
+func (s *Server) init(lvl *slog.LevelVar) error {
+	s.level = lvl
+	s.maintenance = &interceptor.Maintenance{}
+	s.maintenance.Set(s.cfg.Maintenance, s.cfg.MaintenanceMessage)
+	s.adminAPI = &api.AdminServer{
+		Service:     service.NewAdminService(s.repo),
+		Backups:     s.backups,
+		LogLevel:    lvl,
+		Maintenance: s.maintenance,
+	}
+
+	devices := service.NewDeviceService(s.repo)
+	authn := interceptor.NewAuth(s.log, s.cfg.Tokens(), s.repo.GetAccountAuth, devices.CheckDevice, authExempt(s.cfg))
+	unary, stream, err := s.interceptors(authn)
+	if err != nil {
+		return fmt.Errorf("failed to configure interceptors: %w", err)
+	}
+	s.grpc = grpc.NewServer(
+		grpc.Creds(credentials.NewServerTLSFromCert(&insecure.Cert)),
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	)
+	sendService := service.NewSendService(s.repo)
+	s.addJob("secret link cleanup", time.Minute, func(ctx context.Context) error {
+		n, err := sendService.DeleteExpired(ctx)
+		if n > 0 {
+			s.log.InfoContext(ctx, "Deleted expired secret links", "count", n)
+		}
+		return err
+	})
+	emergencyService := service.NewEmergencyService(s.repo, service.LogNotifier{Log: s.log})
+	s.addJob("emergency access grant", time.Minute, emergencyService.GrantDue)
+
+	emergency.RegisterEmergencyAccessServiceServer(s.grpc, &api.EmergencyServer{Service: emergencyService})
+	info.RegisterInfoServiceServer(s.grpc, &api.InfoServer{Features: features(s.cfg), Telemetry: s.telemetry()})
+	send.RegisterSendServiceServer(s.grpc, &api.SendServer{Service: sendService})
+	accounts := service.NewAccountService(s.repo, s.cfg.Tokens(), s.cfg.SaltSecret)
+	user.RegisterUserServiceServer(s.grpc, &api.UserServer{
+		Accounts:      accounts,
+		Allowlist:     service.NewAllowlistService(s.repo),
+		Devices:       devices,
+		Export:        service.NewExportService(s.repo, s.log),
+		ExportLimiter: interceptor.NewLimiter(exportsPerDay/(24*time.Hour).Seconds(), exportsPerDay),
+		Recovery:      service.NewRecoveryService(s.repo, s.cfg.Tokens(), accounts),
+		Registration:  registration(s.cfg),
+	})
+	vault.RegisterVaultServiceServer(s.grpc, &api.VaultServer{
+		Service:  service.NewService(s.repo),
+		Health:   passwordHealth(s.cfg, s.repo),
+		Shares:   service.NewShareService(s.repo),
+		Favicons: favicons(s.cfg, s.repo),
+	})
+	s.health = health.NewServer()
+	healthpb.RegisterHealthServer(s.grpc, s.health)
+	if s.cfg.GRPCReflection {
+		reflection.Register(s.grpc)
+	}
+	err = authn.CheckExempt(s.grpc.GetServiceInfo())
+	if err != nil {
+		return fmt.Errorf("bad AUTH_EXEMPT_METHODS: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) addJob(name string, interval time.Duration, run func(ctx context.Context) error) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+// serve serves on the listener in the background, reporting its failure on Err.
+func (s *Server) serve(what string, lis net.Listener, serve func(net.Listener) error) {
+	s.log.Info("Serving "+what+" on ", "network", lis.Addr().Network(), "addr", lis.Addr())
+	go func() {
+		err := serve(lis)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.fail(fmt.Errorf("failed to serve %s: %w", what, err))
+		}
+	}()
+}
+
+// fail reports err on Err, unless an earlier failure has not been received yet.
+func (s *Server) fail(err error) {
+	select {
+	case s.errs <- err:
+	default:
+	}
+}
+
+// close releases the connections opened by New.
+func (s *Server) close() error {
+	var errs []error
+	for _, c := range s.closers {
+		errs = append(errs, c.Close())
+	}
+	if s.db != nil {
+		s.db.Close()
+	}
+	return errors.Join(errs...)
+}
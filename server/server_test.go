@@ -0,0 +1,465 @@
+package server_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/info"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/send"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/service"
+	"github.com/cmrd-a/GophKeeper/testsupport"
+)
+
+// TestAuthExemptMethodsRegistered checks that the default exempt methods name registered methods,
+// which New verifies, with and without reflection.
+func TestAuthExemptMethodsRegistered(t *testing.T) {
+	for _, reflection := range []bool{false, true} {
+		cfg := defaultConfig(t)
+		cfg.GRPCReflection = reflection
+		srv, err := server.New(cfg, slog.New(slog.DiscardHandler), server.Options{Repo: repository.NewMemory()})
+		if err != nil {
+			t.Fatalf("new server with GRPC_REFLECTION=%v: %v", reflection, err)
+		}
+		err = srv.Stop(context.Background())
+		if err != nil {
+			t.Fatalf("stop server: %v", err)
+		}
+	}
+}
+
+func TestUnknownAuthExemptMethod(t *testing.T) {
+	cfg := defaultConfig(t)
+	cfg.AuthExemptMethods = append(cfg.AuthExemptMethods, "/v1.vault.VaultService/GetLoginPassword")
+	_, err := server.New(cfg, slog.New(slog.DiscardHandler), server.Options{Repo: repository.NewMemory()})
+	if !errors.Is(err, interceptor.ErrUnknownMethod) {
+		t.Fatalf("New() = %v, want ErrUnknownMethod", err)
+	}
+}
+
+// TestStartSocketFailure checks that the TCP listener is released when the unix socket can't be listened on.
+func TestStartSocketFailure(t *testing.T) {
+	// GRPC_PORT is an int16, so the port is picked below the ephemeral range.
+	var (
+		lis  net.Listener
+		port int16
+		err  error
+	)
+	for port = 20000; port < 20100; port++ {
+		lis, err = net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
+		if err == nil {
+			break
+		}
+	}
+	if err != nil {
+		t.Fatalf("pick a port: %v", err)
+	}
+	err = lis.Close()
+	if err != nil {
+		t.Fatalf("close listener: %v", err)
+	}
+	cfg := defaultConfig(t)
+	cfg.GRPCPort = port
+	cfg.GRPCSocket = filepath.Join(t.TempDir(), "missing", "grpc.sock")
+	srv, err := server.New(cfg, slog.New(slog.DiscardHandler), server.Options{Repo: repository.NewMemory()})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+	if srv.Start() == nil {
+		t.Fatal("start with a socket in a missing directory succeeded")
+	}
+	err = srv.Stop(context.Background())
+	if err != nil {
+		t.Fatalf("stop server: %v", err)
+	}
+	lis, err = net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", port))
+	if err != nil {
+		t.Fatalf("listen on the port after the failed start: %v", err)
+	}
+	_ = lis.Close()
+}
+
+// TestAuthExempt calls exempt and other methods without a token.
+func TestAuthExempt(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+
+	_, err := c.Info.GetServerInfo(ctx, &info.GetServerInfoRequest{})
+	if err != nil {
+		t.Errorf("GetServerInfo without a token: %v", err)
+	}
+	_, err = c.User.Register(ctx, &user.RegisterRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Errorf("Register without a token: %v", err)
+	}
+	_, err = c.User.Login(ctx, &user.LoginRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Errorf("Login without a token: %v", err)
+	}
+	_, err = c.Vault.GetLoginPasswords(ctx, &vault.GetLoginPasswordsRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("GetLoginPasswords without a token = %v, want Unauthenticated", err)
+	}
+}
+
+// TestDisabledUserToken checks that disabling an account revokes the tokens it already holds.
+func TestDisabledUserToken(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+
+	err := c.Repo.SetUserDisabled(ctx, "alice", true)
+	if err != nil {
+		t.Fatalf("disable user: %v", err)
+	}
+	_, err = c.Vault.ListVaults(ctx, &vault.ListVaultsRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v with a token issued before the disable, want Unauthenticated", err)
+	}
+}
+
+// TestEmergencyAccess checks that a trusted contact reads the owner's items only once the waiting period passed.
+func TestEmergencyAccess(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+	aliceCtx, _ := c.AddUser(ctx, t, "alice")
+	bobCtx, _ := c.AddUser(ctx, t, "bob")
+
+	_, err := c.Vault.SaveLoginPassword(aliceCtx, &vault.SaveLoginPasswordRequest{Login: "alice", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+	contact, err := c.Emergency.AddTrustedContact(aliceCtx, &emergency.AddTrustedContactRequest{
+		GranteeLogin: "bob",
+		WaitSeconds:  1,
+	})
+	if err != nil {
+		t.Fatalf("add trusted contact: %v", err)
+	}
+	_, err = c.Emergency.RequestAccess(bobCtx, &emergency.RequestAccessRequest{Id: contact.GetId()})
+	if err != nil {
+		t.Fatalf("request access: %v", err)
+	}
+	_, err = c.Emergency.GetOwnerItems(bobCtx, &emergency.GetOwnerItemsRequest{Id: contact.GetId()})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("got %v reading the owner's items before the grant, want PermissionDenied", err)
+	}
+
+	time.Sleep(time.Second)
+	err = service.NewEmergencyService(c.Repo, service.LogNotifier{Log: slog.New(slog.DiscardHandler)}).GrantDue(ctx)
+	if err != nil {
+		t.Fatalf("grant due requests: %v", err)
+	}
+	resp, err := c.Emergency.GetOwnerItems(bobCtx, &emergency.GetOwnerItemsRequest{Id: contact.GetId()})
+	if err != nil {
+		t.Fatalf("get owner items after the grant: %v", err)
+	}
+	if len(resp.GetItems()) != 1 || resp.GetItems()[0].GetLoginPassword().GetPassword() != "hunter2" {
+		t.Errorf("got items %v, want the owner's login password", resp.GetItems())
+	}
+}
+
+// TestRecoverAccount checks that recovering sets the new password, keeping the code when the password is invalid.
+func TestRecoverAccount(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+	aliceCtx, _ := c.AddUser(ctx, t, "alice")
+
+	kit, err := c.User.CreateRecoveryKit(aliceCtx, &user.CreateRecoveryKitRequest{})
+	if err != nil {
+		t.Fatalf("create recovery kit: %v", err)
+	}
+	_, err = c.User.RecoverAccount(ctx, &user.RecoverAccountRequest{
+		Login:        "alice",
+		RecoveryCode: kit.GetRecoveryCode(),
+		NewPassword:  "short",
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("got %v recovering with a short password, want InvalidArgument", err)
+	}
+	_, err = c.User.RecoverAccount(ctx, &user.RecoverAccountRequest{
+		Login:        "alice",
+		RecoveryCode: kit.GetRecoveryCode(),
+		NewPassword:  "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("recover account: %v", err)
+	}
+	_, err = c.User.Login(ctx, &user.LoginRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Errorf("login with the new password: %v", err)
+	}
+	_, err = c.Vault.ListVaults(aliceCtx, &vault.ListVaultsRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Errorf("got %v with a token issued before the recovery, want Unauthenticated", err)
+	}
+}
+
+// TestAccountUsage checks that the usage report counts the devices of the account and their syncs.
+func TestAccountUsage(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+
+	_, err := c.Vault.SaveLoginPassword(ctx, &vault.SaveLoginPasswordRequest{Login: "alice", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+	_, err = c.Vault.GetChangesSince(ctx, &vault.GetChangesSinceRequest{})
+	if err != nil {
+		t.Fatalf("get changes: %v", err)
+	}
+	usage, err := c.User.GetAccountUsage(ctx, &user.GetAccountUsageRequest{})
+	if err != nil {
+		t.Fatalf("get account usage: %v", err)
+	}
+	if usage.GetDevices() != 1 {
+		t.Errorf("got %d devices, want 1", usage.GetDevices())
+	}
+	if usage.GetLastSyncAt() == nil {
+		t.Error("got no last sync, want the sync of the device")
+	}
+	if usage.GetItems()["login_password"] != 1 || usage.GetStorageBytes() == 0 {
+		t.Errorf("got items %v and %d bytes, want the saved login password", usage.GetItems(), usage.GetStorageBytes())
+	}
+}
+
+// TestVaultItemsStream checks that the stream sends the items of every type.
+func TestVaultItemsStream(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+
+	_, err := c.Vault.SaveLoginPassword(ctx, &vault.SaveLoginPasswordRequest{Login: "alice", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+	_, err = c.Vault.SaveWifiCredential(ctx, &vault.SaveWifiCredentialRequest{
+		Ssid:     "home",
+		Security: vault.WifiSecurity_WIFI_SECURITY_WPA,
+		Password: "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("save WiFi credential: %v", err)
+	}
+	stream, err := c.Vault.GetVaultItemsStream(ctx, &vault.GetVaultItemsStreamRequest{})
+	if err != nil {
+		t.Fatalf("get vault items stream: %v", err)
+	}
+	var logins, wifis int
+	for {
+		item, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("receive item: %v", err)
+		}
+		switch {
+		case item.GetLoginPassword() != nil:
+			logins++
+		case item.GetWifiCredential() != nil:
+			wifis++
+		}
+	}
+	if logins != 1 || wifis != 1 {
+		t.Errorf("got %d login passwords and %d WiFi credentials, want 1 of each", logins, wifis)
+	}
+}
+
+// TestDefaultVault checks that users get the default vault when they are created, not when listing vaults.
+func TestDefaultVault(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+
+	resp, err := c.Vault.ListVaults(ctx, &vault.ListVaultsRequest{})
+	if err != nil {
+		t.Fatalf("list vaults: %v", err)
+	}
+	if len(resp.GetVaults()) != 1 || resp.GetVaults()[0].GetName() != repository.DefaultVaultName {
+		t.Errorf("got vaults %v, want the default one", resp.GetVaults())
+	}
+}
+
+// TestTouchItem checks that items of every type record their last use,
+// and that a user an item is shared with read-only can't touch it.
+func TestTouchItem(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+	aliceCtx, _ := c.AddUser(ctx, t, "alice")
+	bobCtx, _ := c.AddUser(ctx, t, "bob")
+
+	_, err := c.Vault.SaveWifiCredential(aliceCtx, &vault.SaveWifiCredentialRequest{
+		Ssid:     "home",
+		Security: vault.WifiSecurity_WIFI_SECURITY_WPA,
+		Password: "correct horse",
+	})
+	if err != nil {
+		t.Fatalf("save WiFi credential: %v", err)
+	}
+	_, err = c.Vault.SaveLoginPassword(aliceCtx, &vault.SaveLoginPasswordRequest{Login: "alice", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+	changes, err := c.Vault.GetChangesSince(aliceCtx, &vault.GetChangesSinceRequest{})
+	if err != nil {
+		t.Fatalf("get changes: %v", err)
+	}
+	wifiID := changes.GetWifiCredentials()[0].GetId()
+	loginID := changes.GetLoginPasswords()[0].GetId()
+
+	_, err = c.Vault.TouchItem(aliceCtx, &vault.TouchItemRequest{ItemId: wifiID})
+	if err != nil {
+		t.Fatalf("touch WiFi credential: %v", err)
+	}
+	item, err := c.Vault.GetVaultItem(aliceCtx, &vault.GetVaultItemRequest{
+		Id:   wifiID,
+		Type: vault.ItemType_ITEM_TYPE_WIFI_CREDENTIAL,
+	})
+	if err != nil {
+		t.Fatalf("get WiFi credential: %v", err)
+	}
+	if item.GetWifiCredential().GetLastUsedAt() == nil {
+		t.Error("got no last use of the touched WiFi credential")
+	}
+
+	_, err = c.Vault.ShareItem(aliceCtx, &vault.ShareItemRequest{ItemId: loginID, GranteeLogin: "bob", ReadOnly: true})
+	if err != nil {
+		t.Fatalf("share login password: %v", err)
+	}
+	_, err = c.Vault.TouchItem(bobCtx, &vault.TouchItemRequest{ItemId: loginID})
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("got %v touching an item shared read-only, want PermissionDenied", err)
+	}
+}
+
+func defaultConfig(t *testing.T) *config.Config {
+	t.Helper()
+	cfg, err := config.Default()
+	if err != nil {
+		t.Fatalf("default config: %v", err)
+	}
+	return cfg
+}
+
+// TestSecretLinkLimits checks that secret links have a size limit and count toward the tenant's quota.
+func TestSecretLinkLimits(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+	tenantID, err := c.Repo.InsertTenant(ctx, "acme", 100)
+	if err != nil {
+		t.Fatalf("add tenant: %v", err)
+	}
+	err = c.Repo.SetUserTenant(ctx, "alice", &tenantID)
+	if err != nil {
+		t.Fatalf("set tenant: %v", err)
+	}
+	link := func(text string) error {
+		_, err := c.Send.CreateSecretLink(ctx, &send.CreateSecretLinkRequest{
+			Secret:     &send.CreateSecretLinkRequest_Text{Text: text},
+			TtlSeconds: 60,
+		})
+		return err
+	}
+
+	if err := link("hunter2"); err != nil {
+		t.Fatalf("create link within the quota: %v", err)
+	}
+	if err := link(strings.Repeat("x", 100)); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("got %v for a link over the quota, want ResourceExhausted", err)
+	}
+	if err := link(strings.Repeat("x", 64<<10+1)); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("got %v for a link over the size limit, want InvalidArgument", err)
+	}
+}
+
+// TestLoginDevice checks that logging in again with the id of the device reuses it, unless it was revoked.
+func TestLoginDevice(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+	_, err := c.User.Register(ctx, &user.RegisterRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	login := func(deviceID string) string {
+		t.Helper()
+		req := &user.LoginRequest{Login: "alice", Password: "correct horse", DeviceId: deviceID}
+		resp, err := c.User.Login(ctx, req)
+		if err != nil {
+			t.Fatalf("login: %v", err)
+		}
+		return resp.GetDeviceId()
+	}
+
+	first := login("")
+	if again := login(first); again != first {
+		t.Errorf("got device %s logging in again, want %s", again, first)
+	}
+	userID, _, err := c.Repo.GetPasswordHash(ctx, "alice")
+	if err != nil {
+		t.Fatalf("get user: %v", err)
+	}
+	devices, err := c.Repo.ListDevices(ctx, userID)
+	if err != nil {
+		t.Fatalf("list devices: %v", err)
+	}
+	if len(devices) != 1 {
+		t.Errorf("got %d devices after logging in twice from one, want 1", len(devices))
+	}
+
+	err = c.Repo.RevokeDevice(ctx, userID, uuid.MustParse(first))
+	if err != nil {
+		t.Fatalf("revoke device: %v", err)
+	}
+	if again := login(first); again == first {
+		t.Error("got the revoked device logging in again, want a new one")
+	}
+}
+
+// TestVaultRevision checks that every change to a vault returns a new vault revision.
+func TestVaultRevision(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx, _ := c.AddUser(context.Background(), t, "alice")
+
+	created, err := c.Vault.CreateVault(ctx, &vault.CreateVaultRequest{Name: "Work"})
+	if err != nil {
+		t.Fatalf("create vault: %v", err)
+	}
+	renamed, err := c.Vault.RenameVault(ctx, &vault.RenameVaultRequest{Id: created.GetId(), Name: "Office"})
+	if err != nil {
+		t.Fatalf("rename vault: %v", err)
+	}
+	rotated, err := c.Vault.SetVaultRotation(ctx, &vault.SetVaultRotationRequest{
+		VaultId:      created.GetId(),
+		RotationDays: 90,
+	})
+	if err != nil {
+		t.Fatalf("set vault rotation: %v", err)
+	}
+	deleted, err := c.Vault.DeleteVault(ctx, &vault.DeleteVaultRequest{Id: created.GetId()})
+	if err != nil {
+		t.Fatalf("delete vault: %v", err)
+	}
+
+	revisions := []int64{created.GetRevision(), renamed.GetRevision(), rotated.GetRevision(), deleted.GetRevision()}
+	for i, r := range revisions {
+		if r <= 0 || i > 0 && r <= revisions[i-1] {
+			t.Fatalf("got revisions %v, want each greater than the one before", revisions)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/argon2"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	maxLoginLength    = 64
+	minPasswordLength = 8
+	// maxPasswordLength bounds the work of hashing a password.
+	maxPasswordLength = 1024
+
+	// The Argon2id parameters recommended by RFC 9106 for memory constrained servers.
+	passwordSaltSize = 16
+	passwordKeySize  = 32
+	passwordTime     = 3
+	passwordMemory   = 64 << 10
+	passwordThreads  = 4
+)
+
+var (
+	ErrBadLogin    = errors.New("login must be 1 to 64 characters without spaces")
+	ErrBadPassword = errors.New("password must be 8 to 1024 characters")
+	ErrLoginTaken  = errors.New("login is already taken")
+	// ErrBadCredentials doesn't tell whether the login or the password was wrong.
+	ErrBadCredentials = errors.New("invalid login or password")
+)
+
+// AccountService creates accounts and checks their passwords.
+// Passwords are hashed with Argon2id, salted with a random salt and the server's secret,
+// so a leaked database alone is not enough to brute force them.
+type AccountService struct {
+	repo   repository.Storage
+	tokens auth.Tokens
+	secret []byte
+}
+
+func NewAccountService(repo repository.Storage, tokens auth.Tokens, saltSecret string) *AccountService {
+	return &AccountService{repo: repo, tokens: tokens, secret: []byte(saltSecret)}
+}
+
+// Register creates an account and returns its ID. Taken logins fail with ErrLoginTaken.
+func (s *AccountService) Register(ctx context.Context, login, password string) (uuid.UUID, error) {
+	if login == "" || utf8.RuneCountInString(login) > maxLoginLength || strings.ContainsFunc(login, unicode.IsSpace) {
+		return uuid.Nil, ErrBadLogin
+	}
+	err := checkPassword(password)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	hash, err := s.hashPassword(password)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	id, err := s.repo.InsertUser(ctx, login, hash)
+	if isUniqueViolation(err) {
+		return uuid.Nil, ErrLoginTaken
+	}
+	return id, err
+}
+
+// Login checks the password of the enabled account with the login and returns an access token
+// of the device logging in, of the account's current token generation, along with the device's id.
+// A device of an earlier login given by deviceID is logged in again, unless it was revoked;
+// otherwise a new device named device is registered.
+func (s *AccountService) Login(
+	ctx context.Context,
+	login, password, device string,
+	deviceID uuid.UUID,
+) (string, uuid.UUID, error) {
+	userID, hash, err := s.repo.GetPasswordHash(ctx, login)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Hash anyway, so the response time doesn't tell which logins exist.
+		s.deriveKey(password, make([]byte, passwordSaltSize))
+		return "", uuid.Nil, ErrBadCredentials
+	}
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	if !s.matchPassword(hash, password) {
+		return "", uuid.Nil, ErrBadCredentials
+	}
+	generation, err := s.repo.GetTokenGeneration(ctx, userID)
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	deviceID, err = s.loginDevice(ctx, userID, deviceID, device)
+	if err != nil {
+		return "", uuid.Nil, err
+	}
+	token, err := auth.NewToken(s.tokens, userID, deviceID, generation)
+	return token, deviceID, err
+}
+
+// loginDevice returns deviceID if it is an active device of the user, registering a new device otherwise.
+func (s *AccountService) loginDevice(ctx context.Context, userID, deviceID uuid.UUID, name string) (uuid.UUID, error) {
+	if deviceID != uuid.Nil {
+		d, err := s.repo.GetDevice(ctx, userID, deviceID)
+		if err == nil && d.RevokedAt == nil {
+			return d.ID, nil
+		}
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, err
+		}
+	}
+	return s.repo.InsertDevice(ctx, userID, deviceName(name))
+}
+
+// ChangePassword replaces the user's password if current is right, failing with ErrBadCredentials otherwise.
+// Every access token of the user is revoked along with the old password, so stolen sessions end;
+// it returns a new token for the caller's device.
+func (s *AccountService) ChangePassword(
+	ctx context.Context,
+	userID, deviceID uuid.UUID,
+	current, password string,
+) (string, error) {
+	account, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	_, hash, err := s.repo.GetPasswordHash(ctx, account.Login)
+	if err != nil {
+		return "", err
+	}
+	if !s.matchPassword(hash, current) {
+		return "", ErrBadCredentials
+	}
+	err = checkPassword(password)
+	if err != nil {
+		return "", err
+	}
+	hash, err = s.hashPassword(password)
+	if err != nil {
+		return "", err
+	}
+	generation, err := s.repo.SetPassword(ctx, userID, hash)
+	if err != nil {
+		return "", err
+	}
+	return auth.NewToken(s.tokens, userID, deviceID, generation)
+}
+
+func checkPassword(password string) error {
+	n := utf8.RuneCountInString(password)
+	if n < minPasswordLength || len(password) > maxPasswordLength {
+		return ErrBadPassword
+	}
+	return nil
+}
+
+// hashPassword returns a new random salt followed by the key derived from the password.
+func (s *AccountService) hashPassword(password string) ([]byte, error) {
+	salt := make([]byte, passwordSaltSize, passwordSaltSize+passwordKeySize)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return nil, err
+	}
+	return append(salt, s.deriveKey(password, salt)...), nil
+}
+
+// matchPassword reports whether hash is of the password.
+func (s *AccountService) matchPassword(hash []byte, password string) bool {
+	if len(hash) != passwordSaltSize+passwordKeySize {
+		return false
+	}
+	salt, key := hash[:passwordSaltSize], hash[passwordSaltSize:]
+	return subtle.ConstantTimeCompare(key, s.deriveKey(password, salt)) == 1
+}
+
+func (s *AccountService) deriveKey(password string, salt []byte) []byte {
+	return argon2.IDKey(
+		[]byte(password),
+		append(salt[:passwordSaltSize:passwordSaltSize], s.secret...),
+		passwordTime,
+		passwordMemory,
+		passwordThreads,
+		passwordKeySize,
+	)
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"net/netip"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+// AdminService holds the account operations available to operators.
+type AdminService struct {
+	repo repository.Storage
+}
+
+func NewAdminService(repo repository.Storage) *AdminService {
+	return &AdminService{repo: repo}
+}
+
+// SetUserDisabled disables or enables an account. Disabled accounts are refused at login
+// and the tokens they hold are revoked.
+func (s *AdminService) SetUserDisabled(ctx context.Context, login string, disabled bool) error {
+	return s.repo.SetUserDisabled(ctx, login, disabled)
+}
+
+func (s *AdminService) GetStorageUsage(ctx context.Context) (models.StorageUsage, error) {
+	return s.repo.GetStorageUsage(ctx)
+}
+
+// SetUserAllowedNetworks replaces the allowed networks of the account with the login.
+func (s *AdminService) SetUserAllowedNetworks(
+	ctx context.Context,
+	login string,
+	networks []string,
+) ([]netip.Prefix, error) {
+	prefixes, err := auth.ParseNetworks(networks)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := s.repo.GetUserIDByLogin(ctx, login)
+	if err != nil {
+		return nil, err
+	}
+	return prefixes, s.repo.SetAllowedNetworks(ctx, userID, prefixes)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+var ErrAllowlistLockout = errors.New("the networks do not include the caller's address")
+
+// AllowlistService manages the networks an account can be accessed from.
+type AllowlistService struct {
+	repo repository.Storage
+}
+
+func NewAllowlistService(repo repository.Storage) *AllowlistService {
+	return &AllowlistService{repo: repo}
+}
+
+// Networks returns the user's allowed networks, empty if any network is allowed.
+func (s *AllowlistService) Networks(ctx context.Context, userID uuid.UUID) ([]netip.Prefix, error) {
+	account, err := s.repo.GetAccountAuth(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return account.AllowedNetworks, nil
+}
+
+// SetNetworks replaces the user's allowed networks, unless they exclude host, the caller's address.
+func (s *AllowlistService) SetNetworks(
+	ctx context.Context,
+	userID uuid.UUID,
+	networks []string,
+	host string,
+) ([]netip.Prefix, error) {
+	prefixes, err := auth.ParseNetworks(networks)
+	if err != nil {
+		return nil, err
+	}
+	if !auth.AddressAllowed(prefixes, host) {
+		return nil, ErrAllowlistLockout
+	}
+	return prefixes, s.repo.SetAllowedNetworks(ctx, userID, prefixes)
+}
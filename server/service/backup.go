@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/backup"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+var ErrBackupNotFound = errors.New("backup not found")
+
+// backupMetrics is published as the "backup" expvar.
+var backupMetrics = expvar.NewMap("backup")
+
+// BackupService writes encrypted dumps of the whole database to a store.
+type BackupService struct {
+	repo       repository.Repository
+	store      backup.Store
+	passphrase string
+	keep       int
+}
+
+// NewBackupService creates the service. Only the newest keep archives, at least one, are kept in the store.
+func NewBackupService(repo repository.Repository, store backup.Store, passphrase string, keep int) *BackupService {
+	return &BackupService{repo: repo, store: store, passphrase: passphrase, keep: max(keep, 1)}
+}
+
+// Run creates a backup and deletes the archives exceeding the retention count.
+func (s *BackupService) Run(ctx context.Context) (models.Backup, error) {
+	start := time.Now()
+	backupMetrics.Add("runs", 1)
+	b, err := s.run(ctx, start)
+	if err != nil {
+		backupMetrics.Add("failures", 1)
+		return b, err
+	}
+	backupMetrics.Set("last_success_unix", intVar(start.Unix()))
+	backupMetrics.Set("last_duration_ms", intVar(time.Since(start).Milliseconds()))
+	backupMetrics.Set("last_size_bytes", intVar(int64(b.Size)))
+	return b, nil
+}
+
+func (s *BackupService) run(ctx context.Context, now time.Time) (models.Backup, error) {
+	version, tables, err := s.repo.Dump(ctx)
+	if err != nil {
+		return models.Backup{}, err
+	}
+	m := backup.Manifest{SchemaVersion: version, CreatedAt: now.UTC()}
+	data, err := backup.Seal(s.passphrase, m, tables)
+	if err != nil {
+		return models.Backup{}, err
+	}
+	b := models.Backup{Name: backup.Name(m), Size: len(data), SchemaVersion: version, CreatedAt: m.CreatedAt}
+	err = s.store.Put(ctx, b.Name, data)
+	if err != nil {
+		return models.Backup{}, err
+	}
+	return b, s.prune(ctx)
+}
+
+func (s *BackupService) prune(ctx context.Context) error {
+	names, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+	for len(names) > s.keep {
+		err = s.store.Delete(ctx, names[0])
+		if err != nil {
+			return err
+		}
+		names = names[1:]
+	}
+	return nil
+}
+
+// List returns the names of the stored archives, oldest first.
+func (s *BackupService) List(ctx context.Context) ([]string, error) {
+	return s.store.List(ctx)
+}
+
+// Restore loads the named archive, or the newest one if name is empty, into the database.
+// If userID is set only the data of that user is restored. With dryRun nothing is changed,
+// but the returned row counts show what would be restored.
+func (s *BackupService) Restore(
+	ctx context.Context,
+	name string,
+	userID *uuid.UUID,
+	dryRun bool,
+) (models.Backup, []models.RestoredTable, error) {
+	names, err := s.store.List(ctx)
+	if err != nil {
+		return models.Backup{}, nil, err
+	}
+	switch {
+	case name == "" && len(names) > 0:
+		name = names[len(names)-1]
+	case !slices.Contains(names, name):
+		return models.Backup{}, nil, ErrBackupNotFound
+	}
+
+	data, err := s.store.Get(ctx, name)
+	if err != nil {
+		return models.Backup{}, nil, err
+	}
+	m, tables, err := backup.Open(s.passphrase, data)
+	if err != nil {
+		return models.Backup{}, nil, err
+	}
+	b := models.Backup{Name: name, Size: len(data), SchemaVersion: m.SchemaVersion, CreatedAt: m.CreatedAt}
+	restored, err := s.repo.Restore(ctx, m.SchemaVersion, tables, userID, dryRun)
+	return b, restored, err
+}
+
+func intVar(v int64) *expvar.Int {
+	i := new(expvar.Int)
+	i.Set(v)
+	return i
+}
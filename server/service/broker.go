@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+const subscriberBuffer = 16
+
+// Broker fans out vault change events to the subscribers of each user.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[uuid.UUID]map[chan models.ChangeEvent]struct{}
+}
+
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[uuid.UUID]map[chan models.ChangeEvent]struct{})}
+}
+
+// Subscribe returns a channel receiving the user's change events.
+// The channel is closed once ctx is done.
+func (b *Broker) Subscribe(ctx context.Context, userID uuid.UUID) <-chan models.ChangeEvent {
+	ch := make(chan models.ChangeEvent, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.subs[userID] == nil {
+		b.subs[userID] = make(map[chan models.ChangeEvent]struct{})
+	}
+	b.subs[userID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[userID], ch)
+		if len(b.subs[userID]) == 0 {
+			delete(b.subs, userID)
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// Publish sends ev to every subscriber of the user.
+// Subscribers that do not keep up miss the event instead of blocking the writer.
+func (b *Broker) Publish(userID uuid.UUID, ev models.ChangeEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[userID] {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	maxDeviceNameLength = 64
+	// defaultDeviceName names the devices of clients that don't send a name on login.
+	defaultDeviceName = "Unnamed device"
+)
+
+type DeviceService struct {
+	repo repository.Storage
+}
+
+func NewDeviceService(repo repository.Storage) *DeviceService {
+	return &DeviceService{repo: repo}
+}
+
+func (s *DeviceService) ListDevices(ctx context.Context, userID uuid.UUID) ([]models.Device, error) {
+	return s.repo.ListDevices(ctx, userID)
+}
+
+func (s *DeviceService) RenameDevice(ctx context.Context, userID, id uuid.UUID, name string) error {
+	return s.repo.RenameDevice(ctx, userID, id, name)
+}
+
+// RevokeDevice marks the device as revoked; its tokens must be rejected from then on.
+func (s *DeviceService) RevokeDevice(ctx context.Context, userID, id uuid.UUID) error {
+	return s.repo.RevokeDevice(ctx, userID, id)
+}
+
+// CheckDevice returns auth.ErrDeviceRevoked if the device was revoked.
+// The auth interceptor calls it on every authenticated call.
+func (s *DeviceService) CheckDevice(ctx context.Context, userID, id uuid.UUID) error {
+	d, err := s.repo.GetDevice(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if d.RevokedAt != nil {
+		return auth.ErrDeviceRevoked
+	}
+	return nil
+}
+
+// GetAccountUsage returns item counts, storage and device use of the user's account.
+func (s *DeviceService) GetAccountUsage(ctx context.Context, userID uuid.UUID) (models.AccountUsage, error) {
+	return s.repo.GetAccountUsage(ctx, userID)
+}
+
+// deviceName returns the name sent by a client logging in, defaulted and cut to maxDeviceNameLength.
+func deviceName(name string) string {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return defaultDeviceName
+	}
+	if utf8.RuneCountInString(name) > maxDeviceNameLength {
+		name = string([]rune(name)[:maxDeviceNameLength])
+	}
+	return name
+}
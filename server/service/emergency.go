@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+var (
+	ErrBadTrustedContact    = errors.New("bad trusted contact")
+	ErrTrustedContactExists = errors.New("user is already a trusted contact")
+	// ErrNoEmergencyAccess is returned when a trusted contact reads the owner's items before being granted access.
+	ErrNoEmergencyAccess = errors.New("emergency access was not granted")
+)
+
+// EmergencyNotifier is told about emergency access state changes,
+// e.g. to email the owner that someone requested access to the vault.
+type EmergencyNotifier interface {
+	AccessRequested(ctx context.Context, c models.EmergencyContact)
+	AccessGranted(ctx context.Context, c models.EmergencyContact)
+	AccessRejected(ctx context.Context, c models.EmergencyContact)
+}
+
+// LogNotifier is an EmergencyNotifier writing to the log.
+type LogNotifier struct {
+	Log *slog.Logger
+}
+
+func (n LogNotifier) AccessRequested(ctx context.Context, c models.EmergencyContact) {
+	n.Log.InfoContext(ctx, "Emergency access requested", "id", c.ID, "owner", c.OwnerID, "grantee", c.GranteeID)
+}
+
+func (n LogNotifier) AccessGranted(ctx context.Context, c models.EmergencyContact) {
+	n.Log.InfoContext(ctx, "Emergency access granted", "id", c.ID, "owner", c.OwnerID, "grantee", c.GranteeID)
+}
+
+func (n LogNotifier) AccessRejected(ctx context.Context, c models.EmergencyContact) {
+	n.Log.InfoContext(ctx, "Emergency access rejected", "id", c.ID, "owner", c.OwnerID, "grantee", c.GranteeID)
+}
+
+// EmergencyService lets a user designate trusted contacts that are granted access to the vault
+// after requesting it, unless the owner rejects the request within the waiting period.
+type EmergencyService struct {
+	repo     repository.Storage
+	notifier EmergencyNotifier
+}
+
+func NewEmergencyService(repo repository.Storage, notifier EmergencyNotifier) *EmergencyService {
+	return &EmergencyService{repo: repo, notifier: notifier}
+}
+
+// AddContact makes the user with granteeLogin a trusted contact of the owner, who can only find users of their tenant.
+func (s *EmergencyService) AddContact(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	granteeLogin string,
+	wait time.Duration,
+) (uuid.UUID, error) {
+	if wait < 0 {
+		return uuid.Nil, ErrBadTrustedContact
+	}
+	granteeID, err := s.repo.GetTenantPeerID(ctx, ownerID, granteeLogin)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if granteeID == ownerID {
+		return uuid.Nil, ErrBadTrustedContact
+	}
+	id, err := s.repo.InsertEmergencyContact(ctx, ownerID, granteeID, wait)
+	if isUniqueViolation(err) {
+		return uuid.Nil, ErrTrustedContactExists
+	}
+	return id, err
+}
+
+func (s *EmergencyService) RemoveContact(ctx context.Context, ownerID, id uuid.UUID) error {
+	return s.repo.DeleteEmergencyContact(ctx, ownerID, id)
+}
+
+func (s *EmergencyService) ListContacts(ctx context.Context, userID uuid.UUID) ([]models.EmergencyContact, error) {
+	return s.repo.ListEmergencyContacts(ctx, userID)
+}
+
+// RequestAccess starts the waiting period and returns when access will be granted.
+func (s *EmergencyService) RequestAccess(ctx context.Context, granteeID, id uuid.UUID) (time.Time, error) {
+	c, err := s.repo.RequestEmergencyAccess(ctx, granteeID, id)
+	if err != nil {
+		return time.Time{}, err
+	}
+	s.notifier.AccessRequested(ctx, c)
+	return c.RequestedAt.Add(c.Wait), nil
+}
+
+func (s *EmergencyService) ApproveAccess(ctx context.Context, ownerID, id uuid.UUID) error {
+	c, err := s.repo.ApproveEmergencyAccess(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	s.notifier.AccessGranted(ctx, c)
+	return nil
+}
+
+func (s *EmergencyService) RejectAccess(ctx context.Context, ownerID, id uuid.UUID) error {
+	c, err := s.repo.RejectEmergencyAccess(ctx, ownerID, id)
+	if err != nil {
+		return err
+	}
+	s.notifier.AccessRejected(ctx, c)
+	return nil
+}
+
+// GrantDue grants requests whose waiting period has passed.
+func (s *EmergencyService) GrantDue(ctx context.Context) error {
+	granted, err := s.repo.GrantDueEmergencyRequests(ctx)
+	if err != nil {
+		return err
+	}
+	for _, c := range granted {
+		s.notifier.AccessGranted(ctx, c)
+	}
+	return nil
+}
+
+// HasAccess reports whether the grantee was granted access to the owner's vault.
+func (s *EmergencyService) HasAccess(ctx context.Context, granteeID, ownerID uuid.UUID) (bool, error) {
+	return s.repo.HasEmergencyAccess(ctx, granteeID, ownerID)
+}
+
+// OwnerItems returns the items of every type of the owner of the contact designating the grantee,
+// or ErrNoEmergencyAccess unless the grantee was granted access.
+func (s *EmergencyService) OwnerItems(ctx context.Context, granteeID, id uuid.UUID) ([]models.VaultItem, error) {
+	contacts, err := s.repo.ListEmergencyContacts(ctx, granteeID)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(contacts, func(c models.EmergencyContact) bool {
+		return c.ID == id && c.GranteeID == granteeID
+	})
+	if i < 0 {
+		return nil, pgx.ErrNoRows
+	}
+	ownerID := contacts[i].OwnerID
+	ok, err := s.HasAccess(ctx, granteeID, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ErrNoEmergencyAccess
+	}
+	var items []models.VaultItem
+	after := uuid.Nil
+	for {
+		page, err := s.repo.ListItemsPage(ctx, ownerID, nil, after, streamPageSize)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+		if len(page) < streamPageSize {
+			return items, nil
+		}
+		after = vaultItemID(page[len(page)-1])
+	}
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/netip"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+// exportFormat is the version of the layout of personal data archives, raised on incompatible changes.
+const exportFormat = 1
+
+// ExportService collects everything stored about a user, for data portability requests.
+type ExportService struct {
+	repo repository.Storage
+	log  *slog.Logger
+}
+
+func NewExportService(repo repository.Storage, log *slog.Logger) *ExportService {
+	return &ExportService{repo: repo, log: log}
+}
+
+// exportManifest is manifest.json, describing the other files of the archive.
+type exportManifest struct {
+	Format     int       `json:"format"`
+	UserID     uuid.UUID `json:"user_id"`
+	ExportedAt time.Time `json:"exported_at"`
+	Files      []string  `json:"files"`
+}
+
+type exportAccount struct {
+	ID              uuid.UUID      `json:"id"`
+	Login           string         `json:"login"`
+	TenantID        *uuid.UUID     `json:"tenant_id"`
+	DisabledAt      *time.Time     `json:"disabled_at"`
+	AllowedNetworks []netip.Prefix `json:"allowed_networks"`
+	HasRecoveryKit  bool           `json:"has_recovery_kit"`
+}
+
+type exportVault struct {
+	ID           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	CreatedAt    time.Time `json:"created_at"`
+	RotationDays int       `json:"rotation_days"`
+}
+
+type exportLoginPassword struct {
+	ID           *uuid.UUID       `json:"id"`
+	VaultID      uuid.UUID        `json:"vault_id"`
+	Login        string           `json:"login"`
+	Password     string           `json:"password"`
+	URLs         []exportLoginURL `json:"urls"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+	LastUsedAt   *time.Time       `json:"last_used_at"`
+	RotationDays int              `json:"rotation_days"`
+}
+
+type exportLoginURL struct {
+	URL   string          `json:"url"`
+	Match models.URLMatch `json:"match"`
+}
+
+type exportWifiCredential struct {
+	ID         *uuid.UUID          `json:"id"`
+	VaultID    uuid.UUID           `json:"vault_id"`
+	SSID       string              `json:"ssid"`
+	Security   models.WifiSecurity `json:"security"`
+	Password   string              `json:"password"`
+	Hidden     bool                `json:"hidden"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+	LastUsedAt *time.Time          `json:"last_used_at"`
+}
+
+type exportSeedPhrase struct {
+	ID         *uuid.UUID `json:"id"`
+	VaultID    uuid.UUID  `json:"vault_id"`
+	Name       string     `json:"name"`
+	Words      []string   `json:"words"`
+	UpdatedAt  time.Time  `json:"updated_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type exportDevice struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastSyncAt *time.Time `json:"last_sync_at"`
+	RevokedAt  *time.Time `json:"revoked_at"`
+}
+
+type exportShare struct {
+	ID           uuid.UUID       `json:"id"`
+	ItemID       uuid.UUID       `json:"item_id"`
+	ItemType     models.ItemType `json:"item_type"`
+	GranteeLogin string          `json:"grantee_login"`
+	ReadOnly     bool            `json:"read_only"`
+	ExpiresAt    *time.Time      `json:"expires_at"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+type exportEmergencyContact struct {
+	ID           uuid.UUID              `json:"id"`
+	OwnerLogin   string                 `json:"owner_login"`
+	GranteeLogin string                 `json:"grantee_login"`
+	WaitSeconds  int64                  `json:"wait_seconds"`
+	Status       models.EmergencyStatus `json:"status"`
+	RequestedAt  *time.Time             `json:"requested_at"`
+}
+
+func newExportVault(v models.Vault) exportVault {
+	return exportVault{ID: v.ID, Name: v.Name, CreatedAt: v.CreatedAt, RotationDays: v.RotationDays}
+}
+
+func newExportLoginPassword(lp models.LoginPassword) exportLoginPassword {
+	return exportLoginPassword{
+		ID:       lp.ID,
+		VaultID:  lp.VaultID,
+		Login:    lp.Login,
+		Password: lp.Password,
+		URLs: exportAll(lp.URLs, func(u models.LoginURL) exportLoginURL {
+			return exportLoginURL{URL: u.URL, Match: u.Match}
+		}),
+		UpdatedAt:    lp.UpdatedAt,
+		LastUsedAt:   lp.LastUsedAt,
+		RotationDays: lp.RotationDays,
+	}
+}
+
+func newExportWifiCredential(w models.WifiCredential) exportWifiCredential {
+	return exportWifiCredential{
+		ID:         w.ID,
+		VaultID:    w.VaultID,
+		SSID:       w.SSID,
+		Security:   w.Security,
+		Password:   w.Password,
+		Hidden:     w.Hidden,
+		UpdatedAt:  w.UpdatedAt,
+		LastUsedAt: w.LastUsedAt,
+	}
+}
+
+func newExportSeedPhrase(sp models.SeedPhrase) exportSeedPhrase {
+	return exportSeedPhrase{
+		ID:         sp.ID,
+		VaultID:    sp.VaultID,
+		Name:       sp.Name,
+		Words:      sp.Words,
+		UpdatedAt:  sp.UpdatedAt,
+		LastUsedAt: sp.LastUsedAt,
+	}
+}
+
+func newExportDevice(d models.Device) exportDevice {
+	return exportDevice{
+		ID:         d.ID,
+		Name:       d.Name,
+		CreatedAt:  d.CreatedAt,
+		LastSyncAt: d.LastSyncAt,
+		RevokedAt:  d.RevokedAt,
+	}
+}
+
+func newExportShare(sh models.Share) exportShare {
+	return exportShare{
+		ID:           sh.ID,
+		ItemID:       sh.ItemID,
+		ItemType:     sh.ItemType,
+		GranteeLogin: sh.GranteeLogin,
+		ReadOnly:     sh.ReadOnly,
+		ExpiresAt:    sh.ExpiresAt,
+		CreatedAt:    sh.CreatedAt,
+	}
+}
+
+func newExportEmergencyContact(c models.EmergencyContact) exportEmergencyContact {
+	return exportEmergencyContact{
+		ID:           c.ID,
+		OwnerLogin:   c.OwnerLogin,
+		GranteeLogin: c.GranteeLogin,
+		WaitSeconds:  int64(c.Wait / time.Second),
+		Status:       c.Status,
+		RequestedAt:  c.RequestedAt,
+	}
+}
+
+// exportFile is a JSON file of the archive.
+type exportFile struct {
+	name string
+	data any
+}
+
+// Export returns a zip archive with manifest.json and a JSON file for each kind of data stored about the user:
+// the account, vaults, items, devices, shares and trusted contacts. Every export is logged.
+func (s *ExportService) Export(ctx context.Context, userID uuid.UUID) ([]byte, error) {
+	files, err := s.collect(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zipJSON(exportManifest{Format: exportFormat, UserID: userID, ExportedAt: time.Now()}, files)
+	if err != nil {
+		return nil, err
+	}
+	s.log.InfoContext(ctx, "Exported personal data",
+		"event", "personal_data_export", "user_id", userID, "bytes", len(archive))
+	return archive, nil
+}
+
+func (s *ExportService) collect(ctx context.Context, userID uuid.UUID) ([]exportFile, error) {
+	account, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	vaults, err := s.repo.ListVaults(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.collectItems(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	devices, err := s.repo.ListDevices(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.repo.ListSharesByOwner(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	contacts, err := s.repo.ListEmergencyContacts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	files := []exportFile{
+		{"account.json", exportAccount(account)},
+		{"vaults.json", exportAll(vaults, newExportVault)},
+	}
+	files = append(files, items...)
+	return append(files,
+		exportFile{"devices.json", exportAll(devices, newExportDevice)},
+		exportFile{"shares.json", exportAll(shares, newExportShare)},
+		exportFile{"emergency_contacts.json", exportAll(contacts, newExportEmergencyContact)},
+	), nil
+}
+
+// collectItems returns the files of the user's own items, not those shared with the user.
+func (s *ExportService) collectItems(ctx context.Context, userID uuid.UUID) ([]exportFile, error) {
+	lps, err := s.repo.ListLoginPasswords(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	wifis, err := s.repo.GetWifiCredentialsChangedSince(ctx, userID, time.Time{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	phrases, err := s.repo.GetSeedPhrasesChangedSince(ctx, userID, time.Time{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	return []exportFile{
+		{"login_passwords.json", exportAll(lps, newExportLoginPassword)},
+		{"wifi_credentials.json", exportAll(wifis, newExportWifiCredential)},
+		{"seed_phrases.json", exportAll(phrases, newExportSeedPhrase)},
+	}, nil
+}
+
+// exportAll converts the rows, always returning a slice so empty files hold [] rather than null.
+func exportAll[T, E any](rows []T, convert func(T) E) []E {
+	out := make([]E, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, convert(r))
+	}
+	return out
+}
+
+// zipJSON packs the manifest, listing the files, and the files as indented JSON.
+func zipJSON(m exportManifest, files []exportFile) ([]byte, error) {
+	for _, f := range files {
+		m.Files = append(m.Files, f.name)
+	}
+	files = append([]exportFile{{"manifest.json", m}}, files...)
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		data, err := json.MarshalIndent(f.data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: f.name, Method: zip.Deflate, Modified: m.ExportedAt})
+		if err != nil {
+			return nil, err
+		}
+		_, err = w.Write(data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := zw.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/favicon"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	FaviconTTL = 7 * 24 * time.Hour
+	// faviconRetry is how long a failed fetch is remembered.
+	faviconRetry = 24 * time.Hour
+)
+
+var ErrNoFavicon = errors.New("no favicon")
+
+// FaviconService serves cached site icons for the hosts of login URLs.
+type FaviconService struct {
+	repo    repository.Storage
+	fetcher *favicon.Fetcher
+}
+
+// NewFaviconService creates the service. Icons are never fetched if fetcher is nil.
+func NewFaviconService(repo repository.Storage, fetcher *favicon.Fetcher) *FaviconService {
+	return &FaviconService{repo: repo, fetcher: fetcher}
+}
+
+// Favicon returns the icon of host, fetching it if the cached one is stale.
+// Only hosts of the user's login URLs are served, so the server can not be used to probe arbitrary sites.
+// It returns pgx.ErrNoRows for other hosts and ErrNoFavicon if the site has no usable icon.
+func (s *FaviconService) Favicon(ctx context.Context, userID uuid.UUID, host string) (models.Favicon, error) {
+	if s.fetcher == nil {
+		return models.Favicon{}, ErrNoFavicon
+	}
+	u, err := normalizeURL(host)
+	if err != nil {
+		return models.Favicon{}, err
+	}
+	host = u.Hostname()
+	ok, err := s.repo.HasLoginURLHost(ctx, userID, host)
+	if err != nil {
+		return models.Favicon{}, err
+	}
+	if !ok {
+		return models.Favicon{}, pgx.ErrNoRows
+	}
+
+	f, err := s.repo.GetFavicon(ctx, host)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return models.Favicon{}, err
+	case len(f.Data) > 0 && time.Since(f.FetchedAt) < FaviconTTL:
+		return f, nil
+	case len(f.Data) == 0 && time.Since(f.FetchedAt) < faviconRetry:
+		return models.Favicon{}, ErrNoFavicon
+	}
+
+	f = models.Favicon{Host: host, FetchedAt: time.Now()}
+	// Failures are cached too, with empty data.
+	f.ContentType, f.Data, _ = s.fetcher.Fetch(ctx, host)
+	err = s.repo.UpsertFavicon(ctx, f)
+	if err != nil {
+		return models.Favicon{}, err
+	}
+	if len(f.Data) == 0 {
+		return models.Favicon{}, ErrNoFavicon
+	}
+	return f, nil
+}
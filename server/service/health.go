@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/breach"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/strength"
+)
+
+// OldPasswordAge is the age after which a password is reported as old.
+const OldPasswordAge = 365 * 24 * time.Hour
+
+// HealthService analyses the passwords stored in a vault.
+type HealthService struct {
+	repo   repository.Storage
+	breach *breach.Client
+	salt   []byte
+}
+
+// NewHealthService creates the service. Breach checks are skipped if breachClient is nil.
+// salt keys the password digests compared to find reused passwords.
+func NewHealthService(repo repository.Storage, breachClient *breach.Client, salt []byte) *HealthService {
+	return &HealthService{repo: repo, breach: breachClient, salt: salt}
+}
+
+// Report analyses all login passwords of the user.
+func (s *HealthService) Report(ctx context.Context, userID uuid.UUID) (models.HealthReport, error) {
+	lps, err := s.repo.ListLoginPasswords(ctx, userID)
+	if err != nil {
+		return models.HealthReport{}, err
+	}
+	vaults, err := s.repo.ListVaults(ctx, userID)
+	if err != nil {
+		return models.HealthReport{}, err
+	}
+	now := time.Now()
+	report := models.HealthReport{
+		Weak:        weak(lps),
+		Reused:      s.reused(lps),
+		Old:         old(lps, now),
+		RotationDue: rotationDue(lps, vaults, now),
+	}
+	report.Breached, err = s.breached(ctx, lps)
+	if err != nil {
+		return models.HealthReport{}, err
+	}
+	return report, nil
+}
+
+func finding(lp models.LoginPassword) models.PasswordFinding {
+	return models.PasswordFinding{ItemID: *lp.ID, Login: lp.Login, UpdatedAt: lp.UpdatedAt}
+}
+
+// breached returns login passwords found in known breaches.
+func (s *HealthService) breached(ctx context.Context, lps []models.LoginPassword) ([]models.PasswordFinding, error) {
+	if s.breach == nil {
+		return nil, nil
+	}
+	counts := make(map[string]int64, len(lps))
+	var findings []models.PasswordFinding
+	for _, lp := range lps {
+		count, ok := counts[lp.Password]
+		if !ok {
+			var err error
+			count, err = s.breach.Count(ctx, lp.Password)
+			if err != nil {
+				return nil, err
+			}
+			counts[lp.Password] = count
+		}
+		if count > 0 {
+			f := finding(lp)
+			f.BreachCount = count
+			findings = append(findings, f)
+		}
+	}
+	return findings, nil
+}
+
+// weak returns login passwords scored below strength.WeakBelow.
+func weak(lps []models.LoginPassword) []models.PasswordFinding {
+	var findings []models.PasswordFinding
+	for _, lp := range lps {
+		score := strength.Estimate(lp.Password, lp.Login).Score
+		if score < strength.WeakBelow {
+			f := finding(lp)
+			f.Strength = int(score)
+			findings = append(findings, f)
+		}
+	}
+	return findings
+}
+
+// reused groups login passwords sharing the same password.
+// Passwords are compared by their salted digests rather than by plaintext.
+func (s *HealthService) reused(lps []models.LoginPassword) []models.ReuseGroup {
+	byDigest := make(map[string][]models.PasswordFinding, len(lps))
+	var order []string
+	for _, lp := range lps {
+		mac := hmac.New(sha256.New, s.salt)
+		mac.Write([]byte(lp.Password))
+		digest := string(mac.Sum(nil))
+		if _, ok := byDigest[digest]; !ok {
+			order = append(order, digest)
+		}
+		byDigest[digest] = append(byDigest[digest], finding(lp))
+	}
+
+	var groups []models.ReuseGroup
+	for _, digest := range order {
+		if items := byDigest[digest]; len(items) > 1 {
+			groups = append(groups, models.ReuseGroup{Items: items})
+		}
+	}
+	return groups
+}
+
+// old returns login passwords not changed for OldPasswordAge.
+func old(lps []models.LoginPassword, now time.Time) []models.PasswordFinding {
+	var findings []models.PasswordFinding
+	for _, lp := range lps {
+		if now.Sub(lp.UpdatedAt) > OldPasswordAge {
+			findings = append(findings, finding(lp))
+		}
+	}
+	return findings
+}
+
+// rotationDue returns login passwords not changed within the rotation policy of the item,
+// or of its vault if the item has none, most overdue first.
+func rotationDue(lps []models.LoginPassword, vaults []models.Vault, now time.Time) []models.PasswordFinding {
+	vaultDays := make(map[uuid.UUID]int, len(vaults))
+	for _, v := range vaults {
+		vaultDays[v.ID] = v.RotationDays
+	}
+	var findings []models.PasswordFinding
+	for _, lp := range lps {
+		days := lp.RotationDays
+		if days == 0 {
+			days = vaultDays[lp.VaultID]
+		}
+		dueAt := lp.UpdatedAt.AddDate(0, 0, days)
+		if days > 0 && now.After(dueAt) {
+			f := finding(lp)
+			f.RotationDueAt = dueAt
+			findings = append(findings, f)
+		}
+	}
+	slices.SortFunc(findings, func(a, b models.PasswordFinding) int { return a.RotationDueAt.Compare(b.RotationDueAt) })
+	return findings
+}
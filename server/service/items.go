@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// maxOrderedItems bounds the items reordered at once.
+const maxOrderedItems = 10000
+
+var (
+	ErrUnknownItemType = errors.New("unknown item type")
+	ErrBadItemOrder    = errors.New("item order must list up to 10000 distinct items")
+)
+
+// ListItemSummaries returns the user's items without their secrets, pinned first, then in the user's order,
+// then newest first, for listings that fetch each item with GetVaultItem only when it is opened.
+// If vaultID is set, only items of that vault are returned. Archived items are only returned if includeArchived is set.
+func (s *VaultService) ListItemSummaries(
+	ctx context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	includeArchived bool,
+) ([]models.ItemSummary, error) {
+	return s.repo.ListItemSummaries(ctx, userID, vaultID, includeArchived)
+}
+
+// GetVaultItem returns an item of the given type with its secrets.
+func (s *VaultService) GetVaultItem(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	itemType models.ItemType,
+) (models.VaultItem, error) {
+	var item models.VaultItem
+	switch itemType {
+	case models.ItemTypeLoginPassword:
+		lp, err := s.GetLoginPassword(ctx, userID, id)
+		if err != nil {
+			return models.VaultItem{}, err
+		}
+		item.LoginPassword = &lp
+	case models.ItemTypeWifiCredential:
+		w, err := s.repo.GetWifiCredential(ctx, userID, id)
+		if err != nil {
+			return models.VaultItem{}, err
+		}
+		item.WifiCredential = &w
+	case models.ItemTypeSeedPhrase:
+		sp, err := s.repo.GetSeedPhrase(ctx, userID, id)
+		if err != nil {
+			return models.VaultItem{}, err
+		}
+		item.SeedPhrase = &sp
+	default:
+		return models.VaultItem{}, ErrUnknownItemType
+	}
+	return item, nil
+}
+
+// PinItem pins or unpins the user's item, pinned items are listed first. It returns the new vault revision.
+func (s *VaultService) PinItem(ctx context.Context, userID, id uuid.UUID, pinned bool) (int64, error) {
+	ev, err := s.repo.SetItemPinned(ctx, userID, id, pinned)
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, ev)
+	return ev.Revision, nil
+}
+
+// ArchiveItem archives or restores the user's item and returns the new vault revision. Archived items are hidden
+// from listings and autofill unless asked for, but still synced and otherwise usable, unlike deleted ones.
+func (s *VaultService) ArchiveItem(ctx context.Context, userID, id uuid.UUID, archived bool) (int64, error) {
+	ev, err := s.repo.SetItemArchived(ctx, userID, id, archived)
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, ev)
+	return ev.Revision, nil
+}
+
+// ReorderItems places the user's items in the order of ids, ahead of the items the user never ordered,
+// and returns the new vault revision. Items not listed, like those of other vaults, keep their place.
+func (s *VaultService) ReorderItems(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) (int64, error) {
+	if len(ids) > maxOrderedItems {
+		return 0, ErrBadItemOrder
+	}
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return 0, ErrBadItemOrder
+		}
+		seen[id] = true
+	}
+	if len(ids) == 0 {
+		return s.repo.GetRevision(ctx, userID)
+	}
+	events, err := s.repo.SetItemOrder(ctx, userID, ids)
+	if err != nil {
+		return 0, err
+	}
+	for _, ev := range events {
+		s.broker.Publish(userID, ev)
+	}
+	return events[0].Revision, nil
+}
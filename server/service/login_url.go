@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+var ErrBadURL = errors.New("bad url")
+
+// FindLoginsForURL returns the user's login passwords with a URL matching the page URL,
+// and archived ones only if includeArchived is set.
+func (s *VaultService) FindLoginsForURL(
+	ctx context.Context,
+	userID uuid.UUID,
+	pageURL string,
+	includeArchived bool,
+) ([]models.LoginPassword, error) {
+	page, err := normalizeURL(pageURL)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.repo.FindLoginPasswordsByHost(ctx, userID, page.Hostname(), includeArchived)
+	if err != nil {
+		return nil, err
+	}
+	var found []models.LoginPassword
+	for _, lp := range candidates {
+		for _, u := range lp.URLs {
+			if urlMatches(u, page) {
+				found = append(found, lp)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+// prepareURLs normalizes the URLs, defaulting to domain matching, and fills in their hosts.
+func prepareURLs(urls []models.LoginURL) error {
+	for i := range urls {
+		u, err := normalizeURL(urls[i].URL)
+		if err != nil {
+			return err
+		}
+		switch urls[i].Match {
+		case "":
+			urls[i].Match = models.URLMatchDomain
+		case models.URLMatchDomain, models.URLMatchExact, models.URLMatchPrefix:
+		default:
+			return fmt.Errorf("%w: unknown match type %q", ErrBadURL, urls[i].Match)
+		}
+		urls[i].URL = u.String()
+		urls[i].Host = u.Hostname()
+	}
+	return nil
+}
+
+// normalizeURL parses raw, assuming https if it has no scheme, lowercases the host and drops the fragment.
+func normalizeURL(raw string) (*url.URL, error) {
+	raw = strings.TrimSpace(raw)
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return nil, fmt.Errorf("%w: %q", ErrBadURL, raw)
+	}
+	u.Host = strings.ToLower(u.Host)
+	u.Fragment, u.RawFragment = "", ""
+	return u, nil
+}
+
+func urlMatches(u models.LoginURL, page *url.URL) bool {
+	switch u.Match {
+	case models.URLMatchDomain:
+		host := page.Hostname()
+		return host == u.Host || strings.HasSuffix(host, "."+u.Host)
+	case models.URLMatchExact:
+		return page.String() == u.URL
+	case models.URLMatchPrefix:
+		return strings.HasPrefix(page.String(), u.URL)
+	}
+	return false
+}
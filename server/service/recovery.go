@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	recoveryCodeSize  = 20
+	recoveryCodeGroup = 4
+	maxWrappedKeySize = 4 << 10
+)
+
+var ErrRecoveryKeyTooLarge = errors.New("wrapped recovery key is too large")
+
+// RecoveryService manages recovery kits.
+// The server only keeps a hash of the recovery code and key material the client wrapped with the code,
+// so it can't unwrap the key itself.
+type RecoveryService struct {
+	repo     repository.Storage
+	tokens   auth.Tokens
+	accounts *AccountService
+}
+
+func NewRecoveryService(repo repository.Storage, tokens auth.Tokens, accounts *AccountService) *RecoveryService {
+	return &RecoveryService{repo: repo, tokens: tokens, accounts: accounts}
+}
+
+// CreateKit generates a recovery code for the user, replacing the previous kit.
+func (s *RecoveryService) CreateKit(ctx context.Context, userID uuid.UUID) (models.RecoveryKit, error) {
+	b := make([]byte, recoveryCodeSize)
+	_, err := rand.Read(b)
+	if err != nil {
+		return models.RecoveryKit{}, err
+	}
+	code := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)
+	var groups []string
+	for len(code) > 0 {
+		groups = append(groups, code[:recoveryCodeGroup])
+		code = code[recoveryCodeGroup:]
+	}
+	kit := models.RecoveryKit{
+		UserID:    userID,
+		Code:      strings.Join(groups, "-"),
+		CreatedAt: time.Now(),
+	}
+	err = s.repo.SetRecoveryCode(ctx, userID, hashRecoveryCode(kit.Code))
+	if err != nil {
+		return models.RecoveryKit{}, err
+	}
+	return kit, nil
+}
+
+// SetKey stores the key material the client wrapped with the code of the user's current kit.
+// It returns pgx.ErrNoRows if the code is not the current one.
+func (s *RecoveryService) SetKey(ctx context.Context, userID uuid.UUID, code string, wrappedKey []byte) error {
+	if len(wrappedKey) > maxWrappedKeySize {
+		return ErrRecoveryKeyTooLarge
+	}
+	return s.repo.SetRecoveryKey(ctx, userID, hashRecoveryCode(code), wrappedKey)
+}
+
+// Recover checks the user's recovery code, sets their new password and returns their wrapped key
+// and an access token. Codes work once, so the user must create a new kit after recovering. The user's
+// other access tokens are revoked, since whoever stole the account's password may hold them.
+// The token is issued to a new device, as on login. It returns pgx.ErrNoRows if the login or code is wrong
+// and ErrBadPassword, keeping the code, if the new password is.
+func (s *RecoveryService) Recover(ctx context.Context, login, code, password, device string) ([]byte, string, error) {
+	err := checkPassword(password)
+	if err != nil {
+		return nil, "", err
+	}
+	hash, err := s.accounts.hashPassword(password)
+	if err != nil {
+		return nil, "", err
+	}
+	userID, wrappedKey, generation, err := s.repo.ConsumeRecovery(ctx, login, hashRecoveryCode(code), hash)
+	if err != nil {
+		return nil, "", err
+	}
+	deviceID, err := s.repo.InsertDevice(ctx, userID, deviceName(device))
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := auth.NewToken(s.tokens, userID, deviceID, generation)
+	if err != nil {
+		return nil, "", err
+	}
+	return wrappedKey, token, nil
+}
+
+// hashRecoveryCode hashes the code ignoring case, spaces and dashes, so it can be typed back from paper.
+// The code is random enough that a plain hash can't be brute forced.
+func hashRecoveryCode(code string) []byte {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	h := sha256.Sum256([]byte(code))
+	return h[:]
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/cmrd-a/GophKeeper/server/captcha"
+	"github.com/cmrd-a/GophKeeper/server/pow"
+)
+
+// challengeTTL is how long clients have to solve a registration challenge.
+const challengeTTL = 10 * time.Minute
+
+var (
+	ErrRegistrationClosed = errors.New("registration is closed")
+	ErrBadInviteCode      = errors.New("invalid invite code")
+	ErrRegistrationLimit  = errors.New("too many accounts created from this address")
+	ErrBadCaptcha         = errors.New("CAPTCHA verification failed")
+)
+
+// RegistrationPolicy are the checks of new accounts. The zero value allows every registration.
+type RegistrationPolicy struct {
+	// Closed requires one of the InviteCodes, no one can register if there are none.
+	Closed      bool
+	InviteCodes []string
+	// Limiter limits the accounts created by each client host when set.
+	Limiter interface{ Allow(key string) bool }
+	// ChallengeBits is the difficulty of the proof of work, 0 disables it.
+	ChallengeBits int
+	// ChallengeKey signs the challenges, it must be the same on every server.
+	ChallengeKey []byte
+	// Captcha verifies the CAPTCHA responses when set.
+	Captcha *captcha.Client
+}
+
+// Registration is what a client presents to create an account, besides the login and password.
+type Registration struct {
+	// Host is the client's host, shared by every client of the HTTP gateway.
+	Host              string
+	InviteCode        string
+	Challenge         string
+	ChallengeSolution string
+	CaptchaToken      string
+}
+
+// RegistrationService protects registration from abuse.
+type RegistrationService struct {
+	policy RegistrationPolicy
+	pow    *pow.Issuer
+}
+
+func NewRegistrationService(policy RegistrationPolicy) *RegistrationService {
+	s := &RegistrationService{policy: policy}
+	if policy.ChallengeBits > 0 {
+		s.pow = pow.NewIssuer(policy.ChallengeKey, policy.ChallengeBits, challengeTTL)
+	}
+	return s
+}
+
+// Open reports whether anyone can register, without an invite code.
+func (s *RegistrationService) Open() bool {
+	return !s.policy.Closed
+}
+
+// CaptchaRequired reports whether registering requires a CAPTCHA response.
+func (s *RegistrationService) CaptchaRequired() bool {
+	return s.policy.Captcha != nil
+}
+
+// Challenge returns a new proof-of-work challenge, false if no proof of work is required.
+func (s *RegistrationService) Challenge() (pow.Challenge, bool, error) {
+	if s.pow == nil {
+		return pow.Challenge{}, false, nil
+	}
+	c, err := s.pow.Challenge()
+	return c, err == nil, err
+}
+
+// Check returns an error unless the registration passes every check of the policy.
+// The creation limit is checked last, so failed attempts don't count.
+func (s *RegistrationService) Check(ctx context.Context, r Registration) error {
+	if s.policy.Closed && !s.validInvite(r.InviteCode) {
+		if len(s.policy.InviteCodes) == 0 {
+			return ErrRegistrationClosed
+		}
+		return ErrBadInviteCode
+	}
+	if s.pow != nil {
+		err := s.pow.Verify(r.Challenge, r.ChallengeSolution)
+		if err != nil {
+			return err
+		}
+	}
+	if s.policy.Captcha != nil {
+		ok, err := s.policy.Captcha.Verify(ctx, r.CaptchaToken, r.Host)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return ErrBadCaptcha
+		}
+	}
+	if s.policy.Limiter != nil && !s.policy.Limiter.Allow(r.Host) {
+		return ErrRegistrationLimit
+	}
+	return nil
+}
+
+func (s *RegistrationService) validInvite(code string) bool {
+	valid := false
+	for _, c := range s.policy.InviteCodes {
+		if subtle.ConstantTimeCompare([]byte(code), []byte(c)) == 1 {
+			valid = true
+		}
+	}
+	return code != "" && valid
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+// maxRotationDays is ten years, longer policies are most likely typos.
+const maxRotationDays = 3650
+
+var ErrBadRotation = errors.New("rotation must be 0 to 3650 days")
+
+// SetItemRotation sets how often the password of the user's login item should be changed,
+// 0 to follow the policy of its vault, and returns the new vault revision. Only the owner of an item sets its policy.
+func (s *VaultService) SetItemRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	if days < 0 || days > maxRotationDays {
+		return 0, ErrBadRotation
+	}
+	revision, err := s.repo.SetLoginPasswordRotation(ctx, userID, id, days)
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, models.ChangeEvent{
+		ItemID:    id,
+		ItemType:  models.ItemTypeLoginPassword,
+		Operation: models.OperationUpdated,
+		Revision:  revision,
+	})
+	return revision, nil
+}
+
+// SetVaultRotation sets how often the passwords of the login items in the vault should be changed, 0 for never,
+// and returns the new vault revision.
+func (s *VaultService) SetVaultRotation(ctx context.Context, userID, id uuid.UUID, days int) (int64, error) {
+	if days < 0 || days > maxRotationDays {
+		return 0, ErrBadRotation
+	}
+	return s.repo.SetVaultRotation(ctx, userID, id, days)
+}
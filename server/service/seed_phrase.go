@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+var (
+	ErrBadSeedPhraseName = errors.New("seed phrase name must be 1 to 64 characters")
+	ErrBadSeedPhrase     = errors.New("seed phrases must have 12 or 24 lowercase words")
+)
+
+// seedPhraseLengths are the word counts of BIP-39 phrases wallets use.
+var seedPhraseLengths = []int{12, 24}
+
+// SaveSeedPhrase inserts or updates sp and returns the new vault revision.
+// New items without a vault go to the user's default vault.
+func (s *VaultService) SaveSeedPhrase(ctx context.Context, sp models.SeedPhrase) (int64, error) {
+	var err error
+	sp.Name, sp.Words, err = checkSeedPhrase(sp.Name, sp.Words)
+	if err != nil {
+		return 0, err
+	}
+	var cur models.SeedPhrase
+	if sp.ID != nil {
+		cur, err = s.repo.GetSeedPhrase(ctx, sp.UserID, *sp.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+	switch {
+	case sp.VaultID == uuid.Nil && sp.ID == nil:
+		sp.VaultID, err = s.repo.DefaultVault(ctx, sp.UserID)
+	case sp.VaultID == uuid.Nil:
+		sp.VaultID = cur.VaultID
+	default:
+		_, err = s.repo.GetVault(ctx, sp.UserID, sp.VaultID)
+	}
+	if err == nil {
+		// cur is empty for new items, which grow by their whole size.
+		err = checkQuota(ctx, s.repo, sp.UserID, len(strings.Join(sp.Words, " "))-len(strings.Join(cur.Words, " ")))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	ev := models.ChangeEvent{ItemType: models.ItemTypeSeedPhrase}
+	if sp.ID == nil {
+		ev.ItemID, ev.Revision, err = s.repo.InsertSeedPhrase(ctx, sp)
+		ev.Operation = models.OperationCreated
+	} else {
+		ev.Revision, err = s.repo.UpdateSeedPhrase(ctx, sp)
+		ev.ItemID, ev.Operation = *sp.ID, models.OperationUpdated
+		err = s.checkSeedPhraseMismatch(ctx, sp.UserID, *sp.ID, sp.ExpectedRevision, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(sp.UserID, ev)
+	return ev.Revision, nil
+}
+
+// checkSeedPhrase trims the name and lowercases the words, so phrases copied from wallets are accepted.
+func checkSeedPhrase(name string, words []string) (string, []string, error) {
+	name, err := vaultName(name)
+	if err != nil {
+		return "", nil, ErrBadSeedPhraseName
+	}
+	if !slices.Contains(seedPhraseLengths, len(words)) {
+		return "", nil, ErrBadSeedPhrase
+	}
+	normalized := make([]string, len(words))
+	for i, w := range words {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w == "" || strings.IndexFunc(w, func(r rune) bool { return !unicode.IsLetter(r) }) >= 0 {
+			return "", nil, ErrBadSeedPhrase
+		}
+		normalized[i] = w
+	}
+	return name, normalized, nil
+}
+
+// GetSeedPhrases returns the user's seed phrases.
+func (s *VaultService) GetSeedPhrases(ctx context.Context, userID uuid.UUID) ([]models.SeedPhrase, error) {
+	return s.repo.GetSeedPhrasesChangedSince(ctx, userID, time.Time{}, 0)
+}
+
+// DeleteSeedPhrase deletes the user's seed phrase and returns the new vault revision.
+// If expectedRevision is set, it fails with ErrRevisionMismatch unless the item is at that revision.
+func (s *VaultService) DeleteSeedPhrase(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	revision, err := s.repo.DeleteSeedPhrase(ctx, userID, id, expectedRevision)
+	err = s.checkSeedPhraseMismatch(ctx, userID, id, expectedRevision, err)
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, models.ChangeEvent{
+		ItemID:    id,
+		ItemType:  models.ItemTypeSeedPhrase,
+		Operation: models.OperationDeleted,
+		Revision:  revision,
+	})
+	return revision, nil
+}
+
+// checkSeedPhraseMismatch turns a write error into ErrRevisionMismatch if the item still exists.
+func (s *VaultService) checkSeedPhraseMismatch(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+	err error,
+) error {
+	if !errors.Is(err, pgx.ErrNoRows) || expectedRevision == nil {
+		return err
+	}
+	_, getErr := s.repo.GetSeedPhrase(ctx, userID, id)
+	if getErr == nil {
+		return ErrRevisionMismatch
+	}
+	return err
+}
@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	MaxSecretLinkTTL = 30 * 24 * time.Hour
+	secretKeySize    = 32
+	maxSecretSize    = 64 << 10
+)
+
+var (
+	ErrBadSecretLink  = errors.New("bad secret link")
+	ErrSecretTooLarge = errors.New("secret link text is too large")
+)
+
+// SendService manages one-time secret links.
+// Secrets are encrypted with a per-link key that is only part of the link token,
+// so the stored data can not be read without the link.
+type SendService struct {
+	repo repository.Storage
+}
+
+func NewSendService(repo repository.Storage) *SendService {
+	return &SendService{repo: repo}
+}
+
+// CreateLink stores text for at most maxViews reveals during ttl and returns the link token.
+// Links count toward the storage quota of the user's tenant.
+func (s *SendService) CreateLink(
+	ctx context.Context,
+	userID uuid.UUID,
+	text string,
+	ttl time.Duration,
+	maxViews int32,
+) (string, time.Time, error) {
+	if ttl <= 0 || ttl > MaxSecretLinkTTL {
+		return "", time.Time{}, fmt.Errorf("%w: ttl must be between 0 and %s", ErrBadSecretLink, MaxSecretLinkTTL)
+	}
+	if len(text) > maxSecretSize {
+		return "", time.Time{}, ErrSecretTooLarge
+	}
+	if maxViews <= 0 {
+		maxViews = 1
+	}
+
+	key := make([]byte, secretKeySize)
+	_, err := rand.Read(key)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	ciphertext, err := seal(key, []byte(text))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	err = checkQuota(ctx, s.repo, userID, len(ciphertext))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	l := models.SecretLink{
+		ID:         uuid.New(),
+		UserID:     userID,
+		Ciphertext: ciphertext,
+		MaxViews:   maxViews,
+		ExpiresAt:  time.Now().Add(ttl),
+	}
+	err = s.repo.InsertSecretLink(ctx, l)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	token := base64.RawURLEncoding.EncodeToString(append(l.ID[:], key...))
+	return token, l.ExpiresAt, nil
+}
+
+// CreateItemLink shares the user's login password item.
+func (s *SendService) CreateItemLink(
+	ctx context.Context,
+	userID, itemID uuid.UUID,
+	ttl time.Duration,
+	maxViews int32,
+) (string, time.Time, error) {
+	lp, err := s.repo.GetLoginPassword(ctx, userID, itemID)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	text := fmt.Sprintf("login: %s\npassword: %s", lp.Login, lp.Password)
+	return s.CreateLink(ctx, userID, text, ttl, maxViews)
+}
+
+// Reveal returns the secret behind token and the number of views left.
+func (s *SendService) Reveal(ctx context.Context, token string) (string, int32, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) != len(uuid.UUID{})+secretKeySize {
+		return "", 0, ErrBadSecretLink
+	}
+	id, err := uuid.FromBytes(raw[:len(uuid.UUID{})])
+	if err != nil {
+		return "", 0, ErrBadSecretLink
+	}
+	l, err := s.repo.ConsumeSecretLink(ctx, id)
+	if err != nil {
+		return "", 0, err
+	}
+	text, err := open(raw[len(uuid.UUID{}):], l.Ciphertext)
+	if err != nil {
+		return "", 0, ErrBadSecretLink
+	}
+	return string(text), l.MaxViews - l.Views, nil
+}
+
+// DeleteExpired removes expired links and returns how many were removed.
+func (s *SendService) DeleteExpired(ctx context.Context) (int64, error) {
+	return s.repo.DeleteExpiredSecretLinks(ctx)
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, ciphertext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, sealed, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+var (
+	ErrBadShare      = errors.New("bad share")
+	ErrReadOnlyShare = errors.New("item is shared read-only")
+	// ErrNotItemOwner is returned when a user an item is shared with tries what only its owner can do.
+	ErrNotItemOwner = errors.New("only the owner of the item can do this")
+)
+
+type ShareService struct {
+	repo repository.Storage
+}
+
+func NewShareService(repo repository.Storage) *ShareService {
+	return &ShareService{repo: repo}
+}
+
+// ShareItem grants the user with granteeLogin access to the owner's item until expiresAt, if set.
+// Only users of the owner's tenant can be found by their login.
+func (s *ShareService) ShareItem(
+	ctx context.Context,
+	ownerID, itemID uuid.UUID,
+	granteeLogin string,
+	readOnly bool,
+	expiresAt *time.Time,
+) (uuid.UUID, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return uuid.Nil, ErrBadShare
+	}
+	_, err := s.repo.GetLoginPassword(ctx, ownerID, itemID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	granteeID, err := s.repo.GetTenantPeerID(ctx, ownerID, granteeLogin)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if granteeID == ownerID {
+		return uuid.Nil, ErrBadShare
+	}
+	return s.repo.InsertShare(ctx, models.Share{
+		ItemID:    itemID,
+		ItemType:  models.ItemTypeLoginPassword,
+		OwnerID:   ownerID,
+		GranteeID: granteeID,
+		ReadOnly:  readOnly,
+		ExpiresAt: expiresAt,
+	})
+}
+
+// ListMyShares returns the grants made by the owner, including expired ones.
+func (s *ShareService) ListMyShares(ctx context.Context, ownerID uuid.UUID) ([]models.Share, error) {
+	return s.repo.ListSharesByOwner(ctx, ownerID)
+}
+
+func (s *ShareService) RevokeShare(ctx context.Context, ownerID, id uuid.UUID) error {
+	return s.repo.DeleteShare(ctx, ownerID, id)
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	maxTenantNameLength     = 64
+	foreignKeyViolationCode = "23503"
+)
+
+var (
+	ErrBadTenantName = errors.New("tenant name must be 1 to 64 characters")
+	ErrTenantExists  = errors.New("tenant with this name already exists")
+	ErrNoTenant      = errors.New("tenant does not exist")
+	ErrBadQuota      = errors.New("quota must not be negative")
+	// ErrQuotaExceeded is returned when a new or grown item would take the tenant over its storage quota.
+	ErrQuotaExceeded = errors.New("tenant storage quota exceeded")
+)
+
+// ListUsers returns every account, or only those of the tenant if tenantID is set.
+func (s *AdminService) ListUsers(ctx context.Context, tenantID *uuid.UUID) ([]models.UserSummary, error) {
+	return s.repo.ListUsers(ctx, tenantID)
+}
+
+// CreateTenant adds a tenant whose users' secrets may take up to quotaBytes, unlimited if 0.
+func (s *AdminService) CreateTenant(ctx context.Context, name string, quotaBytes int64) (uuid.UUID, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxTenantNameLength {
+		return uuid.Nil, ErrBadTenantName
+	}
+	if quotaBytes < 0 {
+		return uuid.Nil, ErrBadQuota
+	}
+	id, err := s.repo.InsertTenant(ctx, name, quotaBytes)
+	if isUniqueViolation(err) {
+		return uuid.Nil, ErrTenantExists
+	}
+	return id, err
+}
+
+func (s *AdminService) ListTenants(ctx context.Context) ([]models.Tenant, error) {
+	return s.repo.ListTenants(ctx)
+}
+
+// SetTenantQuota changes the storage quota of the tenant. Lowering it under the current usage
+// only refuses new and growing items.
+func (s *AdminService) SetTenantQuota(ctx context.Context, id uuid.UUID, quotaBytes int64) error {
+	if quotaBytes < 0 {
+		return ErrBadQuota
+	}
+	return s.repo.SetTenantQuota(ctx, id, quotaBytes)
+}
+
+// SetUserTenant moves the account with the login to the tenant, or out of any tenant if tenantID is nil.
+// Existing shares and trusted contacts with users of other tenants are kept.
+func (s *AdminService) SetUserTenant(ctx context.Context, login string, tenantID *uuid.UUID) error {
+	err := s.repo.SetUserTenant(ctx, login, tenantID)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+		return ErrNoTenant
+	}
+	return err
+}
+
+// checkQuota fails with ErrQuotaExceeded if the user's items and secret links growing by size bytes
+// would take their tenant over its quota. Items that don't grow always fit. Items carry no tenant,
+// so the usage of a tenant is summed over the items of its current users.
+func checkQuota(ctx context.Context, repo repository.Storage, userID uuid.UUID, size int) error {
+	if size <= 0 {
+		return nil
+	}
+	q, err := repo.GetStorageQuota(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if q.QuotaBytes > 0 && q.UsedBytes+int64(size) > q.QuotaBytes {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
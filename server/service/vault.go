@@ -2,22 +2,407 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/cmrd-a/GophKeeper/server/models"
 	"github.com/cmrd-a/GophKeeper/server/repository"
 )
 
+const (
+	maxVaultNameLength  = 64
+	maxRecentlyUsed     = 100
+	streamPageSize      = 100
+	oldestPasswords     = 10
+	uniqueViolationCode = "23505"
+)
+
+var (
+	ErrBadVaultName  = errors.New("vault name must be 1 to 64 characters")
+	ErrVaultExists   = errors.New("vault with this name already exists")
+	ErrVaultNotEmpty = errors.New("vault is not empty")
+	// ErrRevisionMismatch is returned when an item changed since the expected revision.
+	ErrRevisionMismatch = errors.New("item was changed since the expected revision")
+)
+
 type VaultService struct {
-	repo repository.Repository
+	repo   repository.Storage
+	broker *Broker
 }
 
-func NewService() *VaultService {
-	return &VaultService{}
+func NewService(repo repository.Storage) *VaultService {
+	return &VaultService{repo: repo, broker: NewBroker()}
 }
 
-func (s *VaultService) SaveLoginPassword(ctx context.Context, lp models.LoginPassword) error {
+// SaveLoginPassword inserts or updates lp and returns the new vault revision.
+// New items without a vault go to the user's default vault.
+func (s *VaultService) SaveLoginPassword(ctx context.Context, lp models.LoginPassword) (int64, error) {
+	err := prepareURLs(lp.URLs)
+	if err != nil {
+		return 0, err
+	}
+	ev := models.ChangeEvent{ItemType: models.ItemTypeLoginPassword}
 	if lp.ID == nil {
-		return s.repo.InsertLoginPassword(ctx, lp)
+		err = s.prepareInsert(ctx, &lp)
+		if err == nil {
+			ev.ItemID, ev.Revision, err = s.repo.InsertLoginPassword(ctx, lp)
+		}
+		ev.Operation = models.OperationCreated
+	} else {
+		err = s.prepareUpdate(ctx, &lp)
+		if err == nil {
+			ev.Revision, err = s.repo.UpdateLoginPassword(ctx, lp)
+		}
+		if errors.Is(err, pgx.ErrNoRows) && lp.ExpectedRevision != nil {
+			// prepareUpdate found the item, so it is at another revision.
+			err = ErrRevisionMismatch
+		}
+		ev.ItemID, ev.Operation = *lp.ID, models.OperationUpdated
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(lp.UserID, ev)
+	return ev.Revision, nil
+}
+
+// checkVault sets lp.VaultID to the default vault if it is unset or checks that the user owns it.
+func (s *VaultService) checkVault(ctx context.Context, lp *models.LoginPassword) error {
+	var err error
+	if lp.VaultID == uuid.Nil {
+		lp.VaultID, err = s.repo.DefaultVault(ctx, lp.UserID)
+		return err
+	}
+	_, err = s.repo.GetVault(ctx, lp.UserID, lp.VaultID)
+	return err
+}
+
+// prepareInsert puts lp in its vault and checks that it fits in the quota of the user's tenant.
+func (s *VaultService) prepareInsert(ctx context.Context, lp *models.LoginPassword) error {
+	err := s.checkVault(ctx, lp)
+	if err != nil {
+		return err
+	}
+	return checkQuota(ctx, s.repo, lp.UserID, loginPasswordSize(*lp))
+}
+
+// prepareUpdate switches lp to its owner if it is shared with the user and checks that its growth fits
+// in the quota of the owner's tenant. Only the owner can move an item to another vault,
+// otherwise the item stays in its vault.
+func (s *VaultService) prepareUpdate(ctx context.Context, lp *models.LoginPassword) error {
+	ownerID, err := s.resolveOwner(ctx, lp.UserID, *lp.ID, true)
+	if err != nil {
+		return err
+	}
+	cur, err := s.repo.GetLoginPassword(ctx, ownerID, *lp.ID)
+	if err != nil {
+		return err
+	}
+	if ownerID == lp.UserID && lp.VaultID != uuid.Nil {
+		err = s.checkVault(ctx, lp)
+		if err != nil {
+			return err
+		}
+	} else {
+		lp.UserID, lp.VaultID = ownerID, cur.VaultID
+	}
+	return checkQuota(ctx, s.repo, lp.UserID, loginPasswordSize(*lp)-loginPasswordSize(cur))
+}
+
+// loginPasswordSize is the storage a login password takes up against the tenant quota.
+func loginPasswordSize(lp models.LoginPassword) int {
+	return len(lp.Login) + len(lp.Password)
+}
+
+// GetLoginPassword returns a login password owned by or shared with the user.
+func (s *VaultService) GetLoginPassword(ctx context.Context, userID, id uuid.UUID) (models.LoginPassword, error) {
+	ownerID, err := s.resolveOwner(ctx, userID, id, false)
+	if err != nil {
+		return models.LoginPassword{}, err
+	}
+	return s.repo.GetLoginPassword(ctx, ownerID, id)
+}
+
+// resolveOwner returns the owner of an item the user may access, checking the share grant
+// if the user is not the owner. Writes to items shared read-only fail with ErrReadOnlyShare.
+func (s *VaultService) resolveOwner(ctx context.Context, userID, itemID uuid.UUID, write bool) (uuid.UUID, error) {
+	_, err := s.repo.GetLoginPassword(ctx, userID, itemID)
+	if err == nil {
+		return userID, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return uuid.Nil, err
+	}
+	sh, err := s.repo.GetActiveShare(ctx, itemID, userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if write && sh.ReadOnly {
+		return uuid.Nil, ErrReadOnlyShare
+	}
+	return sh.OwnerID, nil
+}
+
+// TouchItem records that the user viewed or copied an item of any type owned by or shared with them
+// and returns the new revision of the owner's vault. The last use is kept on the owner's item,
+// so items shared read-only can't be touched.
+func (s *VaultService) TouchItem(ctx context.Context, userID, id uuid.UUID) (int64, error) {
+	ev, err := s.repo.TouchItem(ctx, userID, id)
+	ownerID := userID
+	if errors.Is(err, pgx.ErrNoRows) {
+		ownerID, err = s.resolveOwner(ctx, userID, id, true)
+		if err != nil {
+			return 0, err
+		}
+		ev, err = s.repo.TouchItem(ctx, ownerID, id)
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(ownerID, ev)
+	return ev.Revision, nil
+}
+
+// StreamItems calls send with each item of every type of the user, or of one vault if vaultID is set,
+// along with the vault revision read before listing. Items are read in pages, so no connection is held
+// while sending.
+func (s *VaultService) StreamItems(
+	ctx context.Context,
+	userID uuid.UUID,
+	vaultID *uuid.UUID,
+	send func(item models.VaultItem, revision int64) error,
+) error {
+	revision, err := s.repo.GetRevision(ctx, userID)
+	if err != nil {
+		return err
+	}
+	after := uuid.Nil
+	for {
+		page, err := s.repo.ListItemsPage(ctx, userID, vaultID, after, streamPageSize)
+		if err != nil {
+			return err
+		}
+		for _, it := range page {
+			err = send(it, revision)
+			if err != nil {
+				return err
+			}
+		}
+		if len(page) < streamPageSize {
+			return nil
+		}
+		after = vaultItemID(page[len(page)-1])
+	}
+}
+
+// vaultItemID returns the id of the item, whatever its type.
+func vaultItemID(it models.VaultItem) uuid.UUID {
+	switch {
+	case it.LoginPassword != nil:
+		return *it.LoginPassword.ID
+	case it.WifiCredential != nil:
+		return *it.WifiCredential.ID
+	default:
+		return *it.SeedPhrase.ID
+	}
+}
+
+// ListLoginPasswords returns all login passwords of the user.
+func (s *VaultService) ListLoginPasswords(ctx context.Context, userID uuid.UUID) ([]models.LoginPassword, error) {
+	return s.repo.ListLoginPasswords(ctx, userID)
+}
+
+// ListRecentlyUsed returns up to limit login passwords used after since, most recently used first.
+func (s *VaultService) ListRecentlyUsed(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	limit int,
+) ([]models.LoginPassword, error) {
+	if limit <= 0 || limit > maxRecentlyUsed {
+		limit = maxRecentlyUsed
+	}
+	return s.repo.ListRecentlyUsedLoginPasswords(ctx, userID, since, limit)
+}
+
+// DeleteLoginPassword deletes the user's login password and returns the new vault revision.
+// If expectedRevision is set, it fails with ErrRevisionMismatch unless the item is at that revision.
+// Items the user does not have fail with pgx.ErrNoRows, items only shared with the user with ErrNotItemOwner.
+func (s *VaultService) DeleteLoginPassword(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	revision, err := s.repo.DeleteLoginPassword(ctx, userID, id, expectedRevision)
+	if errors.Is(err, pgx.ErrNoRows) {
+		err = s.checkDeleteMiss(ctx, userID, id)
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, models.ChangeEvent{
+		ItemID:    id,
+		ItemType:  models.ItemTypeLoginPassword,
+		Operation: models.OperationDeleted,
+		Revision:  revision,
+	})
+	return revision, nil
+}
+
+// checkDeleteMiss explains why deleting an item deleted nothing.
+func (s *VaultService) checkDeleteMiss(ctx context.Context, userID, id uuid.UUID) error {
+	_, err := s.repo.GetLoginPassword(ctx, userID, id)
+	if err == nil {
+		return ErrRevisionMismatch
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+	_, err = s.repo.GetActiveShare(ctx, id, userID)
+	if err == nil {
+		return ErrNotItemOwner
+	}
+	return err
+}
+
+// RecordSync records that the device synced the user's vaults, for the account usage report.
+// Devices revoked meanwhile are skipped, their next call is refused anyway.
+func (s *VaultService) RecordSync(ctx context.Context, userID, deviceID uuid.UUID) error {
+	err := s.repo.TouchDevice(ctx, userID, deviceID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	return err
+}
+
+// GetChangesSince returns items changed and deleted after sinceRevision, or after since if sinceRevision is zero.
+func (s *VaultService) GetChangesSince(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+	sinceRevision int64,
+) (models.Changes, error) {
+	changes := models.Changes{SyncedAt: time.Now()}
+	var err error
+	// Read the revision first so that writes racing with the queries are fetched again on the next sync.
+	changes.Revision, err = s.repo.GetRevision(ctx, userID)
+	if err != nil {
+		return models.Changes{}, err
+	}
+	// The item types are read concurrently, each query on its own pool connection.
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		changes.LoginPasswords, err = s.repo.GetLoginPasswordsChangedSince(gctx, userID, since, sinceRevision)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		changes.WifiCredentials, err = s.repo.GetWifiCredentialsChangedSince(gctx, userID, since, sinceRevision)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		changes.SeedPhrases, err = s.repo.GetSeedPhrasesChangedSince(gctx, userID, since, sinceRevision)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		changes.Deleted, err = s.repo.GetTombstonesSince(gctx, userID, since, sinceRevision)
+		return err
+	})
+	err = g.Wait()
+	if err != nil {
+		return models.Changes{}, err
+	}
+	return changes, nil
+}
+
+// ListVaults returns the user's vaults, oldest first. It creates none, users get the default vault
+// when they are created.
+func (s *VaultService) ListVaults(ctx context.Context, userID uuid.UUID) ([]models.Vault, error) {
+	return s.repo.ListVaults(ctx, userID)
+}
+
+// CreateVault adds a named vault and returns its id and the new vault revision.
+func (s *VaultService) CreateVault(ctx context.Context, userID uuid.UUID, name string) (uuid.UUID, int64, error) {
+	name, err := vaultName(name)
+	if err != nil {
+		return uuid.Nil, 0, err
 	}
-	return s.repo.UpdateLoginPassword(ctx, lp)
+	id, revision, err := s.repo.InsertVault(ctx, userID, name)
+	if isUniqueViolation(err) {
+		return uuid.Nil, 0, ErrVaultExists
+	}
+	return id, revision, err
+}
+
+// RenameVault renames the vault and returns the new vault revision.
+func (s *VaultService) RenameVault(ctx context.Context, userID, id uuid.UUID, name string) (int64, error) {
+	name, err := vaultName(name)
+	if err != nil {
+		return 0, err
+	}
+	revision, err := s.repo.RenameVault(ctx, userID, id, name)
+	if isUniqueViolation(err) {
+		return 0, ErrVaultExists
+	}
+	return revision, err
+}
+
+// DeleteVault deletes an empty vault and returns the new vault revision.
+// Vaults with items fail with ErrVaultNotEmpty.
+func (s *VaultService) DeleteVault(ctx context.Context, userID, id uuid.UUID) (int64, error) {
+	_, err := s.repo.GetVault(ctx, userID, id)
+	if err != nil {
+		return 0, err
+	}
+	n, err := s.repo.CountVaultItems(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		return 0, ErrVaultNotEmpty
+	}
+	revision, err := s.repo.DeleteVault(ctx, userID, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		// An item was added concurrently.
+		return 0, ErrVaultNotEmpty
+	}
+	return revision, err
+}
+
+func vaultName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxVaultNameLength {
+		return "", ErrBadVaultName
+	}
+	return name, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
+
+// GetRevision returns the current revision of the user's vault.
+func (s *VaultService) GetRevision(ctx context.Context, userID uuid.UUID) (int64, error) {
+	return s.repo.GetRevision(ctx, userID)
+}
+
+// WatchChanges streams change events of the user's vault until ctx is done.
+func (s *VaultService) WatchChanges(ctx context.Context, userID uuid.UUID) <-chan models.ChangeEvent {
+	return s.broker.Subscribe(ctx, userID)
+}
+
+// GetVaultStats returns the growth and storage of the user's vault, with their ten oldest unchanged passwords.
+func (s *VaultService) GetVaultStats(ctx context.Context, userID uuid.UUID) (models.VaultStats, error) {
+	return s.repo.GetVaultStats(ctx, userID, oldestPasswords)
 }
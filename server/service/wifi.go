@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/cmrd-a/GophKeeper/server/models"
+)
+
+const (
+	maxSSIDLength        = 32
+	minWPAPasswordLength = 8
+	maxWPAPasswordLength = 63
+)
+
+var (
+	ErrBadSSID         = errors.New("SSID must be 1 to 32 bytes")
+	ErrBadWifiSecurity = errors.New("unknown WiFi security type")
+	ErrBadWifiPassword = errors.New("WPA passwords must be 8 to 63 characters, open networks have none")
+)
+
+// SaveWifiCredential inserts or updates w and returns the new vault revision.
+// New items without a vault go to the user's default vault.
+func (s *VaultService) SaveWifiCredential(ctx context.Context, w models.WifiCredential) (int64, error) {
+	err := checkWifiCredential(w)
+	if err != nil {
+		return 0, err
+	}
+	ev := models.ChangeEvent{ItemType: models.ItemTypeWifiCredential}
+	var cur models.WifiCredential
+	if w.ID != nil {
+		cur, err = s.repo.GetWifiCredential(ctx, w.UserID, *w.ID)
+		if err != nil {
+			return 0, err
+		}
+	}
+	switch {
+	case w.VaultID == uuid.Nil && w.ID == nil:
+		w.VaultID, err = s.repo.DefaultVault(ctx, w.UserID)
+	case w.VaultID == uuid.Nil:
+		w.VaultID = cur.VaultID
+	default:
+		_, err = s.repo.GetVault(ctx, w.UserID, w.VaultID)
+	}
+	if err == nil {
+		// cur is empty for new items, which grow by their whole size.
+		err = checkQuota(ctx, s.repo, w.UserID, len(w.SSID)+len(w.Password)-len(cur.SSID)-len(cur.Password))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if w.ID == nil {
+		ev.ItemID, ev.Revision, err = s.repo.InsertWifiCredential(ctx, w)
+		ev.Operation = models.OperationCreated
+	} else {
+		ev.Revision, err = s.repo.UpdateWifiCredential(ctx, w)
+		ev.ItemID, ev.Operation = *w.ID, models.OperationUpdated
+		err = s.checkWifiMismatch(ctx, w.UserID, *w.ID, w.ExpectedRevision, err)
+	}
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(w.UserID, ev)
+	return ev.Revision, nil
+}
+
+func checkWifiCredential(w models.WifiCredential) error {
+	if w.SSID == "" || len(w.SSID) > maxSSIDLength {
+		return ErrBadSSID
+	}
+	switch w.Security {
+	case models.WifiSecurityNone:
+		if w.Password != "" {
+			return ErrBadWifiPassword
+		}
+	case models.WifiSecurityWPA, models.WifiSecurityWPA3:
+		if len(w.Password) < minWPAPasswordLength || len(w.Password) > maxWPAPasswordLength {
+			return ErrBadWifiPassword
+		}
+	case models.WifiSecurityWEP:
+	default:
+		return ErrBadWifiSecurity
+	}
+	return nil
+}
+
+// GetWifiCredentials returns the user's WiFi credentials.
+func (s *VaultService) GetWifiCredentials(ctx context.Context, userID uuid.UUID) ([]models.WifiCredential, error) {
+	return s.repo.GetWifiCredentialsChangedSince(ctx, userID, time.Time{}, 0)
+}
+
+// DeleteWifiCredential deletes the user's WiFi credential and returns the new vault revision.
+// If expectedRevision is set, it fails with ErrRevisionMismatch unless the item is at that revision.
+func (s *VaultService) DeleteWifiCredential(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+) (int64, error) {
+	revision, err := s.repo.DeleteWifiCredential(ctx, userID, id, expectedRevision)
+	err = s.checkWifiMismatch(ctx, userID, id, expectedRevision, err)
+	if err != nil {
+		return 0, err
+	}
+	s.broker.Publish(userID, models.ChangeEvent{
+		ItemID:    id,
+		ItemType:  models.ItemTypeWifiCredential,
+		Operation: models.OperationDeleted,
+		Revision:  revision,
+	})
+	return revision, nil
+}
+
+// checkWifiMismatch turns a write error into ErrRevisionMismatch if the item still exists.
+func (s *VaultService) checkWifiMismatch(
+	ctx context.Context,
+	userID, id uuid.UUID,
+	expectedRevision *int64,
+	err error,
+) error {
+	if !errors.Is(err, pgx.ErrNoRows) || expectedRevision == nil {
+		return err
+	}
+	_, getErr := s.repo.GetWifiCredential(ctx, userID, id)
+	if getErr == nil {
+		return ErrRevisionMismatch
+	}
+	return err
+}
@@ -0,0 +1,347 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+
+	"github.com/cmrd-a/GophKeeper/server/backup"
+	"github.com/cmrd-a/GophKeeper/server/breach"
+	"github.com/cmrd-a/GophKeeper/server/cache"
+	"github.com/cmrd-a/GophKeeper/server/captcha"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/favicon"
+	"github.com/cmrd-a/GophKeeper/server/interceptor"
+	"github.com/cmrd-a/GophKeeper/server/listener"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+	"github.com/cmrd-a/GophKeeper/server/service"
+	"github.com/cmrd-a/GophKeeper/server/telemetry"
+)
+
+// OpenDatabase connects to the database of the configuration, waiting for it to be ready and migrated.
+func OpenDatabase(log *slog.Logger, cfg *config.Config) (*repository.Repository, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DBWait)
+	defer cancel()
+
+	r, err := repository.NewRepository(context.Background(), cfg.DatabaseURI, repository.PoolOptions{
+		MaxConns:          cfg.DBMaxConns,
+		MinConns:          cfg.DBMinConns,
+		MaxConnLifetime:   cfg.DBMaxConnLifetime,
+		MaxConnIdleTime:   cfg.DBMaxConnIdleTime,
+		HealthCheckPeriod: cfg.DBHealthCheckPeriod,
+		Dialect:           repository.Dialect(cfg.DBDialect),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Waiting for database", "timeout", cfg.DBWait)
+	err = r.WaitReady(ctx)
+	if err == nil && cfg.DBBootstrap {
+		err = bootstrap(ctx, log, r)
+	}
+	if err == nil {
+		err = r.CheckMigrations(ctx)
+	}
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return r, nil
+}
+
+// bootstrap creates the schema if the database is empty.
+func bootstrap(ctx context.Context, log *slog.Logger, r *repository.Repository) error {
+	created, err := r.Bootstrap(ctx)
+	if created {
+		log.InfoContext(ctx, "Created the schema of the empty database")
+	}
+	return err
+}
+
+// NewBackupService returns the backups of the database, as configured.
+func NewBackupService(cfg *config.Config, repo *repository.Repository) (*service.BackupService, error) {
+	store, err := backup.NewStore(cfg.BackupTarget, cfg.BackupS3Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewBackupService(*repo, store, cfg.BackupPassphrase, cfg.BackupKeep), nil
+}
+
+// every runs job each interval until ctx is done, logging its failures.
+func every(
+	ctx context.Context,
+	log *slog.Logger,
+	interval time.Duration,
+	name string,
+	job func(ctx context.Context) error,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		err := job(ctx)
+		if err != nil {
+			log.ErrorContext(ctx, "background job failed", "job", name, "error", err)
+		}
+	}
+}
+
+// scheduleBackups configures the backups of the database and schedules them if they are enabled.
+func (s *Server) scheduleBackups() error {
+	if s.cfg.BackupPassphrase != "" {
+		var err error
+		s.backups, err = NewBackupService(s.cfg, s.db)
+		if err != nil {
+			return fmt.Errorf("failed to configure backups: %w", err)
+		}
+	}
+	if s.cfg.BackupInterval <= 0 {
+		return nil
+	}
+	if s.backups == nil {
+		return errors.New("BACKUP_PASSPHRASE is required when backups are enabled")
+	}
+	s.addJob("backup", s.cfg.BackupInterval, func(ctx context.Context) error {
+		start := time.Now()
+		b, err := s.backups.Run(ctx)
+		if err != nil {
+			return err
+		}
+		s.log.InfoContext(ctx, "Backup created",
+			"name", b.Name,
+			"size", b.Size,
+			"schema_version", b.SchemaVersion,
+			"duration", time.Since(start),
+		)
+		return nil
+	})
+	return nil
+}
+
+// registration returns the checks of new accounts enabled by the configuration.
+func registration(cfg *config.Config) *service.RegistrationService {
+	policy := service.RegistrationPolicy{
+		Closed:        !cfg.RegistrationOpen,
+		InviteCodes:   cfg.RegistrationInviteCodes,
+		ChallengeBits: cfg.RegistrationChallengeBits,
+		ChallengeKey:  challengeKey(cfg.JWTSecret),
+	}
+	if cfg.RegistrationLimit > 0 {
+		rate := float64(cfg.RegistrationLimit) / cfg.RegistrationLimitWindow.Seconds()
+		policy.Limiter = interceptor.NewLimiter(rate, cfg.RegistrationLimit)
+	}
+	if cfg.RegistrationCaptchaURL != "" {
+		policy.Captcha = captcha.NewClient(cfg.RegistrationCaptchaURL, cfg.RegistrationCaptchaSecret)
+	}
+	return service.NewRegistrationService(policy)
+}
+
+// challengeKey derives the key of registration challenges from the JWT secret, shared by every server.
+func challengeKey(jwtSecret string) []byte {
+	h := hmac.New(sha256.New, []byte(jwtSecret))
+	h.Write([]byte("registration challenge"))
+	return h.Sum(nil)
+}
+
+// authExempt returns the methods callable without an access token,
+// including health checks and reflection when enabled.
+func authExempt(cfg *config.Config) []string {
+	exempt := append(slices.Clone(cfg.AuthExemptMethods), "/grpc.health.v1.Health/")
+	if !cfg.GRPCReflection {
+		return exempt
+	}
+	return append(exempt,
+		"/grpc.reflection.v1.ServerReflection/",
+		"/grpc.reflection.v1alpha.ServerReflection/",
+	)
+}
+
+// grpcWeb wraps the server for gRPC-Web when enabled, nil otherwise.
+func grpcWeb(cfg *config.Config, s *grpc.Server) *grpcweb.WrappedGrpcServer {
+	if !cfg.GRPCWeb {
+		return nil
+	}
+	allowed := func(origin string) bool {
+		return slices.Contains(cfg.GRPCWebOrigins, "*") || slices.Contains(cfg.GRPCWebOrigins, origin)
+	}
+	return grpcweb.WrapServer(s,
+		grpcweb.WithOriginFunc(allowed),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			u, err := url.Parse(origin)
+			return origin == "" || err == nil && u.Host == r.Host || allowed(origin)
+		}),
+	)
+}
+
+// responseCache returns the Redis response cache. Its hits and misses are logged every minute.
+func (s *Server) responseCache() (*interceptor.ResponseCache, error) {
+	store, err := cache.NewRedis(s.cfg.RedisURL)
+	if err != nil {
+		return nil, err
+	}
+	s.closers = append(s.closers, store)
+	c := interceptor.NewResponseCache(s.log, store, s.repo.GetRevision, s.cfg.CacheTTL)
+	s.addJob("response cache stats", time.Minute, func(ctx context.Context) error {
+		hits, misses := c.Stats()
+		s.log.InfoContext(ctx, "Response cache", "hits", hits, "misses", misses)
+		return nil
+	})
+	return c, nil
+}
+
+// interceptors returns the interceptors enabled by the configuration, in the order they run.
+// Those that Reload can enable are always there, doing nothing while disabled.
+func (s *Server) interceptors(
+	authn *interceptor.Auth,
+) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+	cfg := s.cfg
+	policy, err := interceptor.ParsePolicy(cfg.InterceptorPolicy)
+	if err != nil {
+		return nil, nil, err
+	}
+	unary := []grpc.UnaryServerInterceptor{interceptor.LocalizeUnary()}
+	stream := []grpc.StreamServerInterceptor{interceptor.LocalizeStream()}
+	if s.accessLog != nil {
+		unary = append(unary, policy.Unary(interceptor.AccessLog, interceptor.AccessLogUnary(s.accessLog)))
+		stream = append(stream, policy.Stream(interceptor.AccessLog, interceptor.AccessLogStream(s.accessLog)))
+	}
+	unary = append(unary, s.maintenance.Unary())
+	stream = append(stream, s.maintenance.Stream())
+	s.limiter = interceptor.NewLimiter(cfg.RateLimit, cfg.RateBurst)
+	unary = append(unary, policy.Unary(interceptor.RateLimit, s.limiter.Unary()))
+	stream = append(stream, policy.Stream(interceptor.RateLimit, s.limiter.Stream()))
+	unary = append(
+		unary,
+		authn.Unary(),
+		interceptor.NewIdempotency(cfg.IdempotencyWindow).Unary(),
+		interceptor.IfMatchUnary(),
+	)
+	stream = append(stream, authn.Stream())
+	if cfg.RedisURL != "" {
+		cache, err := s.responseCache()
+		if err != nil {
+			return nil, nil, err
+		}
+		unary = append(unary, policy.Unary(interceptor.Cache, cache.Unary()))
+	}
+	s.payloads = interceptor.NewPayloadLogger(s.log, nil, 0, 0)
+	setPayloadLog(s.payloads, cfg)
+	unary = append(unary, policy.Unary(interceptor.PayloadLog, s.payloads.Unary()))
+	stream = append(stream, policy.Stream(interceptor.PayloadLog, s.payloads.Stream()))
+	unary = append(unary, interceptor.StatusErrorsUnary(s.log))
+	stream = append(stream, interceptor.StatusErrorsStream(s.log))
+	return unary, stream, nil
+}
+
+// alwaysOn are the features every server has, listed so clients can tell them from older servers lacking them.
+var alwaysOn = []string{
+	"sharing", "emergency-access", "secret-links", "account-recovery", "wifi-credentials", "seed-phrases",
+	"watch-changes",
+}
+
+// features lists the features of the server, including the optional ones enabled by the configuration.
+func features(cfg *config.Config) []string {
+	f := slices.Clone(alwaysOn)
+	if cfg.GRPCWeb {
+		f = append(f, "grpc-web")
+	}
+	if cfg.BreachCheck {
+		f = append(f, "breach-check")
+	}
+	if cfg.FaviconFetch {
+		f = append(f, "favicons")
+	}
+	return f
+}
+
+// passwordHealth returns the vault health service, checking passwords for breaches if enabled.
+func passwordHealth(cfg *config.Config, repo repository.Storage) *service.HealthService {
+	var client *breach.Client
+	if cfg.BreachCheck {
+		client = breach.NewClient(cfg.BreachURL)
+	}
+	return service.NewHealthService(repo, client, []byte(cfg.SaltSecret))
+}
+
+// favicons returns the favicon service, fetching icons of login URL sites if enabled.
+func favicons(cfg *config.Config, repo repository.Storage) *service.FaviconService {
+	var fetcher *favicon.Fetcher
+	if cfg.FaviconFetch {
+		fetcher = favicon.NewFetcher()
+	}
+	return service.NewFaviconService(repo, fetcher)
+}
+
+// telemetry schedules the telemetry reports if they are enabled, returning nil otherwise.
+func (s *Server) telemetry() *telemetry.Reporter {
+	if !s.cfg.Telemetry {
+		return nil
+	}
+	r := telemetry.NewReporter(s.cfg.TelemetryURL, s.cfg.TelemetryInterval, features(s.cfg), s.repo.GetUsageCounts)
+	s.addJob("telemetry", s.cfg.TelemetryInterval, r.Report)
+	return r
+}
+
+// listenGRPC returns the gRPC listeners and the address the gateway should dial.
+func listenGRPC(cfg *config.Config, activated map[string]net.Listener) ([]net.Listener, string, error) {
+	lis, ok := activated["grpc"]
+	if !ok {
+		var err error
+		lis, err = net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", cfg.GRPCPort))
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	// The port of the listener, rather than GRPC_PORT, which may be 0 to pick any free port.
+	addr := fmt.Sprintf("0.0.0.0:%d", cfg.GRPCPort)
+	if tcpAddr, isTCP := lis.Addr().(*net.TCPAddr); isTCP {
+		addr = fmt.Sprintf("0.0.0.0:%d", tcpAddr.Port)
+	}
+	listeners := []net.Listener{lis}
+	if cfg.GRPCSocket != "" {
+		unixLis, err := listener.Unix(cfg.GRPCSocket)
+		if err != nil {
+			return nil, "", errors.Join(err, lis.Close())
+		}
+		listeners = append(listeners, unixLis)
+	}
+	return listeners, addr, nil
+}
+
+func listenHTTP(cfg *config.Config, activated map[string]net.Listener) ([]net.Listener, error) {
+	lis, ok := activated["http"]
+	if !ok {
+		var err error
+		lis, err = net.Listen("tcp", fmt.Sprintf("0.0.0.0:%d", cfg.HTTPPort))
+		if err != nil {
+			return nil, err
+		}
+	}
+	listeners := []net.Listener{lis}
+	if cfg.HTTPSocket != "" {
+		unixLis, err := listener.Unix(cfg.HTTPSocket)
+		if err != nil {
+			return nil, errors.Join(err, lis.Close())
+		}
+		listeners = append(listeners, unixLis)
+	}
+	return listeners, nil
+}
@@ -0,0 +1,57 @@
+package strength
+
+import (
+	"github.com/nbutton23/zxcvbn-go"
+)
+
+// maxEstimatedLen bounds the estimation cost; longer passwords are scored by their prefix,
+// which is already far beyond any guessing attack.
+const maxEstimatedLen = 100
+
+// Score is a zxcvbn score from 0 (too guessable) to 4 (very unguessable).
+type Score int
+
+const (
+	VeryWeak Score = iota
+	Weak
+	Fair
+	Strong
+	VeryStrong
+)
+
+// WeakBelow is the lowest score not reported as weak.
+const WeakBelow = Strong
+
+func (s Score) String() string {
+	switch s {
+	case VeryWeak:
+		return "very weak"
+	case Weak:
+		return "weak"
+	case Fair:
+		return "fair"
+	case Strong:
+		return "strong"
+	case VeryStrong:
+		return "very strong"
+	default:
+		return "unknown"
+	}
+}
+
+type Result struct {
+	Score Score
+	// CrackTime is a human readable estimate like "3 hours".
+	CrackTime string
+}
+
+// Estimate scores password, penalizing it for containing userInputs such as the login.
+// The client and the server both use it so that their scores agree.
+func Estimate(password string, userInputs ...string) Result {
+	runes := []rune(password)
+	if len(runes) > maxEstimatedLen {
+		password = string(runes[:maxEstimatedLen])
+	}
+	m := zxcvbn.PasswordStrength(password, userInputs)
+	return Result{Score: Score(m.Score), CrackTime: m.CrackTimeDisplay}
+}
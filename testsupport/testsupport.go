@@ -0,0 +1,130 @@
+// Package testsupport runs the complete gRPC server in memory, so client tests exercise the real
+// services and interceptors without a network, a database or a running server.
+package testsupport
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/emergency"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/info"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/send"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/server"
+	"github.com/cmrd-a/GophKeeper/server/auth"
+	"github.com/cmrd-a/GophKeeper/server/config"
+	"github.com/cmrd-a/GophKeeper/server/insecure"
+	"github.com/cmrd-a/GophKeeper/server/repository"
+)
+
+const (
+	bufSize = 1 << 20
+	// stopTimeout bounds how long the end of a test waits for the calls in flight.
+	stopTimeout = 5 * time.Second
+)
+
+// Client is connected to a server started by Start.
+type Client struct {
+	Conn      *grpc.ClientConn
+	Emergency emergency.EmergencyAccessServiceClient
+	Info      info.InfoServiceClient
+	Send      send.SendServiceClient
+	User      user.UserServiceClient
+	Vault     vault.VaultServiceClient
+	// Repo holds the data of the server, to seed it and check the effects of calls.
+	Repo *repository.Memory
+
+	tokens auth.Tokens
+}
+
+// Start serves the gRPC API over bufconn, backed by an in-memory repository, and returns a client
+// connected to it. Both are stopped when the test ends. The server is configured with cfg,
+// or config.Default() when nil. The dial options are added to the client's.
+func Start(tb testing.TB, cfg *config.Config, opts ...grpc.DialOption) *Client {
+	tb.Helper()
+	if cfg == nil {
+		var err error
+		cfg, err = config.Default()
+		if err != nil {
+			tb.Fatalf("default config: %v", err)
+		}
+	}
+	repo := repository.NewMemory()
+	srv, err := server.New(cfg, slog.New(slog.DiscardHandler), server.Options{Repo: repo})
+	if err != nil {
+		tb.Fatalf("new server: %v", err)
+	}
+	lis := bufconn.Listen(bufSize)
+	go srv.ServeGRPC(lis) //nolint:errcheck // Serve only fails once the server is stopped.
+	tb.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), stopTimeout)
+		defer cancel()
+		err := srv.Stop(ctx)
+		if err != nil {
+			tb.Errorf("stop server: %v", err)
+		}
+	})
+
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		// The server presents the insecure certificate, issued for localhost.
+		grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(insecure.CertPool, "localhost")),
+	}, opts...)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		tb.Fatalf("dial server: %v", err)
+	}
+	tb.Cleanup(func() { conn.Close() })
+
+	return &Client{
+		Conn:      conn,
+		Emergency: emergency.NewEmergencyAccessServiceClient(conn),
+		Info:      info.NewInfoServiceClient(conn),
+		Send:      send.NewSendServiceClient(conn),
+		User:      user.NewUserServiceClient(conn),
+		Vault:     vault.NewVaultServiceClient(conn),
+		Repo:      repo,
+		tokens:    cfg.Tokens(),
+	}
+}
+
+// AddUser creates an account on the server and returns ctx authenticated as it.
+func (c *Client) AddUser(ctx context.Context, tb testing.TB, login string) (context.Context, uuid.UUID) {
+	tb.Helper()
+	userID, err := c.Repo.InsertUser(ctx, login, nil)
+	if err != nil {
+		tb.Fatalf("add user %q: %v", login, err)
+	}
+	return c.AuthContext(ctx, tb, userID), userID
+}
+
+// AuthContext returns ctx carrying an access token of a new device of the user,
+// of their current token generation.
+func (c *Client) AuthContext(ctx context.Context, tb testing.TB, userID uuid.UUID) context.Context {
+	tb.Helper()
+	generation, err := c.Repo.GetTokenGeneration(ctx, userID)
+	if err != nil {
+		tb.Fatalf("token generation: %v", err)
+	}
+	deviceID, err := c.Repo.InsertDevice(ctx, userID, "testsupport")
+	if err != nil {
+		tb.Fatalf("add device: %v", err)
+	}
+	token, err := auth.NewToken(c.tokens, userID, deviceID, generation)
+	if err != nil {
+		tb.Fatalf("new token: %v", err)
+	}
+	return metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+}
@@ -0,0 +1,79 @@
+package testsupport_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/user"
+	"github.com/cmrd-a/GophKeeper/gen/proto/v1/vault"
+	"github.com/cmrd-a/GophKeeper/testsupport"
+)
+
+// TestSmoke registers and logs in through the API, then saves and lists an item with the token it got.
+func TestSmoke(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	ctx := context.Background()
+
+	_, err := c.User.Register(ctx, &user.RegisterRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	login, err := c.User.Login(ctx, &user.LoginRequest{Login: "alice", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+login.GetToken())
+
+	saved, err := c.Vault.SaveLoginPassword(ctx, &vault.SaveLoginPasswordRequest{
+		Login:    "alice@example.com",
+		Password: "hunter2",
+		Urls:     []*vault.LoginURL{{Url: "https://example.com/login"}},
+	})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+
+	list, err := c.Vault.GetLoginPasswords(ctx, &vault.GetLoginPasswordsRequest{})
+	if err != nil {
+		t.Fatalf("get login passwords: %v", err)
+	}
+	if len(list.GetLoginPasswords()) != 1 {
+		t.Fatalf("got %d login passwords, want 1", len(list.GetLoginPasswords()))
+	}
+	lp := list.GetLoginPasswords()[0]
+	if lp.GetLogin() != "alice@example.com" || lp.GetPassword() != "hunter2" {
+		t.Errorf("got login %q and password %q, want the saved ones", lp.GetLogin(), lp.GetPassword())
+	}
+	if list.GetRevision() != saved.GetRevision() {
+		t.Errorf("got revision %d, want %d of the save", list.GetRevision(), saved.GetRevision())
+	}
+
+	summaries, err := c.Vault.ListItemSummaries(ctx, &vault.ListItemSummariesRequest{})
+	if err != nil {
+		t.Fatalf("list item summaries: %v", err)
+	}
+	if len(summaries.GetItems()) != 1 || summaries.GetItems()[0].GetId() != lp.GetId() {
+		t.Errorf("got summaries %v, want only the saved item", summaries.GetItems())
+	}
+}
+
+// TestAddUser checks that the contexts of AddUser are authenticated as distinct users.
+func TestAddUser(t *testing.T) {
+	c := testsupport.Start(t, nil)
+	alice, _ := c.AddUser(context.Background(), t, "alice")
+	bob, _ := c.AddUser(context.Background(), t, "bob")
+
+	_, err := c.Vault.SaveLoginPassword(alice, &vault.SaveLoginPasswordRequest{Login: "alice", Password: "secret"})
+	if err != nil {
+		t.Fatalf("save login password: %v", err)
+	}
+	list, err := c.Vault.GetLoginPasswords(bob, &vault.GetLoginPasswordsRequest{})
+	if err != nil {
+		t.Fatalf("get login passwords: %v", err)
+	}
+	if n := len(list.GetLoginPasswords()); n != 0 {
+		t.Errorf("bob got %d of alice's login passwords, want none", n)
+	}
+}